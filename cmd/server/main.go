@@ -2,19 +2,70 @@ package main
 
 import (
 	"avito-test-task/internal/api"
+	"avito-test-task/internal/assignment"
 	"avito-test-task/internal/config"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	"avito-test-task/internal/graph"
+	"avito-test-task/internal/grpcserver"
 	"avito-test-task/internal/handler"
+	"avito-test-task/internal/notify"
+	"avito-test-task/internal/outbox"
+	"avito-test-task/internal/publish"
+	"avito-test-task/internal/ratelimit"
+	ratelimitpg "avito-test-task/internal/ratelimit/postgres"
 	"avito-test-task/internal/repository"
+	"avito-test-task/internal/repository/audit"
+	outboxrepo "avito-test-task/internal/repository/outbox"
 	pullrequest "avito-test-task/internal/repository/pull_request"
 	"avito-test-task/internal/repository/team"
 	"avito-test-task/internal/repository/user"
+	"avito-test-task/internal/scheduler"
+	schedulerpg "avito-test-task/internal/scheduler/postgres"
 	"avito-test-task/internal/usecase"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log"
+	"log/slog"
+	"math/rand"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	rateLimitCleanupInterval = 10 * time.Minute
+	rateLimitIdleTTL         = time.Hour
+	// reminderLockKey - ключ advisory-блокировки задачи напоминаний, общий для всех реплик
+	reminderLockKey = 0x7265766965770001
+	// outboxLockKey - ключ advisory-блокировки доставки outbox: порядок событий держится, пока их отправляет одна реплика
+	outboxLockKey = 0x7265766965770002
 )
 
 func main() {
-	cfg := config.Load()
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "print" {
+		printConfig(os.Args[3:])
+		return
+	}
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "dsn" {
+		printDSN(os.Args[3:])
+		return
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	setupLogging(cfg.Log)
 
 	repo, err := repository.NewPostgresRepository(cfg)
 	if err != nil {
@@ -27,19 +78,355 @@ func main() {
 	userRepo := user.NewUserRepository(db)
 	teamRepo := team.NewTeamRepository(db)
 	prRepo := pullrequest.NewPRRepository(db)
+	auditRepo := audit.NewAuditRepository(db)
 
-	userUC := usecase.NewUserUseCase(*userRepo)
+	// heartbeat и проверка «онлайн» при назначении hotfix должны идти по одним часам
+	clock := usecase.SystemClock()
+	userUC := usecase.NewUserUseCase(*userRepo, usecase.WithUserClock(clock))
 	teamUC := usecase.NewTeamUseCase(*teamRepo, *userRepo)
-	prUC := usecase.NewPRUseCase(*prRepo, *userRepo, *teamRepo)
+	prOpts := []usecase.PROption{usecase.WithClock(clock)}
+	if cfg.Assignment.Seed != 0 {
+		log.Printf("Using fixed assignment seed %d", cfg.Assignment.Seed)
+		prOpts = append(prOpts, usecase.WithRandSource(rand.NewSource(cfg.Assignment.Seed)))
+	}
+	if cfg.Assignment.PolicyFile != "" {
+		store, err := newPolicyStore(cfg.Assignment)
+		if err != nil {
+			log.Fatalf("Failed to load assignment policy: %v", err)
+		}
+		prOpts = append(prOpts, usecase.WithPolicyStore(store))
+	}
+	notifier, err := newNotifier(cfg.Notify)
+	if err != nil {
+		log.Fatalf("Failed to set up notifications: %v", err)
+	}
+	// обработчики API не ждут SMTP и чат: уведомления уходят из очереди в фоне
+	queue := notify.NewQueue(notifier, cfg.Notify.QueueSize)
+	go queue.Run(context.Background())
+	prOpts = append(prOpts, usecase.WithNotifier(queue))
 
-	service := handler.NewServerHandler(teamUC, userUC, prUC)
+	prUC := usecase.NewPRUseCase(*prRepo, *userRepo, *teamRepo, prOpts...)
+	auditUC := usecase.NewAuditUseCase(*auditRepo, *prRepo)
 
-	strictHandler := api.NewStrictHandler(service, nil)
+	eventUC := usecase.NewEventUseCase(*auditRepo, *prRepo, *userRepo, *teamRepo)
+	broker, err := newEventBroker(cfg, eventUC)
+	if err != nil {
+		log.Fatalf("Failed to start review events: %v", err)
+	}
+
+	if cfg.Reminders.Enabled {
+		startReminders(cfg.Reminders, db, usecase.NewReminderUseCase(*prRepo, *userRepo, prUC, notifier, usecase.ReminderSettings{
+			DefaultSLA:           cfg.Reminders.DefaultSLA,
+			DefaultReassignAfter: cfg.Reminders.DefaultReassignAfter,
+		}))
+	}
+
+	if cfg.Outbox.RelayEnabled {
+		sink, publisher, err := newOutboxSink(cfg.Publisher)
+		if err != nil {
+			log.Fatalf("Failed to set up event publisher: %v", err)
+		}
+		defer publisher.Close()
+		startOutboxRelay(cfg.Outbox, db, outbox.NewRelay(outboxrepo.NewOutboxRepository(db), sink, outbox.Settings{
+			BatchSize: cfg.Outbox.BatchSize,
+			Retention: cfg.Outbox.Retention,
+		}))
+	}
 
-	router := api.Handler(strictHandler)
+	grpcServer, err := newGRPCServer(cfg.Server, grpcserver.NewReviewService(teamUC, userUC, prUC, broker))
+	if err != nil {
+		log.Fatalf("Failed to start gRPC server: %v", err)
+	}
+	defer grpcServer.GracefulStop()
+
+	service := handler.NewServerHandler(teamUC, userUC, prUC, auditUC, broker)
+
+	strictHandler := api.NewStrictHandlerWithOptions(service, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handler.RequestErrorHandler,
+		ResponseErrorHandlerFunc: handler.ResponseErrorHandler,
+	})
+
+	// последний middleware в списке выполняется первым
+	var middlewares []api.MiddlewareFunc
+	if cfg.Features.RequestValidation {
+		swagger, err := api.GetSwagger()
+		if err != nil {
+			log.Fatalf("Failed to load OpenAPI spec: %v", err)
+		}
+		validation, err := handler.OpenAPIValidationMiddleware(swagger)
+		if err != nil {
+			log.Fatalf("Failed to create request validator: %v", err)
+		}
+		middlewares = append(middlewares, validation)
+	}
+	middlewares = append(middlewares, handler.ActorMiddleware)
 
-	log.Printf("Server starting on port %s", cfg.ServerPort)
-	if err := http.ListenAndServe(":"+cfg.ServerPort, router); err != nil {
+	graphQL, err := graph.NewHandler(teamUC, userUC, prUC, graph.Limits{
+		MaxDepth:      cfg.GraphQL.MaxDepth,
+		MaxComplexity: cfg.GraphQL.MaxComplexity,
+	})
+	if err != nil {
+		log.Fatalf("Failed to build GraphQL schema: %v", err)
+	}
+	var graphQLHandler http.Handler = graphQL
+
+	if cfg.RateLimit.Enabled {
+		rateLimit, err := newRateLimitMiddleware(cfg.RateLimit, db)
+		if err != nil {
+			log.Fatalf("Invalid rate limit configuration: %v", err)
+		}
+		middlewares = append(middlewares, rateLimit)
+		graphQLHandler = rateLimit(graphQLHandler)
+	}
+
+	// /graphql не описан в openapi.yml, поэтому регистрируется рядом со сгенерированными маршрутами
+	baseRouter := chi.NewRouter()
+	baseRouter.Handle("/graphql", graphQLHandler)
+
+	router := api.HandlerWithOptions(strictHandler, api.ChiServerOptions{
+		BaseRouter:       baseRouter,
+		Middlewares:      middlewares,
+		ErrorHandlerFunc: handler.RequestErrorHandler,
+	})
+
+	server := &http.Server{
+		Addr:         ":" + cfg.Server.Port,
+		Handler:      router,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+
+	if cfg.Server.TLSCertFile != "" {
+		log.Printf("Server starting on port %s with TLS", cfg.Server.Port)
+		err = server.ListenAndServeTLS(cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile)
+	} else {
+		log.Printf("Server starting on port %s", cfg.Server.Port)
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("Server failed to start: %v", err)
 	}
 }
+
+// printConfig выполняет подкоманду "config print": печатает итоговую конфигурацию без секретов
+func printConfig(args []string) {
+	cfg, err := config.Load(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := cfg.Redacted().WriteYAML(os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// printDSN печатает DSN базы из тех же слоёв конфигурации, что и у сервера: по нему entrypoint применяет миграции
+func printDSN(args []string) {
+	cfg, err := config.Load(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(cfg.GetDBConnectionString())
+}
+
+// newEventBroker подписывается на уведомления Postgres, чтобы поток событий видел изменения всех реплик
+func newEventBroker(cfg *config.Config, loader events.Loader) (*events.Broker, error) {
+	wake, err := events.Listen(context.Background(), cfg.GetDBConnectionString())
+	if err != nil {
+		return nil, err
+	}
+
+	broker := events.NewBroker(loader)
+	go func() {
+		if err := broker.Run(context.Background(), wake); err != nil {
+			log.Fatalf("Review events broker stopped: %v", err)
+		}
+	}()
+	return broker, nil
+}
+
+// newGRPCServer запускает gRPC API на отдельном порту; сертификат TLS общий с HTTP
+func newGRPCServer(cfg config.ServerConfig, service *grpcserver.ReviewService) (*grpc.Server, error) {
+	var opts []grpc.ServerOption
+	if cfg.TLSCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpcserver.NewServer(service, opts...)
+	go func() {
+		log.Printf("gRPC server starting on port %s", cfg.GRPCPort)
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("gRPC server failed: %v", err)
+		}
+	}()
+	return server, nil
+}
+
+// startReminders запускает поиск зависших ревью; advisory-блокировка оставляет задачу одной реплике
+func startReminders(cfg config.RemindersConfig, db *sql.DB, reminders *usecase.ReminderUseCase) {
+	job := func(ctx context.Context, now time.Time) error {
+		stats, err := reminders.ProcessStaleReviews(ctx, now)
+		if err != nil {
+			return err
+		}
+		if stats != (usecase.ReminderStats{}) {
+			log.Printf("Stale reviews: %d reminded, %d reassigned, %d failed", stats.Reminded, stats.Reassigned, stats.Failed)
+		}
+		return nil
+	}
+
+	lock := schedulerpg.NewAdvisoryLock(db, reminderLockKey)
+	go scheduler.New("review reminders", cfg.Interval, lock, job).Run(context.Background())
+	log.Printf("Review reminders enabled: every %s, default SLA %s, reassign after %s",
+		cfg.Interval, cfg.DefaultSLA, cfg.DefaultReassignAfter)
+}
+
+// startOutboxRelay запускает доставку событий из outbox; advisory-блокировка оставляет её одной реплике
+func startOutboxRelay(cfg config.OutboxConfig, db *sql.DB, relay *outbox.Relay) {
+	job := func(ctx context.Context, now time.Time) error {
+		stats, err := relay.RelayOnce(ctx, now)
+		if err != nil {
+			return err
+		}
+		if stats.Failed != 0 || stats.Purged != 0 {
+			log.Printf("Outbox: %d delivered, %d failed, %d purged", stats.Delivered, stats.Failed, stats.Purged)
+		}
+		return nil
+	}
+
+	lock := schedulerpg.NewAdvisoryLock(db, outboxLockKey)
+	go scheduler.New("outbox relay", cfg.Interval, lock, job).Run(context.Background())
+	log.Printf("Outbox relay enabled: every %s, batch %d", cfg.Interval, cfg.BatchSize)
+}
+
+// newOutboxSink выбирает, куда relay доставляет события outbox; publisher нужно закрыть при остановке
+func newOutboxSink(cfg config.PublisherConfig) (outbox.Sink, publish.Publisher, error) {
+	var publisher publish.Publisher
+	switch cfg.Backend {
+	case "log":
+		return outbox.LogSink{}, publish.Noop{}, nil
+	case "nats":
+		natsPublisher, err := publish.NewNATSPublisher(publish.NATSConfig{
+			URL:        cfg.NATS.URL,
+			Partitions: cfg.NATS.Partitions,
+			JetStream:  cfg.NATS.JetStream,
+			Timeout:    cfg.NATS.Timeout,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		publisher = natsPublisher
+	case "kafka":
+		publisher = publish.NewKafkaPublisher(publish.KafkaConfig{
+			Brokers: cfg.Kafka.Brokers,
+			Timeout: cfg.Kafka.Timeout,
+		})
+	default:
+		publisher = publish.Noop{}
+	}
+
+	topics := publish.Topics{Default: cfg.Topic, ByType: make(map[domain.DomainEventType]string, len(cfg.Topics))}
+	for eventType, topic := range cfg.Topics {
+		topics.ByType[domain.DomainEventType(eventType)] = topic
+	}
+	log.Printf("Domain events are published via %s, default topic %s", cfg.Backend, cfg.Topic)
+	return publish.NewSink(publisher, topics), publisher, nil
+}
+
+// newNotifier собирает настроенные каналы уведомлений; без каналов уведомления только пишутся в лог
+func newNotifier(cfg config.NotifyConfig) (notify.Notifier, error) {
+	renderer, err := notify.NewRenderer(domain.Locale(cfg.Locale))
+	if err != nil {
+		return nil, err
+	}
+
+	var channels notify.Multi
+	if cfg.Email.Host != "" {
+		channels = append(channels, notify.NewEmailNotifier(notify.EmailConfig{
+			Addr:     net.JoinHostPort(cfg.Email.Host, cfg.Email.Port),
+			Username: cfg.Email.Username,
+			Password: cfg.Email.Password,
+			From:     cfg.Email.From,
+			Timeout:  cfg.Email.Timeout,
+		}, renderer))
+		log.Printf("Email notifications enabled via %s:%s", cfg.Email.Host, cfg.Email.Port)
+	}
+	if cfg.Webhook.URL != "" {
+		channels = append(channels, notify.NewWebhookNotifier(cfg.Webhook.URL, cfg.Webhook.Timeout, renderer))
+		log.Printf("Chat webhook notifications enabled")
+	}
+
+	if len(channels) == 0 {
+		return notify.LogNotifier{}, nil
+	}
+	return channels, nil
+}
+
+// newPolicyStore загружает политику назначения и перечитывает её по SIGHUP и при изменении файла
+func newPolicyStore(cfg config.AssignmentConfig) (*assignment.PolicyStore, error) {
+	active, err := assignment.LoadPolicyFile(cfg.PolicyFile, time.Now)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Assignment policy %s loaded from %s", active.Version, active.Source)
+
+	store := assignment.NewPolicyStore(active)
+	reloader := assignment.NewPolicyReloader(cfg.PolicyFile, store, time.Now)
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go reloader.Watch(context.Background(), hangup, cfg.ReloadInterval)
+
+	return store, nil
+}
+
+// setupLogging направляет log и slog в один обработчик с уровнем из конфигурации;
+// сообщения log.Printf пишутся с уровнем info
+func setupLogging(cfg config.LogConfig) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		log.Fatalf("Invalid log level: %v", err)
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+}
+
+func newRateLimitMiddleware(cfg config.RateLimitConfig, db *sql.DB) (api.MiddlewareFunc, error) {
+	rules := cfg.Rules()
+
+	switch cfg.Backend {
+	case "memory":
+		return handler.RateLimitMiddleware(ratelimit.NewMemoryLimiter(time.Now), rules, cfg.APIKeys), nil
+	case "postgres":
+		limiter := ratelimitpg.NewLimiter(db)
+		go deleteIdleBuckets(limiter)
+		return handler.RateLimitMiddleware(limiter, rules, cfg.APIKeys), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", cfg.Backend)
+	}
+}
+
+// deleteIdleBuckets чистит таблицу лимитов от клиентов, которые давно не приходили
+func deleteIdleBuckets(limiter *ratelimitpg.Limiter) {
+	for range time.Tick(rateLimitCleanupInterval) {
+		deleted, err := limiter.DeleteIdle(context.Background(), time.Now().Add(-rateLimitIdleTTL))
+		if err != nil {
+			log.Printf("Failed to delete idle rate limit buckets: %v", err)
+			continue
+		}
+		if deleted > 0 {
+			log.Printf("Deleted %d idle rate limit buckets", deleted)
+		}
+	}
+}
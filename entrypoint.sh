@@ -7,6 +7,8 @@ until pg_isready -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER"; do
 done
 
 echo "Applying database migrations..."
-go run github.com/pressly/goose/v3/cmd/goose@latest -dir migrations postgres "user=$DB_USER password=$DB_PASSWORD dbname=$DB_NAME sslmode=disable host=$DB_HOST port=$DB_PORT" up
+# DSN собирает сам сервер, поэтому sslmode и sslrootcert у миграций те же, что у приложения
+DB_DSN="$(./server config dsn)"
+go run github.com/pressly/goose/v3/cmd/goose@latest -dir migrations postgres "$DB_DSN" up
 echo "Migrations applied successfully!"
 exec ./server
\ No newline at end of file
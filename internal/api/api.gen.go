@@ -4,25 +4,101 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
-// Defines values for ErrorResponseErrorCode.
+// Defines values for AssignmentPolicyMode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	LeastLoaded AssignmentPolicyMode = "least_loaded"
+	Random      AssignmentPolicyMode = "random"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionPRCreated                AuditAction = "PR_CREATED"
+	AuditActionPRMerged                 AuditAction = "PR_MERGED"
+	AuditActionPRPriorityChanged        AuditAction = "PR_PRIORITY_CHANGED"
+	AuditActionPRUpdated                AuditAction = "PR_UPDATED"
+	AuditActionReviewerAssigned         AuditAction = "REVIEWER_ASSIGNED"
+	AuditActionReviewerRemoved          AuditAction = "REVIEWER_REMOVED"
+	AuditActionReviewerReplaced         AuditAction = "REVIEWER_REPLACED"
+	AuditActionTeamCreated              AuditAction = "TEAM_CREATED"
+	AuditActionTeamMembershipChanged    AuditAction = "TEAM_MEMBERSHIP_CHANGED"
+	AuditActionTeamMoved                AuditAction = "TEAM_MOVED"
+	AuditActionTeamSettingsChanged      AuditAction = "TEAM_SETTINGS_CHANGED"
+	AuditActionUserActivityChanged      AuditAction = "USER_ACTIVITY_CHANGED"
+	AuditActionUserCapacityChanged      AuditAction = "USER_CAPACITY_CHANGED"
+	AuditActionUserNotificationsChanged AuditAction = "USER_NOTIFICATIONS_CHANGED"
+	AuditActionUserSaved                AuditAction = "USER_SAVED"
+	AuditActionUserTagsChanged          AuditAction = "USER_TAGS_CHANGED"
+)
+
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypePullRequest AuditEntityType = "pull_request"
+	AuditEntityTypeTeam        AuditEntityType = "team"
+	AuditEntityTypeUser        AuditEntityType = "user"
+)
+
+// Defines values for ErrorCode.
+const (
+	ALREADYASSIGNED       ErrorCode = "ALREADY_ASSIGNED"
+	AUTHORCANNOTREVIEW    ErrorCode = "AUTHOR_CANNOT_REVIEW"
+	BADREQUEST            ErrorCode = "BAD_REQUEST"
+	INTERNALERROR         ErrorCode = "INTERNAL_ERROR"
+	INVALIDREVIEWERSCOUNT ErrorCode = "INVALID_REVIEWERS_COUNT"
+	NOCANDIDATE           ErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED           ErrorCode = "NOT_ASSIGNED"
+	NOTFOUND              ErrorCode = "NOT_FOUND"
+	NOTTEAMMEMBER         ErrorCode = "NOT_TEAM_MEMBER"
+	PREXISTS              ErrorCode = "PR_EXISTS"
+	PRIMARYTEAM           ErrorCode = "PRIMARY_TEAM"
+	PRMERGED              ErrorCode = "PR_MERGED"
+	RATELIMITED           ErrorCode = "RATE_LIMITED"
+	REVIEWERRULES         ErrorCode = "REVIEWER_RULES"
+	REVIEWERSLIMIT        ErrorCode = "REVIEWERS_LIMIT"
+	TEAMCYCLE             ErrorCode = "TEAM_CYCLE"
+	TEAMEXISTS            ErrorCode = "TEAM_EXISTS"
+	USERINACTIVE          ErrorCode = "USER_INACTIVE"
+	VALIDATIONERROR       ErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for MemberRole.
+const (
+	Lead    MemberRole = "lead"
+	Member  MemberRole = "member"
+	Senior  MemberRole = "senior"
+	Trainee MemberRole = "trainee"
+)
+
+// Defines values for NotificationSettingsLocale.
+const (
+	NotificationLocaleEN NotificationSettingsLocale = "en"
+	NotificationLocaleRU NotificationSettingsLocale = "ru"
+)
+
+// Defines values for PRPriority.
+const (
+	PRPriorityHigh   PRPriority = "high"
+	PRPriorityHotfix PRPriority = "hotfix"
+	PRPriorityLow    PRPriority = "low"
+	PRPriorityNormal PRPriority = "normal"
 )
 
 // Defines values for PullRequestStatus.
@@ -37,27 +113,244 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewEventType.
+const (
+	PrCreated          ReviewEventType = "pr_created"
+	PrMerged           ReviewEventType = "pr_merged"
+	ReviewerAssigned   ReviewEventType = "reviewer_assigned"
+	ReviewerReassigned ReviewEventType = "reviewer_reassigned"
+)
+
+// Defines values for GetUsersGetReviewParamsSort.
+const (
+	ReviewSortByID       GetUsersGetReviewParamsSort = "pull_request_id"
+	ReviewSortByPriority GetUsersGetReviewParamsSort = "priority"
+)
+
+// AssignmentPolicy defines model for AssignmentPolicy.
+type AssignmentPolicy struct {
+	// AllowPartial Создавать PR с меньшим числом ревьюверов, если кандидатов не хватает
+	AllowPartial bool `json:"allow_partial"`
+
+	// Fallback Если в команде PR нет ни одного кандидата, брать ревьюверов из соседних команд, затем из родительской,
+	// затем из соседей родительской и так до верхнего уровня
+	Fallback bool                 `json:"fallback"`
+	Mode     AssignmentPolicyMode `json:"mode"`
+
+	// ReviewersCount Число ревьюверов для новых PR вместо настройки команды, 0 - брать из команды
+	ReviewersCount int `json:"reviewers_count"`
+
+	// Roles Правила состава ревьюверов по ролям в команде PR; проверяются при назначении, доборе и переназначении
+	Roles RoleRules `json:"roles"`
+
+	// Version Версия из файла политики или хеш его содержимого
+	Version string `json:"version"`
+}
+
+// AssignmentPolicyMode defines model for AssignmentPolicy.Mode.
+type AssignmentPolicyMode string
+
+// AssignmentPolicyStatus defines model for AssignmentPolicyStatus.
+type AssignmentPolicyStatus struct {
+	// LastReloadError Ошибка последней неудачной перезагрузки; сбрасывается после успешной
+	LastReloadError   *string          `json:"last_reload_error,omitempty"`
+	LastReloadErrorAt *time.Time       `json:"last_reload_error_at,omitempty"`
+	LoadedAt          time.Time        `json:"loaded_at"`
+	Policy            AssignmentPolicy `json:"policy"`
+
+	// Source Файл, из которого загружена политика, или default
+	Source string `json:"source"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEntityType defines model for AuditEntityType.
+type AuditEntityType string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditAction `json:"action"`
+
+	// Actor Инициатор изменения (заголовок X-Actor-Id), иначе system
+	Actor      string                  `json:"actor"`
+	CreatedAt  time.Time               `json:"created_at"`
+	EntityId   string                  `json:"entity_id"`
+	EntityType AuditEntityType         `json:"entity_type"`
+	Id         int64                   `json:"id"`
+	NewValue   *map[string]interface{} `json:"new_value"`
+	OldValue   *map[string]interface{} `json:"old_value"`
+
+	// Reason Причина изменения (auto_assign, reassign, top_up, manual, deactivation, activation, team_sync) или пустая строка
+	Reason string `json:"reason"`
+}
+
+// ErrorCode Стабильный каталог кодов ошибок. Код не меняется между версиями и не зависит от текста сообщения.
+//
+// | Код | HTTP | Значение |
+// |-----|------|----------|
+// | BAD_REQUEST | 400 | Тело запроса не разбирается как JSON |
+// | VALIDATION_ERROR | 400 | Поля запроса нарушают правила; список нарушений в fields / invalid_params |
+// | TEAM_EXISTS | 400 | Команда с таким именем уже существует |
+// | NOT_FOUND | 404 | Команда, пользователь или PR не найдены |
+// | PR_EXISTS | 409 | PR с таким идентификатором уже существует |
+// | PR_MERGED | 409 | PR уже MERGED, ревьюверов менять нельзя |
+// | NOT_ASSIGNED | 409 | Пользователь не назначен ревьювером PR |
+// | NO_CANDIDATE | 409 | Нет активных кандидатов для назначения |
+// | REVIEWERS_LIMIT | 409 | У PR уже максимальное число ревьюверов |
+// | ALREADY_ASSIGNED | 409 | Пользователь уже назначен ревьювером PR |
+// | AUTHOR_CANNOT_REVIEW | 409 | Автор не может ревьюить свой PR |
+// | USER_INACTIVE | 409 | Пользователь неактивен |
+// | NOT_TEAM_MEMBER | 409 | Пользователь не состоит в команде |
+// | PRIMARY_TEAM | 409 | Из основной команды пользователя исключить нельзя, сначала нужно сменить основную |
+// | TEAM_CYCLE | 409 | Команду нельзя перенести в её собственное поддерево |
+// | REVIEWER_RULES | 409 | Нет кандидатов, с которыми ревьюверы PR удовлетворяют правилам ролей из политики назначения |
+// | INVALID_REVIEWERS_COUNT | 422 | Число ревьюверов вне диапазона или больше размера команды без автора |
+// | RATE_LIMITED | 429 | Клиент превысил квоту запросов; повторить через Retry-After секунд |
+// | INTERNAL_ERROR | 500 | Внутренняя ошибка сервера, подробности только в логах |
+type ErrorCode string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		// Code Стабильный каталог кодов ошибок. Код не меняется между версиями и не зависит от текста сообщения.
+		//
+		// | Код | HTTP | Значение |
+		// |-----|------|----------|
+		// | BAD_REQUEST | 400 | Тело запроса не разбирается как JSON |
+		// | VALIDATION_ERROR | 400 | Поля запроса нарушают правила; список нарушений в fields / invalid_params |
+		// | TEAM_EXISTS | 400 | Команда с таким именем уже существует |
+		// | NOT_FOUND | 404 | Команда, пользователь или PR не найдены |
+		// | PR_EXISTS | 409 | PR с таким идентификатором уже существует |
+		// | PR_MERGED | 409 | PR уже MERGED, ревьюверов менять нельзя |
+		// | NOT_ASSIGNED | 409 | Пользователь не назначен ревьювером PR |
+		// | NO_CANDIDATE | 409 | Нет активных кандидатов для назначения |
+		// | REVIEWERS_LIMIT | 409 | У PR уже максимальное число ревьюверов |
+		// | ALREADY_ASSIGNED | 409 | Пользователь уже назначен ревьювером PR |
+		// | AUTHOR_CANNOT_REVIEW | 409 | Автор не может ревьюить свой PR |
+		// | USER_INACTIVE | 409 | Пользователь неактивен |
+		// | NOT_TEAM_MEMBER | 409 | Пользователь не состоит в команде |
+		// | PRIMARY_TEAM | 409 | Из основной команды пользователя исключить нельзя, сначала нужно сменить основную |
+		// | TEAM_CYCLE | 409 | Команду нельзя перенести в её собственное поддерево |
+		// | REVIEWER_RULES | 409 | Нет кандидатов, с которыми ревьюверы PR удовлетворяют правилам ролей из политики назначения |
+		// | INVALID_REVIEWERS_COUNT | 422 | Число ревьюверов вне диапазона или больше размера команды без автора |
+		// | RATE_LIMITED | 429 | Клиент превысил квоту запросов; повторить через Retry-After секунд |
+		// | INTERNAL_ERROR | 500 | Внутренняя ошибка сервера, подробности только в логах |
+		Code ErrorCode `json:"code"`
+
+		// Fields Ошибки отдельных полей, только для VALIDATION_ERROR
+		Fields  *[]FieldError `json:"fields,omitempty"`
+		Message string        `json:"message"`
 	} `json:"error"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
-type ErrorResponseErrorCode string
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю в теле запроса (members[1].user_id) или имя query-параметра
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Label Произвольная метка PR; пробелы по краям отбрасываются, регистр сохраняется
+type Label = string
+
+// MemberRole Роль пользователя в команде (по умолчанию member); правила назначения по ролям задаёт политика назначения
+type MemberRole string
+
+// NotificationSettings Контакты и настройки уведомлений; без email письма не отправляются, пустой locale - язык сервера
+type NotificationSettings struct {
+	// ChatHandle Упоминание в чате как есть, например @alice для Mattermost или <@U024BE7LH> для Slack
+	ChatHandle *string                     `json:"chat_handle,omitempty"`
+	Email      *string                     `json:"email,omitempty"`
+	Locale     *NotificationSettingsLocale `json:"locale,omitempty"`
+	MuteChat   *bool                       `json:"mute_chat,omitempty"`
+	MuteEmail  *bool                       `json:"mute_email,omitempty"`
+}
+
+// NotificationSettingsLocale defines model for NotificationSettings.Locale.
+type NotificationSettingsLocale string
+
+// PRMetadata Где лежит код PR; незаданные поля опускаются
+type PRMetadata struct {
+	// LinesChanged Число изменённых строк
+	LinesChanged *int `json:"lines_changed,omitempty"`
+
+	// Repository Репозиторий, например org/service
+	Repository   *string `json:"repository,omitempty"`
+	SourceBranch *string `json:"source_branch,omitempty"`
+	TargetBranch *string `json:"target_branch,omitempty"`
+
+	// Url Абсолютная http(s)-ссылка на PR в хостинге репозиториев
+	Url *string `json:"url,omitempty"`
+}
+
+// PRPriority Срочность PR; для hotfix ревьюверами назначаются наименее загруженные активные участники независимо от политики и ротации
+type PRPriority string
+
+// Problem Описание ошибки по RFC 7807. Отдаётся с Content-Type application/problem+json,
+// если клиент запросил этот тип в заголовке Accept; иначе ошибка отдаётся как ErrorResponse.
+type Problem struct {
+	// Code Стабильный каталог кодов ошибок. Код не меняется между версиями и не зависит от текста сообщения.
+	//
+	// | Код | HTTP | Значение |
+	// |-----|------|----------|
+	// | BAD_REQUEST | 400 | Тело запроса не разбирается как JSON |
+	// | VALIDATION_ERROR | 400 | Поля запроса нарушают правила; список нарушений в fields / invalid_params |
+	// | TEAM_EXISTS | 400 | Команда с таким именем уже существует |
+	// | NOT_FOUND | 404 | Команда, пользователь или PR не найдены |
+	// | PR_EXISTS | 409 | PR с таким идентификатором уже существует |
+	// | PR_MERGED | 409 | PR уже MERGED, ревьюверов менять нельзя |
+	// | NOT_ASSIGNED | 409 | Пользователь не назначен ревьювером PR |
+	// | NO_CANDIDATE | 409 | Нет активных кандидатов для назначения |
+	// | REVIEWERS_LIMIT | 409 | У PR уже максимальное число ревьюверов |
+	// | ALREADY_ASSIGNED | 409 | Пользователь уже назначен ревьювером PR |
+	// | AUTHOR_CANNOT_REVIEW | 409 | Автор не может ревьюить свой PR |
+	// | USER_INACTIVE | 409 | Пользователь неактивен |
+	// | NOT_TEAM_MEMBER | 409 | Пользователь не состоит в команде |
+	// | PRIMARY_TEAM | 409 | Из основной команды пользователя исключить нельзя, сначала нужно сменить основную |
+	// | TEAM_CYCLE | 409 | Команду нельзя перенести в её собственное поддерево |
+	// | REVIEWER_RULES | 409 | Нет кандидатов, с которыми ревьюверы PR удовлетворяют правилам ролей из политики назначения |
+	// | INVALID_REVIEWERS_COUNT | 422 | Число ревьюверов вне диапазона или больше размера команды без автора |
+	// | RATE_LIMITED | 429 | Клиент превысил квоту запросов; повторить через Retry-After секунд |
+	// | INTERNAL_ERROR | 500 | Внутренняя ошибка сервера, подробности только в логах |
+	Code   ErrorCode `json:"code"`
+	Detail *string   `json:"detail,omitempty"`
+
+	// Instance Путь запроса, вызвавшего ошибку
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams Ошибки отдельных полей, только для VALIDATION_ERROR
+	InvalidParams *[]FieldError `json:"invalid_params,omitempty"`
+	Status        int           `json:"status"`
+	Title         string        `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует коду
+	Type string `json:"type"`
+}
 
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..required_reviewers)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	Labels            *[]Label   `json:"labels,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+
+	// Metadata Где лежит код PR; незаданные поля опускаются
+	Metadata *PRMetadata `json:"metadata,omitempty"`
+
+	// Priority Срочность PR; для hotfix ревьюверами назначаются наименее загруженные активные участники независимо от политики и ротации
+	Priority        *PRPriority `json:"priority,omitempty"`
+	PullRequestId   string      `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+
+	// RequiredReviewers Требуемое число ревьюверов для PR
+	RequiredReviewers int `json:"required_reviewers"`
+
+	// RequiredTags Теги экспертизы, которые должны покрыть ревьюверы
+	RequiredTags *[]Tag            `json:"required_tags,omitempty"`
+	Status       PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -65,7 +358,14 @@ type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
+	AuthorId string   `json:"author_id"`
+	Labels   *[]Label `json:"labels,omitempty"`
+
+	// Metadata Где лежит код PR; незаданные поля опускаются
+	Metadata *PRMetadata `json:"metadata,omitempty"`
+
+	// Priority Срочность PR; для hotfix ревьюверами назначаются наименее загруженные активные участники независимо от политики и ротации
+	Priority        *PRPriority            `json:"priority,omitempty"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
 	Status          PullRequestShortStatus `json:"status"`
@@ -74,38 +374,277 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// RepositoryStats defines model for RepositoryStats.
+type RepositoryStats struct {
+	MergedPrs   int `json:"merged_prs"`
+	OpenPrs     int `json:"open_prs"`
+	OpenReviews int `json:"open_reviews"`
+
+	// Repository Репозиторий из метаданных PR; пустая строка - PR без репозитория
+	Repository string `json:"repository"`
+}
+
+// ReviewEvent Данные одного события в потоке /events/stream (поле data), id события совпадает с полем id
+type ReviewEvent struct {
+	Actor     string    `json:"actor"`
+	AuthorId  string    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+
+	// OldReviewerId Снятый ревьювер для reviewer_reassigned
+	OldReviewerId   *string `json:"old_reviewer_id,omitempty"`
+	PullRequestId   string  `json:"pull_request_id"`
+	PullRequestName string  `json:"pull_request_name"`
+
+	// ReviewerId Назначенный ревьювер, для reviewer_reassigned - новый
+	ReviewerId *string `json:"reviewer_id,omitempty"`
+
+	// Reviewers Ревьюверы PR на момент отправки события
+	Reviewers []string `json:"reviewers"`
+
+	// TeamName Команда автора PR
+	TeamName string          `json:"team_name"`
+	Type     ReviewEventType `json:"type"`
+}
+
+// ReviewEventType defines model for ReviewEvent.Type.
+type ReviewEventType string
+
+// ReviewMatrix Сколько раз каждый ревьювер ревьюил каждого автора в PR команды; пар без ревью в списке нет
+type ReviewMatrix struct {
+	// Members Участники команды по user_id, в том числе без единого ревью
+	Members []string `json:"members"`
+
+	// Pairs По автору, затем по ревьюверу
+	Pairs    []ReviewPair `json:"pairs"`
+	TeamName string       `json:"team_name"`
+}
+
+// ReviewPair defines model for ReviewPair.
+type ReviewPair struct {
+	AuthorId string `json:"author_id"`
+
+	// LastReviewedAt Последнее назначение ревьювера на PR автора
+	LastReviewedAt time.Time `json:"last_reviewed_at"`
+
+	// ReviewerId Ревьювер может быть и не из команды, если его назначили вручную
+	ReviewerId string `json:"reviewer_id"`
+
+	// Reviews Число PR автора, на которые назначался ревьювер
+	Reviews int `json:"reviews"`
+}
+
+// RoleRules Правила состава ревьюверов по ролям в команде PR; проверяются при назначении, доборе и переназначении
+type RoleRules struct {
+	// LeadsAsFallback Лиды назначаются, только если остальных кандидатов не хватает
+	LeadsAsFallback bool `json:"leads_as_fallback"`
+
+	// NoSoleTrainee Ревьюверы не могут быть одними стажёрами (trainee)
+	NoSoleTrainee bool `json:"no_sole_trainee"`
+
+	// RequireSenior Среди ревьюверов должен быть хотя бы один senior или lead
+	RequireSenior bool `json:"require_senior"`
+}
+
+// Tag Тег экспертизы (например go, postgres, c++); регистр не учитывается
+type Tag = string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// Members Участники команды, user_id не должны повторяться
+	Members []TeamMember `json:"members"`
+
+	// ParentName Родительская команда (департамент); не задано - команда верхнего уровня
+	ParentName *string `json:"parent_name,omitempty"`
+
+	// ReassignAfterHours Через сколько часов без ревью ревьювер заменяется другим, больше review_sla_hours (не задано - значение сервера)
+	ReassignAfterHours *int `json:"reassign_after_hours,omitempty"`
+
+	// ReviewRotation Выбирать ревьюверов, которые дольше всех не ревьюили автора, вместо способа из политики назначения (по умолчанию false)
+	ReviewRotation *bool `json:"review_rotation,omitempty"`
+
+	// ReviewSlaHours Через сколько часов без ревью ревьюверу приходит напоминание (не задано - значение сервера)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewersCount Сколько ревьюверов назначать на PR авторов команды (по умолчанию 2, не больше размера команды без автора)
+	ReviewersCount *int   `json:"reviewers_count,omitempty"`
+	TeamName       string `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	// Capacity Доля ревью пользователя в процентах; только в ответах, меняется через /users/setCapacity
+	Capacity *int `json:"capacity,omitempty"`
+
+	// IsActive Участвует ли пользователь в ревью этой команды; в ответах учитывает и общую активность пользователя
+	IsActive bool `json:"is_active"`
+
+	// Role Роль пользователя в команде (по умолчанию member); правила назначения по ролям задаёт политика назначения
+	Role     *MemberRole `json:"role,omitempty"`
+	UserId   string      `json:"user_id"`
+	Username string      `json:"username"`
+}
+
+// TeamMembership defines model for TeamMembership.
+type TeamMembership struct {
+	// IsActive Активно ли членство в этой команде
+	IsActive bool `json:"is_active"`
+
+	// IsPrimary Основная команда; из неё назначаются ревьюверы PR, созданных без team_name
+	IsPrimary bool `json:"is_primary"`
+
+	// Role Роль пользователя в команде (по умолчанию member); правила назначения по ролям задаёт политика назначения
+	Role     MemberRole `json:"role"`
+	TeamName string     `json:"team_name"`
+}
+
+// TeamStats Показатели поддерева команды; пользователь из нескольких команд поддерева считается один раз
+type TeamStats struct {
+	// ActiveCapacity Сумма capacity активных участников в процентах, 250 - как два с половиной ревьювера на полную нагрузку
+	ActiveCapacity int `json:"active_capacity"`
+
+	// ActiveMembers Участники, активные хотя бы в одной команде поддерева
+	ActiveMembers int `json:"active_members"`
+
+	// Depth Глубина относительно запрошенной команды, у неё самой 0
+	Depth     int `json:"depth"`
+	Members   int `json:"members"`
+	MergedPrs int `json:"merged_prs"`
+	OpenPrs   int `json:"open_prs"`
+
+	// OpenReviews Назначения ревьюверов в открытых PR
+	OpenReviews int `json:"open_reviews"`
+
+	// Repositories Показатели PR поддерева в разрезе репозиториев, по имени репозитория
+	Repositories []RepositoryStats `json:"repositories"`
+	TeamName     string            `json:"team_name"`
+
+	// Teams Число команд в поддереве, включая саму команду
+	Teams int `json:"teams"`
+}
+
+// TeamTree defines model for TeamTree.
+type TeamTree struct {
+	// Children Вложенные команды, по имени
+	Children []TeamTree `json:"children"`
+	Team     Team       `json:"team"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	// Capacity Доля ревью в процентах от полной (по умолчанию 100), 0 - ревьювером автоматически не назначается
+	Capacity int  `json:"capacity"`
+	IsActive bool `json:"is_active"`
+
+	// LastSeenAt Последний heartbeat (/users/heartbeat); пользователь онлайн 5 минут после него
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+
+	// Notifications Контакты и настройки уведомлений; без email письма не отправляются, пустой locale - язык сервера
+	Notifications *NotificationSettings `json:"notifications,omitempty"`
+
+	// Tags Теги экспертизы пользователя в нижнем регистре
+	Tags *[]Tag `json:"tags,omitempty"`
+
+	// TeamName Основная команда пользователя
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// Teams Все команды пользователя, основная - первой
+	Teams    *[]TeamMembership `json:"teams,omitempty"`
+	UserId   string            `json:"user_id"`
+	Username string            `json:"username"`
 }
 
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// BadRequestApplicationJSON defines model for BadRequest.
+type BadRequestApplicationJSON = ErrorResponse
+
+// BadRequestApplicationProblemPlusJSON Описание ошибки по RFC 7807. Отдаётся с Content-Type application/problem+json,
+// если клиент запросил этот тип в заголовке Accept; иначе ошибка отдаётся как ErrorResponse.
+type BadRequestApplicationProblemPlusJSON = Problem
+
+// InternalErrorApplicationJSON defines model for InternalError.
+type InternalErrorApplicationJSON = ErrorResponse
+
+// InternalErrorApplicationProblemPlusJSON Описание ошибки по RFC 7807. Отдаётся с Content-Type application/problem+json,
+// если клиент запросил этот тип в заголовке Accept; иначе ошибка отдаётся как ErrorResponse.
+type InternalErrorApplicationProblemPlusJSON = Problem
+
+// TooManyRequestsApplicationJSON defines model for TooManyRequests.
+type TooManyRequestsApplicationJSON = ErrorResponse
+
+// TooManyRequestsApplicationProblemPlusJSON Описание ошибки по RFC 7807. Отдаётся с Content-Type application/problem+json,
+// если клиент запросил этот тип в заголовке Accept; иначе ошибка отдаётся как ErrorResponse.
+type TooManyRequestsApplicationProblemPlusJSON = Problem
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	EntityType *AuditEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *string          `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+	Actor      *string          `form:"actor,omitempty" json:"actor,omitempty"`
+	Action     *AuditAction     `form:"action,omitempty" json:"action,omitempty"`
+
+	// From Начало интервала (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец интервала (не включительно)
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// UserId Только события, где пользователь автор, ревьювер или снятый ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только события PR авторов из команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string   `json:"author_id"`
+	Labels   *[]Label `json:"labels,omitempty"`
+
+	// Metadata Где лежит код PR; незаданные поля опускаются
+	Metadata *PRMetadata `json:"metadata,omitempty"`
+
+	// Priority Срочность PR; для hotfix ревьюверами назначаются наименее загруженные активные участники независимо от политики и ротации
+	Priority        *PRPriority `json:"priority,omitempty"`
+	PullRequestId   string      `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+
+	// RequiredTags Теги экспертизы; при назначении предпочитаются активные ревьюверы, покрывающие каждый тег хотя бы одним ревьювером
+	RequiredTags *[]Tag `json:"required_tags,omitempty"`
+
+	// ReviewersCount Переопределяет число ревьюверов команды для этого PR
+	ReviewersCount *int `json:"reviewers_count,omitempty"`
+
+	// TeamName Команда автора, из которой назначаются ревьюверы (по умолчанию основная команда автора)
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -119,16 +658,137 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestSetPriorityJSONBody defines parameters for PostPullRequestSetPriority.
+type PostPullRequestSetPriorityJSONBody struct {
+	// Priority Срочность PR; для hotfix ревьюверами назначаются наименее загруженные активные участники независимо от политики и ротации
+	Priority      PRPriority `json:"priority"`
+	PullRequestId string     `json:"pull_request_id"`
+}
+
+// PostPullRequestTopUpJSONBody defines parameters for PostPullRequestTopUp.
+type PostPullRequestTopUpJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestUpdateJSONBody defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateJSONBody struct {
+	Labels *[]Label `json:"labels,omitempty"`
+
+	// Metadata Где лежит код PR; незаданные поля опускаются
+	Metadata      *PRMetadata `json:"metadata,omitempty"`
+	PullRequestId string      `json:"pull_request_id"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamMoveJSONBody defines parameters for PostTeamMove.
+type PostTeamMoveJSONBody struct {
+	// ParentName Новая родительская команда; не задано - команда становится верхнего уровня
+	ParentName *string `json:"parent_name,omitempty"`
+	TeamName   string  `json:"team_name"`
+}
+
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// GetTeamReviewMatrixParams defines parameters for GetTeamReviewMatrix.
+type GetTeamReviewMatrixParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSetMemberJSONBody defines parameters for PostTeamSetMember.
+type PostTeamSetMemberJSONBody struct {
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Role Роль пользователя в команде (по умолчанию member); правила назначения по ролям задаёт политика назначения
+	Role     *MemberRole `json:"role,omitempty"`
+	TeamName string      `json:"team_name"`
+	UserId   string      `json:"user_id"`
+}
+
+// PostTeamSetReviewRotationJSONBody defines parameters for PostTeamSetReviewRotation.
+type PostTeamSetReviewRotationJSONBody struct {
+	ReviewRotation bool   `json:"review_rotation"`
+	TeamName       string `json:"team_name"`
+}
+
+// PostTeamSetReviewSLAJSONBody defines parameters for PostTeamSetReviewSLA.
+type PostTeamSetReviewSLAJSONBody struct {
+	ReassignAfterHours *int   `json:"reassign_after_hours,omitempty"`
+	ReviewSlaHours     *int   `json:"review_sla_hours,omitempty"`
+	TeamName           string `json:"team_name"`
+}
+
+// PostTeamSetReviewersCountJSONBody defines parameters for PostTeamSetReviewersCount.
+type PostTeamSetReviewersCountJSONBody struct {
+	ReviewersCount int    `json:"reviewers_count"`
+	TeamName       string `json:"team_name"`
+}
+
+// GetTeamSubtreeParams defines parameters for GetTeamSubtree.
+type GetTeamSubtreeParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamSubtreeStatsParams defines parameters for GetTeamSubtreeStats.
+type GetTeamSubtreeStatsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Priority Только PR с этими приоритетами (параметр можно повторять)
+	Priority *[]PRPriority `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// Sort Порядок списка - по id PR или сначала самые срочные (при равном приоритете по id)
+	Sort *GetUsersGetReviewParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Label Только PR со всеми перечисленными метками (параметр можно повторять)
+	Label *[]Label `form:"label,omitempty" json:"label,omitempty"`
+
+	// Repository Только PR из этого репозитория
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
+}
+
+// GetUsersGetReviewParamsSort defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsSort string
+
+// PostUsersHeartbeatJSONBody defines parameters for PostUsersHeartbeat.
+type PostUsersHeartbeatJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetUsersSearchByTagParams defines parameters for GetUsersSearchByTag.
+type GetUsersSearchByTagParams struct {
+	Tag Tag `form:"tag" json:"tag"`
+
+	// TeamName Искать только среди участников команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// PostUsersSetCapacityJSONBody defines parameters for PostUsersSetCapacity.
+type PostUsersSetCapacityJSONBody struct {
+	Capacity int    `json:"capacity"`
+	UserId   string `json:"user_id"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -137,6 +797,28 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetNotificationsJSONBody defines parameters for PostUsersSetNotifications.
+type PostUsersSetNotificationsJSONBody struct {
+	// Notifications Контакты и настройки уведомлений; без email письма не отправляются, пустой locale - язык сервера
+	Notifications NotificationSettings `json:"notifications"`
+	UserId        string               `json:"user_id"`
+}
+
+// PostUsersSetPrimaryTeamJSONBody defines parameters for PostUsersSetPrimaryTeam.
+type PostUsersSetPrimaryTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostUsersSetTagsJSONBody defines parameters for PostUsersSetTags.
+type PostUsersSetTagsJSONBody struct {
+	Tags   []Tag  `json:"tags"`
+	UserId string `json:"user_id"`
+}
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -146,47 +828,194 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestSetPriorityJSONRequestBody defines body for PostPullRequestSetPriority for application/json ContentType.
+type PostPullRequestSetPriorityJSONRequestBody PostPullRequestSetPriorityJSONBody
+
+// PostPullRequestTopUpJSONRequestBody defines body for PostPullRequestTopUp for application/json ContentType.
+type PostPullRequestTopUpJSONRequestBody PostPullRequestTopUpJSONBody
+
+// PostPullRequestUpdateJSONRequestBody defines body for PostPullRequestUpdate for application/json ContentType.
+type PostPullRequestUpdateJSONRequestBody PostPullRequestUpdateJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamMoveJSONRequestBody defines body for PostTeamMove for application/json ContentType.
+type PostTeamMoveJSONRequestBody PostTeamMoveJSONBody
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
+
+// PostTeamSetMemberJSONRequestBody defines body for PostTeamSetMember for application/json ContentType.
+type PostTeamSetMemberJSONRequestBody PostTeamSetMemberJSONBody
+
+// PostTeamSetReviewRotationJSONRequestBody defines body for PostTeamSetReviewRotation for application/json ContentType.
+type PostTeamSetReviewRotationJSONRequestBody PostTeamSetReviewRotationJSONBody
+
+// PostTeamSetReviewSLAJSONRequestBody defines body for PostTeamSetReviewSLA for application/json ContentType.
+type PostTeamSetReviewSLAJSONRequestBody PostTeamSetReviewSLAJSONBody
+
+// PostTeamSetReviewersCountJSONRequestBody defines body for PostTeamSetReviewersCount for application/json ContentType.
+type PostTeamSetReviewersCountJSONRequestBody PostTeamSetReviewersCountJSONBody
+
+// PostUsersHeartbeatJSONRequestBody defines body for PostUsersHeartbeat for application/json ContentType.
+type PostUsersHeartbeatJSONRequestBody PostUsersHeartbeatJSONBody
+
+// PostUsersSetCapacityJSONRequestBody defines body for PostUsersSetCapacity for application/json ContentType.
+type PostUsersSetCapacityJSONRequestBody PostUsersSetCapacityJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetNotificationsJSONRequestBody defines body for PostUsersSetNotifications for application/json ContentType.
+type PostUsersSetNotificationsJSONRequestBody PostUsersSetNotificationsJSONBody
+
+// PostUsersSetPrimaryTeamJSONRequestBody defines body for PostUsersSetPrimaryTeam for application/json ContentType.
+type PostUsersSetPrimaryTeamJSONRequestBody PostUsersSetPrimaryTeamJSONBody
+
+// PostUsersSetTagsJSONRequestBody defines body for PostUsersSetTags for application/json ContentType.
+type PostUsersSetTagsJSONRequestBody PostUsersSetTagsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Активная политика назначения ревьюверов и результат последней перезагрузки
+	// (GET /admin/assignmentPolicy)
+	GetAdminAssignmentPolicy(w http.ResponseWriter, r *http.Request)
+	// Поиск по журналу изменений PR, ревьюверов, команд и пользователей
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Поток событий назначения ревьюверов (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams)
+	// Вручную назначить конкретного ревьювера (в пределах требуемого числа ревьюверов PR)
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (по умолчанию до 2)
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить историю изменений PR (создание, назначения, переназначения, merge)
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Снять ревьювера с PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request)
+	// Изменить приоритет открытого PR
+	// (POST /pullRequest/setPriority)
+	PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request)
+	// Добрать ревьюверов до требуемого числа (например, после прихода новых участников в команду)
+	// (POST /pullRequest/topUp)
+	PostPullRequestTopUp(w http.ResponseWriter, r *http.Request)
+	// Изменить метки и метаданные PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(w http.ResponseWriter, r *http.Request)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(w http.ResponseWriter, r *http.Request)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Перенести команду вместе с вложенными командами под другую родительскую
+	// (POST /team/move)
+	PostTeamMove(w http.ResponseWriter, r *http.Request)
+	// Исключить пользователя из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(w http.ResponseWriter, r *http.Request)
+	// Матрица ревью автор×ревьювер по PR команды
+	// (GET /team/reviewMatrix)
+	GetTeamReviewMatrix(w http.ResponseWriter, r *http.Request, params GetTeamReviewMatrixParams)
+	// Добавить существующего пользователя в команду или изменить его роль и активность в ней
+	// (POST /team/setMember)
+	PostTeamSetMember(w http.ResponseWriter, r *http.Request)
+	// Включить или выключить ротацию ревьюверов команды
+	// (POST /team/setReviewRotation)
+	PostTeamSetReviewRotation(w http.ResponseWriter, r *http.Request)
+	// Задать пороги напоминания и переназначения ревьюверов команды
+	// (POST /team/setReviewSLA)
+	PostTeamSetReviewSLA(w http.ResponseWriter, r *http.Request)
+	// Задать число ревьюверов для новых PR команды
+	// (POST /team/setReviewersCount)
+	PostTeamSetReviewersCount(w http.ResponseWriter, r *http.Request)
+	// Получить команду со всеми вложенными командами (без участников)
+	// (GET /team/subtree)
+	GetTeamSubtree(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeParams)
+	// Показатели поддерева команды и каждой вложенной команды
+	// (GET /team/subtreeStats)
+	GetTeamSubtreeStats(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeStatsParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Отметить, что пользователь онлайн
+	// (POST /users/heartbeat)
+	PostUsersHeartbeat(w http.ResponseWriter, r *http.Request)
+	// Найти пользователей с тегом экспертизы
+	// (GET /users/searchByTag)
+	GetUsersSearchByTag(w http.ResponseWriter, r *http.Request, params GetUsersSearchByTagParams)
+	// Задать долю ревью пользователя
+	// (POST /users/setCapacity)
+	PostUsersSetCapacity(w http.ResponseWriter, r *http.Request)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Задать контакты и настройки уведомлений пользователя
+	// (POST /users/setNotifications)
+	PostUsersSetNotifications(w http.ResponseWriter, r *http.Request)
+	// Сменить основную команду пользователя
+	// (POST /users/setPrimaryTeam)
+	PostUsersSetPrimaryTeam(w http.ResponseWriter, r *http.Request)
+	// Задать теги экспертизы пользователя
+	// (POST /users/setTags)
+	PostUsersSetTags(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Активная политика назначения ревьюверов и результат последней перезагрузки
+// (GET /admin/assignmentPolicy)
+func (_ Unimplemented) GetAdminAssignmentPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поиск по журналу изменений PR, ревьюверов, команд и пользователей
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток событий назначения ревьюверов (Server-Sent Events)
+// (GET /events/stream)
+func (_ Unimplemented) GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную назначить конкретного ревьювера (в пределах требуемого числа ревьюверов PR)
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить ревьюверов из команды автора (по умолчанию до 2)
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить историю изменений PR (создание, назначения, переназначения, merge)
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
 func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
@@ -199,6 +1028,30 @@ func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Снять ревьювера с PR без замены
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить приоритет открытого PR
+// (POST /pullRequest/setPriority)
+func (_ Unimplemented) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добрать ревьюверов до требуемого числа (например, после прихода новых участников в команду)
+// (POST /pullRequest/topUp)
+func (_ Unimplemented) PostPullRequestTopUp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить метки и метаданные PR
+// (POST /pullRequest/update)
+func (_ Unimplemented) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать команду с участниками (создаёт/обновляет пользователей)
 // (POST /team/add)
 func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
@@ -211,32 +1064,122 @@ func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+// Перенести команду вместе с вложенными командами под другую родительскую
+// (POST /team/move)
+func (_ Unimplemented) PostTeamMove(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// Исключить пользователя из команды
+// (POST /team/removeMember)
+func (_ Unimplemented) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Матрица ревью автор×ревьювер по PR команды
+// (GET /team/reviewMatrix)
+func (_ Unimplemented) GetTeamReviewMatrix(w http.ResponseWriter, r *http.Request, params GetTeamReviewMatrixParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Добавить существующего пользователя в команду или изменить его роль и активность в ней
+// (POST /team/setMember)
+func (_ Unimplemented) PostTeamSetMember(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// Включить или выключить ротацию ревьюверов команды
+// (POST /team/setReviewRotation)
+func (_ Unimplemented) PostTeamSetReviewRotation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать пороги напоминания и переназначения ревьюверов команды
+// (POST /team/setReviewSLA)
+func (_ Unimplemented) PostTeamSetReviewSLA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать число ревьюверов для новых PR команды
+// (POST /team/setReviewersCount)
+func (_ Unimplemented) PostTeamSetReviewersCount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду со всеми вложенными командами (без участников)
+// (GET /team/subtree)
+func (_ Unimplemented) GetTeamSubtree(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Показатели поддерева команды и каждой вложенной команды
+// (GET /team/subtreeStats)
+func (_ Unimplemented) GetTeamSubtreeStats(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить, что пользователь онлайн
+// (POST /users/heartbeat)
+func (_ Unimplemented) PostUsersHeartbeat(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Найти пользователей с тегом экспертизы
+// (GET /users/searchByTag)
+func (_ Unimplemented) GetUsersSearchByTag(w http.ResponseWriter, r *http.Request, params GetUsersSearchByTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать долю ревью пользователя
+// (POST /users/setCapacity)
+func (_ Unimplemented) PostUsersSetCapacity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать контакты и настройки уведомлений пользователя
+// (POST /users/setNotifications)
+func (_ Unimplemented) PostUsersSetNotifications(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сменить основную команду пользователя
+// (POST /users/setPrimaryTeam)
+func (_ Unimplemented) PostUsersSetPrimaryTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать теги экспертизы пользователя
+// (POST /users/setTags)
+func (_ Unimplemented) PostUsersSetTags(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminAssignmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAssignmentPolicy(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.GetAdminAssignmentPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -246,11 +1189,80 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.GetAudit(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -260,11 +1272,53 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.GetEventsStream(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -274,11 +1328,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostPullRequestAddReviewer(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -288,31 +1342,45 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestHistoryParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetPullRequestHistory(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -322,31 +1390,39 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ------------- Required query parameter "user_id" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostPullRequestRemoveReviewer(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -356,11 +1432,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestSetPriority operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostPullRequestSetPriority(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -370,410 +1446,4687 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostPullRequestTopUp operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestTopUp(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestTopUp(w, r)
+	}))
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// PostPullRequestUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestUpdate(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
-
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
-
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
-
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
-
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	// ------------- Required query parameter "team_name" -------------
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
-
-	return r
-}
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamMove operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMove(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamMove(w, r)
+	}))
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
-
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMember(w, r)
+	}))
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+// GetTeamReviewMatrix operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReviewMatrix(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReviewMatrixParams
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+	// ------------- Required query parameter "team_name" -------------
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReviewMatrix(w, r, params)
+	}))
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamSetMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetMember(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetMember(w, r)
+	}))
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+// PostTeamSetReviewRotation operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewRotation(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetReviewRotation(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+// PostTeamSetReviewSLA operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewSLA(w http.ResponseWriter, r *http.Request) {
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetReviewSLA(w, r)
+	}))
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PostTeamSetReviewersCount operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewersCount(w http.ResponseWriter, r *http.Request) {
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetReviewersCount(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+// GetTeamSubtree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSubtree(w http.ResponseWriter, r *http.Request) {
 
-type GetTeamGet200JSONResponse Team
+	var err error
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSubtreeParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "team_name" -------------
 
-type GetTeamGet404JSONResponse ErrorResponse
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSubtree(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
+// GetTeamSubtreeStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSubtreeStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSubtreeStatsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSubtreeStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+// PostUsersHeartbeat operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersHeartbeat(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersHeartbeat(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUsersSearchByTag operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersSearchByTag(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersSearchByTagParams
+
+	// ------------- Required query parameter "tag" -------------
+
+	if paramValue := r.URL.Query().Get("tag"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tag"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersSearchByTag(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
+// PostUsersSetCapacity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetCapacity(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetCapacity(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+// PostUsersSetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetNotifications(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetNotifications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersSetPrimaryTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetPrimaryTeam(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetPrimaryTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+// PostUsersSetTags operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetTags(w http.ResponseWriter, r *http.Request) {
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetTags(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/assignmentPolicy", wrapper.GetAdminAssignmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/setPriority", wrapper.PostPullRequestSetPriority)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/topUp", wrapper.PostPullRequestTopUp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/update", wrapper.PostPullRequestUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/move", wrapper.PostTeamMove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/reviewMatrix", wrapper.GetTeamReviewMatrix)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setMember", wrapper.PostTeamSetMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewRotation", wrapper.PostTeamSetReviewRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewSLA", wrapper.PostTeamSetReviewSLA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewersCount", wrapper.PostTeamSetReviewersCount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/subtree", wrapper.GetTeamSubtree)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/subtreeStats", wrapper.GetTeamSubtreeStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/heartbeat", wrapper.PostUsersHeartbeat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/searchByTag", wrapper.GetUsersSearchByTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setCapacity", wrapper.PostUsersSetCapacity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setNotifications", wrapper.PostUsersSetNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setPrimaryTeam", wrapper.PostUsersSetPrimaryTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setTags", wrapper.PostUsersSetTags)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse ErrorResponse
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type InternalErrorJSONResponse ErrorResponse
+type InternalErrorApplicationProblemPlusJSONResponse Problem
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter          int
+	XRateLimitLimit     int
+	XRateLimitRemaining int
+	XRateLimitReset     int
+}
+type TooManyRequestsJSONResponse struct {
+	Body ErrorResponse
+
+	Headers TooManyRequestsResponseHeaders
+}
+type TooManyRequestsApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type GetAdminAssignmentPolicyRequestObject struct {
+}
+
+type GetAdminAssignmentPolicyResponseObject interface {
+	VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAssignmentPolicy200JSONResponse AssignmentPolicyStatus
+
+func (response GetAdminAssignmentPolicy200JSONResponse) VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAssignmentPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetAdminAssignmentPolicy429JSONResponse) VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAdminAssignmentPolicy429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminAssignmentPolicy429ApplicationProblemPlusJSONResponse) VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAdminAssignmentPolicy500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAdminAssignmentPolicy500JSONResponse) VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAssignmentPolicy500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminAssignmentPolicy500ApplicationProblemPlusJSONResponse) VisitGetAdminAssignmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse struct {
+	Entries []AuditEntry `json:"entries"`
+}
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAudit400ApplicationProblemPlusJSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetAudit429JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAudit429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAudit429ApplicationProblemPlusJSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAudit500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAudit500JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAudit500ApplicationProblemPlusJSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStreamRequestObject struct {
+	Params GetEventsStreamParams
+}
+
+type GetEventsStreamResponseObject interface {
+	VisitGetEventsStreamResponse(w http.ResponseWriter) error
+}
+
+type GetEventsStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEventsStream200TexteventStreamResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEventsStream400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetEventsStream400JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetEventsStream400ApplicationProblemPlusJSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetEventsStream429JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetEventsStream429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetEventsStream429ApplicationProblemPlusJSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetEventsStream500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetEventsStream500JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetEventsStream500ApplicationProblemPlusJSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Body *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestAddReviewer400JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestAddReviewer400ApplicationProblemPlusJSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestAddReviewer404ApplicationProblemPlusJSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestAddReviewer409ApplicationProblemPlusJSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestAddReviewer429JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestAddReviewer429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestAddReviewer429ApplicationProblemPlusJSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestAddReviewer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestAddReviewer500JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestAddReviewer500ApplicationProblemPlusJSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+
+	// UncoveredTags Требуемые теги, которые не покрыл ни один назначенный ревьювер
+	UncoveredTags *[]Tag `json:"uncovered_tags,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestCreate400ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestCreate404ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestCreate409ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate422JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate422JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestCreate422ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestCreate429JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestCreate429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestCreate429ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestCreate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestCreate500ApplicationProblemPlusJSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse struct {
+	History       []AuditEntry `json:"history"`
+	PullRequestId string       `json:"pull_request_id"`
+}
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequestHistory400JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetPullRequestHistory400ApplicationProblemPlusJSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetPullRequestHistory404ApplicationProblemPlusJSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPullRequestHistory429JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestHistory429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetPullRequestHistory429ApplicationProblemPlusJSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestHistory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPullRequestHistory500JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPullRequestHistory500ApplicationProblemPlusJSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestMerge400JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestMerge400ApplicationProblemPlusJSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestMerge404ApplicationProblemPlusJSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestMerge429JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestMerge429ApplicationProblemPlusJSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestMerge500ApplicationProblemPlusJSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestReassign400ApplicationProblemPlusJSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestReassign404ApplicationProblemPlusJSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestReassign409ApplicationProblemPlusJSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestReassign429JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestReassign429ApplicationProblemPlusJSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestReassign500ApplicationProblemPlusJSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Body *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestRemoveReviewer400JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestRemoveReviewer400ApplicationProblemPlusJSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestRemoveReviewer404ApplicationProblemPlusJSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestRemoveReviewer409ApplicationProblemPlusJSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestRemoveReviewer429JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestRemoveReviewer429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestRemoveReviewer429ApplicationProblemPlusJSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestRemoveReviewer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestRemoveReviewer500JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestRemoveReviewer500ApplicationProblemPlusJSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriorityRequestObject struct {
+	Body *PostPullRequestSetPriorityJSONRequestBody
+}
+
+type PostPullRequestSetPriorityResponseObject interface {
+	VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestSetPriority200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestSetPriority200JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestSetPriority400JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestSetPriority400ApplicationProblemPlusJSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority404JSONResponse ErrorResponse
+
+func (response PostPullRequestSetPriority404JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestSetPriority404ApplicationProblemPlusJSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority409JSONResponse ErrorResponse
+
+func (response PostPullRequestSetPriority409JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestSetPriority409ApplicationProblemPlusJSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestSetPriority429JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestSetPriority429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestSetPriority429ApplicationProblemPlusJSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestSetPriority500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestSetPriority500JSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetPriority500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestSetPriority500ApplicationProblemPlusJSONResponse) VisitPostPullRequestSetPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUpRequestObject struct {
+	Body *PostPullRequestTopUpJSONRequestBody
+}
+
+type PostPullRequestTopUpResponseObject interface {
+	VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestTopUp200JSONResponse struct {
+	// Added user_id добавленных ревьюверов
+	Added []string    `json:"added"`
+	Pr    PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestTopUp200JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestTopUp400JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestTopUp400ApplicationProblemPlusJSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp404JSONResponse ErrorResponse
+
+func (response PostPullRequestTopUp404JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestTopUp404ApplicationProblemPlusJSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp409JSONResponse ErrorResponse
+
+func (response PostPullRequestTopUp409JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestTopUp409ApplicationProblemPlusJSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestTopUp429JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestTopUp429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestTopUp429ApplicationProblemPlusJSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestTopUp500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestTopUp500JSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestTopUp500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestTopUp500ApplicationProblemPlusJSONResponse) VisitPostPullRequestTopUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdateRequestObject struct {
+	Body *PostPullRequestUpdateJSONRequestBody
+}
+
+type PostPullRequestUpdateResponseObject interface {
+	VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestUpdate200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestUpdate200JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestUpdate400JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestUpdate400ApplicationProblemPlusJSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate404JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate404JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostPullRequestUpdate404ApplicationProblemPlusJSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPullRequestUpdate429JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestUpdate429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestUpdate429ApplicationProblemPlusJSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestUpdate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestUpdate500JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPullRequestUpdate500ApplicationProblemPlusJSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamAdd400ApplicationProblemPlusJSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd422JSONResponse ErrorResponse
+
+func (response PostTeamAdd422JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamAdd422ApplicationProblemPlusJSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamAdd429JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamAdd429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamAdd429ApplicationProblemPlusJSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamAdd500ApplicationProblemPlusJSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamGet400JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamGet400ApplicationProblemPlusJSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTeamGet404ApplicationProblemPlusJSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamGet429JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamGet429ApplicationProblemPlusJSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamGet500ApplicationProblemPlusJSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveRequestObject struct {
+	Body *PostTeamMoveJSONRequestBody
+}
+
+type PostTeamMoveResponseObject interface {
+	VisitPostTeamMoveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamMove200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamMove200JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamMove400JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamMove400ApplicationProblemPlusJSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove404JSONResponse ErrorResponse
+
+func (response PostTeamMove404JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamMove404ApplicationProblemPlusJSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove409JSONResponse ErrorResponse
+
+func (response PostTeamMove409JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamMove409ApplicationProblemPlusJSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamMove429JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamMove429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamMove429ApplicationProblemPlusJSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamMove500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamMove500JSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMove500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamMove500ApplicationProblemPlusJSONResponse) VisitPostTeamMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMemberRequestObject struct {
+	Body *PostTeamRemoveMemberJSONRequestBody
+}
+
+type PostTeamRemoveMemberResponseObject interface {
+	VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRemoveMember200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamRemoveMember200JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamRemoveMember400JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamRemoveMember400ApplicationProblemPlusJSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember404JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember404JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamRemoveMember404ApplicationProblemPlusJSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember409JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember409JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamRemoveMember409ApplicationProblemPlusJSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamRemoveMember429JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamRemoveMember429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamRemoveMember429ApplicationProblemPlusJSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamRemoveMember500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamRemoveMember500JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamRemoveMember500ApplicationProblemPlusJSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrixRequestObject struct {
+	Params GetTeamReviewMatrixParams
+}
+
+type GetTeamReviewMatrixResponseObject interface {
+	VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error
+}
+
+type GetTeamReviewMatrix200JSONResponse ReviewMatrix
+
+func (response GetTeamReviewMatrix200JSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamReviewMatrix400JSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamReviewMatrix400ApplicationProblemPlusJSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix404JSONResponse ErrorResponse
+
+func (response GetTeamReviewMatrix404JSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTeamReviewMatrix404ApplicationProblemPlusJSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamReviewMatrix429JSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamReviewMatrix429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamReviewMatrix429ApplicationProblemPlusJSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamReviewMatrix500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamReviewMatrix500JSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewMatrix500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamReviewMatrix500ApplicationProblemPlusJSONResponse) VisitGetTeamReviewMatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMemberRequestObject struct {
+	Body *PostTeamSetMemberJSONRequestBody
+}
+
+type PostTeamSetMemberResponseObject interface {
+	VisitPostTeamSetMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetMember200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamSetMember200JSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamSetMember400JSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetMember400ApplicationProblemPlusJSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember404JSONResponse ErrorResponse
+
+func (response PostTeamSetMember404JSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamSetMember404ApplicationProblemPlusJSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamSetMember429JSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetMember429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetMember429ApplicationProblemPlusJSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetMember500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetMember500JSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetMember500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetMember500ApplicationProblemPlusJSONResponse) VisitPostTeamSetMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotationRequestObject struct {
+	Body *PostTeamSetReviewRotationJSONRequestBody
+}
+
+type PostTeamSetReviewRotationResponseObject interface {
+	VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewRotation200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamSetReviewRotation200JSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamSetReviewRotation400JSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewRotation400ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewRotation404JSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamSetReviewRotation404ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamSetReviewRotation429JSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewRotation429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewRotation429ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewRotation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetReviewRotation500JSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewRotation500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewRotation500ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLARequestObject struct {
+	Body *PostTeamSetReviewSLAJSONRequestBody
+}
+
+type PostTeamSetReviewSLAResponseObject interface {
+	VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewSLA200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamSetReviewSLA200JSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamSetReviewSLA400JSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewSLA400ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewSLA404JSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamSetReviewSLA404ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamSetReviewSLA429JSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewSLA429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewSLA429ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewSLA500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetReviewSLA500JSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSLA500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewSLA500ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewSLAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCountRequestObject struct {
+	Body *PostTeamSetReviewersCountJSONRequestBody
+}
+
+type PostTeamSetReviewersCountResponseObject interface {
+	VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewersCount200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamSetReviewersCount200JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamSetReviewersCount400JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewersCount400ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewersCount404JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamSetReviewersCount404ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount422JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewersCount422JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTeamSetReviewersCount422ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTeamSetReviewersCount429JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewersCount429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewersCount429ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewersCount500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetReviewersCount500JSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewersCount500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostTeamSetReviewersCount500ApplicationProblemPlusJSONResponse) VisitPostTeamSetReviewersCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeRequestObject struct {
+	Params GetTeamSubtreeParams
+}
+
+type GetTeamSubtreeResponseObject interface {
+	VisitGetTeamSubtreeResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSubtree200JSONResponse TeamTree
+
+func (response GetTeamSubtree200JSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamSubtree400JSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtree400ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree404JSONResponse ErrorResponse
+
+func (response GetTeamSubtree404JSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTeamSubtree404ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamSubtree429JSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSubtree429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtree429ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSubtree500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamSubtree500JSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtree500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtree500ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStatsRequestObject struct {
+	Params GetTeamSubtreeStatsParams
+}
+
+type GetTeamSubtreeStatsResponseObject interface {
+	VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSubtreeStats200JSONResponse struct {
+	Stats []TeamStats `json:"stats"`
+}
+
+func (response GetTeamSubtreeStats200JSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamSubtreeStats400JSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtreeStats400ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats404JSONResponse ErrorResponse
+
+func (response GetTeamSubtreeStats404JSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTeamSubtreeStats404ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamSubtreeStats429JSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSubtreeStats429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtreeStats429ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSubtreeStats500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamSubtreeStats500JSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSubtreeStats500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamSubtreeStats500ApplicationProblemPlusJSONResponse) VisitGetTeamSubtreeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersGetReview400ApplicationProblemPlusJSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetUsersGetReview429JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersGetReview429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersGetReview429ApplicationProblemPlusJSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersGetReview500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersGetReview500ApplicationProblemPlusJSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeatRequestObject struct {
+	Body *PostUsersHeartbeatJSONRequestBody
+}
+
+type PostUsersHeartbeatResponseObject interface {
+	VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error
+}
+
+type PostUsersHeartbeat200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersHeartbeat200JSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersHeartbeat400JSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersHeartbeat400ApplicationProblemPlusJSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat404JSONResponse ErrorResponse
+
+func (response PostUsersHeartbeat404JSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersHeartbeat404ApplicationProblemPlusJSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersHeartbeat429JSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersHeartbeat429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersHeartbeat429ApplicationProblemPlusJSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersHeartbeat500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersHeartbeat500JSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHeartbeat500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersHeartbeat500ApplicationProblemPlusJSONResponse) VisitPostUsersHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTagRequestObject struct {
+	Params GetUsersSearchByTagParams
+}
+
+type GetUsersSearchByTagResponseObject interface {
+	VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error
+}
+
+type GetUsersSearchByTag200JSONResponse struct {
+	Users []User `json:"users"`
+}
+
+func (response GetUsersSearchByTag200JSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUsersSearchByTag400JSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersSearchByTag400ApplicationProblemPlusJSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag404JSONResponse ErrorResponse
+
+func (response GetUsersSearchByTag404JSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetUsersSearchByTag404ApplicationProblemPlusJSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetUsersSearchByTag429JSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersSearchByTag429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersSearchByTag429ApplicationProblemPlusJSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersSearchByTag500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersSearchByTag500JSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSearchByTag500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersSearchByTag500ApplicationProblemPlusJSONResponse) VisitGetUsersSearchByTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacityRequestObject struct {
+	Body *PostUsersSetCapacityJSONRequestBody
+}
+
+type PostUsersSetCapacityResponseObject interface {
+	VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetCapacity200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetCapacity200JSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetCapacity400JSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetCapacity400ApplicationProblemPlusJSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity404JSONResponse ErrorResponse
+
+func (response PostUsersSetCapacity404JSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetCapacity404ApplicationProblemPlusJSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersSetCapacity429JSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetCapacity429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetCapacity429ApplicationProblemPlusJSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetCapacity500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetCapacity500JSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetCapacity500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetCapacity500ApplicationProblemPlusJSONResponse) VisitPostUsersSetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetIsActive400JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetIsActive400ApplicationProblemPlusJSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetIsActive404ApplicationProblemPlusJSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersSetIsActive429JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetIsActive429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetIsActive429ApplicationProblemPlusJSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetIsActive500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetIsActive500ApplicationProblemPlusJSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotificationsRequestObject struct {
+	Body *PostUsersSetNotificationsJSONRequestBody
+}
+
+type PostUsersSetNotificationsResponseObject interface {
+	VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetNotifications200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetNotifications200JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetNotifications400JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetNotifications400ApplicationProblemPlusJSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications404JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications404JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetNotifications404ApplicationProblemPlusJSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersSetNotifications429JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetNotifications429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetNotifications429ApplicationProblemPlusJSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetNotifications500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetNotifications500JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetNotifications500ApplicationProblemPlusJSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeamRequestObject struct {
+	Body *PostUsersSetPrimaryTeamJSONRequestBody
+}
+
+type PostUsersSetPrimaryTeamResponseObject interface {
+	VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetPrimaryTeam200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetPrimaryTeam200JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetPrimaryTeam400JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetPrimaryTeam400ApplicationProblemPlusJSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam404JSONResponse ErrorResponse
+
+func (response PostUsersSetPrimaryTeam404JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetPrimaryTeam404ApplicationProblemPlusJSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam409JSONResponse ErrorResponse
+
+func (response PostUsersSetPrimaryTeam409JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetPrimaryTeam409ApplicationProblemPlusJSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersSetPrimaryTeam429JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetPrimaryTeam429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetPrimaryTeam429ApplicationProblemPlusJSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetPrimaryTeam500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetPrimaryTeam500JSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetPrimaryTeam500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetPrimaryTeam500ApplicationProblemPlusJSONResponse) VisitPostUsersSetPrimaryTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTagsRequestObject struct {
+	Body *PostUsersSetTagsJSONRequestBody
+}
+
+type PostUsersSetTagsResponseObject interface {
+	VisitPostUsersSetTagsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetTags200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetTags200JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetTags400JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetTags400ApplicationProblemPlusJSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags404JSONResponse ErrorResponse
+
+func (response PostUsersSetTags404JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostUsersSetTags404ApplicationProblemPlusJSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostUsersSetTags429JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetTags429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetTags429ApplicationProblemPlusJSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.Header().Set("X-RateLimit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-RateLimit-Reset", fmt.Sprint(response.Headers.XRateLimitReset))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetTags500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetTags500JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersSetTags500ApplicationProblemPlusJSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Активная политика назначения ревьюверов и результат последней перезагрузки
+	// (GET /admin/assignmentPolicy)
+	GetAdminAssignmentPolicy(ctx context.Context, request GetAdminAssignmentPolicyRequestObject) (GetAdminAssignmentPolicyResponseObject, error)
+	// Поиск по журналу изменений PR, ревьюверов, команд и пользователей
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Поток событий назначения ревьюверов (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(ctx context.Context, request GetEventsStreamRequestObject) (GetEventsStreamResponseObject, error)
+	// Вручную назначить конкретного ревьювера (в пределах требуемого числа ревьюверов PR)
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (по умолчанию до 2)
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить историю изменений PR (создание, назначения, переназначения, merge)
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Снять ревьювера с PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Изменить приоритет открытого PR
+	// (POST /pullRequest/setPriority)
+	PostPullRequestSetPriority(ctx context.Context, request PostPullRequestSetPriorityRequestObject) (PostPullRequestSetPriorityResponseObject, error)
+	// Добрать ревьюверов до требуемого числа (например, после прихода новых участников в команду)
+	// (POST /pullRequest/topUp)
+	PostPullRequestTopUp(ctx context.Context, request PostPullRequestTopUpRequestObject) (PostPullRequestTopUpResponseObject, error)
+	// Изменить метки и метаданные PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(ctx context.Context, request PostPullRequestUpdateRequestObject) (PostPullRequestUpdateResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Перенести команду вместе с вложенными командами под другую родительскую
+	// (POST /team/move)
+	PostTeamMove(ctx context.Context, request PostTeamMoveRequestObject) (PostTeamMoveResponseObject, error)
+	// Исключить пользователя из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx context.Context, request PostTeamRemoveMemberRequestObject) (PostTeamRemoveMemberResponseObject, error)
+	// Матрица ревью автор×ревьювер по PR команды
+	// (GET /team/reviewMatrix)
+	GetTeamReviewMatrix(ctx context.Context, request GetTeamReviewMatrixRequestObject) (GetTeamReviewMatrixResponseObject, error)
+	// Добавить существующего пользователя в команду или изменить его роль и активность в ней
+	// (POST /team/setMember)
+	PostTeamSetMember(ctx context.Context, request PostTeamSetMemberRequestObject) (PostTeamSetMemberResponseObject, error)
+	// Включить или выключить ротацию ревьюверов команды
+	// (POST /team/setReviewRotation)
+	PostTeamSetReviewRotation(ctx context.Context, request PostTeamSetReviewRotationRequestObject) (PostTeamSetReviewRotationResponseObject, error)
+	// Задать пороги напоминания и переназначения ревьюверов команды
+	// (POST /team/setReviewSLA)
+	PostTeamSetReviewSLA(ctx context.Context, request PostTeamSetReviewSLARequestObject) (PostTeamSetReviewSLAResponseObject, error)
+	// Задать число ревьюверов для новых PR команды
+	// (POST /team/setReviewersCount)
+	PostTeamSetReviewersCount(ctx context.Context, request PostTeamSetReviewersCountRequestObject) (PostTeamSetReviewersCountResponseObject, error)
+	// Получить команду со всеми вложенными командами (без участников)
+	// (GET /team/subtree)
+	GetTeamSubtree(ctx context.Context, request GetTeamSubtreeRequestObject) (GetTeamSubtreeResponseObject, error)
+	// Показатели поддерева команды и каждой вложенной команды
+	// (GET /team/subtreeStats)
+	GetTeamSubtreeStats(ctx context.Context, request GetTeamSubtreeStatsRequestObject) (GetTeamSubtreeStatsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Отметить, что пользователь онлайн
+	// (POST /users/heartbeat)
+	PostUsersHeartbeat(ctx context.Context, request PostUsersHeartbeatRequestObject) (PostUsersHeartbeatResponseObject, error)
+	// Найти пользователей с тегом экспертизы
+	// (GET /users/searchByTag)
+	GetUsersSearchByTag(ctx context.Context, request GetUsersSearchByTagRequestObject) (GetUsersSearchByTagResponseObject, error)
+	// Задать долю ревью пользователя
+	// (POST /users/setCapacity)
+	PostUsersSetCapacity(ctx context.Context, request PostUsersSetCapacityRequestObject) (PostUsersSetCapacityResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Задать контакты и настройки уведомлений пользователя
+	// (POST /users/setNotifications)
+	PostUsersSetNotifications(ctx context.Context, request PostUsersSetNotificationsRequestObject) (PostUsersSetNotificationsResponseObject, error)
+	// Сменить основную команду пользователя
+	// (POST /users/setPrimaryTeam)
+	PostUsersSetPrimaryTeam(ctx context.Context, request PostUsersSetPrimaryTeamRequestObject) (PostUsersSetPrimaryTeamResponseObject, error)
+	// Задать теги экспертизы пользователя
+	// (POST /users/setTags)
+	PostUsersSetTags(ctx context.Context, request PostUsersSetTagsRequestObject) (PostUsersSetTagsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminAssignmentPolicy operation middleware
+func (sh *strictHandler) GetAdminAssignmentPolicy(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminAssignmentPolicyRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAssignmentPolicy(ctx, request.(GetAdminAssignmentPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAssignmentPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminAssignmentPolicyResponseObject); ok {
+		if err := validResponse.VisitGetAdminAssignmentPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEventsStream operation middleware
+func (sh *strictHandler) GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams) {
+	var request GetEventsStreamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEventsStream(ctx, request.(GetEventsStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEventsStream")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEventsStreamResponseObject); ok {
+		if err := validResponse.VisitGetEventsStreamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestAddReviewerRequestObject
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx, request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestSetPriority operation middleware
+func (sh *strictHandler) PostPullRequestSetPriority(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestSetPriorityRequestObject
+
+	var body PostPullRequestSetPriorityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestSetPriority(ctx, request.(PostPullRequestSetPriorityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestSetPriority")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestSetPriorityResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestSetPriorityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestTopUp operation middleware
+func (sh *strictHandler) PostPullRequestTopUp(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestTopUpRequestObject
+
+	var body PostPullRequestTopUpJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestTopUp(ctx, request.(PostPullRequestTopUpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestTopUp")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestTopUpResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestTopUpResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestUpdate operation middleware
+func (sh *strictHandler) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestUpdateRequestObject
+
+	var body PostPullRequestUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestUpdate(ctx, request.(PostPullRequestUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestUpdateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamMove operation middleware
+func (sh *strictHandler) PostTeamMove(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamMoveRequestObject
+
+	var body PostTeamMoveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamMove(ctx, request.(PostTeamMoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamMove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamMoveResponseObject); ok {
+		if err := validResponse.VisitPostTeamMoveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamRemoveMember operation middleware
+func (sh *strictHandler) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRemoveMemberRequestObject
+
+	var body PostTeamRemoveMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRemoveMember(ctx, request.(PostTeamRemoveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRemoveMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRemoveMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamRemoveMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// GetTeamReviewMatrix operation middleware
+func (sh *strictHandler) GetTeamReviewMatrix(w http.ResponseWriter, r *http.Request, params GetTeamReviewMatrixParams) {
+	var request GetTeamReviewMatrixRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	request.Params = params
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamReviewMatrix(ctx, request.(GetTeamReviewMatrixRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamReviewMatrix")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamReviewMatrixResponseObject); ok {
+		if err := validResponse.VisitGetTeamReviewMatrixResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+// PostTeamSetMember operation middleware
+func (sh *strictHandler) PostTeamSetMember(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetMemberRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostTeamSetMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -781,18 +6134,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamSetMember(ctx, request.(PostTeamSetMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamSetMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -800,11 +6153,11 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostTeamSetReviewRotation operation middleware
+func (sh *strictHandler) PostTeamSetReviewRotation(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetReviewRotationRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostTeamSetReviewRotationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -812,18 +6165,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamSetReviewRotation(ctx, request.(PostTeamSetReviewRotationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamSetReviewRotation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetReviewRotationResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetReviewRotationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -831,11 +6184,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostTeamSetReviewSLA operation middleware
+func (sh *strictHandler) PostTeamSetReviewSLA(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetReviewSLARequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostTeamSetReviewSLAJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -843,18 +6196,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostTeamSetReviewSLA(ctx, request.(PostTeamSetReviewSLARequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostTeamSetReviewSLA")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetReviewSLAResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetReviewSLAResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -862,11 +6215,11 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostTeamSetReviewersCount operation middleware
+func (sh *strictHandler) PostTeamSetReviewersCount(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetReviewersCountRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostTeamSetReviewersCountJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -874,18 +6227,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostTeamSetReviewersCount(ctx, request.(PostTeamSetReviewersCountRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostTeamSetReviewersCount")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetReviewersCountResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetReviewersCountResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -893,25 +6246,51 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetTeamSubtree operation middleware
+func (sh *strictHandler) GetTeamSubtree(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeParams) {
+	var request GetTeamSubtreeRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetTeamSubtree(ctx, request.(GetTeamSubtreeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetTeamSubtree")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTeamSubtreeResponseObject); ok {
+		if err := validResponse.VisitGetTeamSubtreeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamSubtreeStats operation middleware
+func (sh *strictHandler) GetTeamSubtreeStats(w http.ResponseWriter, r *http.Request, params GetTeamSubtreeStatsParams) {
+	var request GetTeamSubtreeStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSubtreeStats(ctx, request.(GetTeamSubtreeStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSubtreeStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSubtreeStatsResponseObject); ok {
+		if err := validResponse.VisitGetTeamSubtreeStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -945,6 +6324,94 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostUsersHeartbeat operation middleware
+func (sh *strictHandler) PostUsersHeartbeat(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersHeartbeatRequestObject
+
+	var body PostUsersHeartbeatJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersHeartbeat(ctx, request.(PostUsersHeartbeatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersHeartbeat")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersHeartbeatResponseObject); ok {
+		if err := validResponse.VisitPostUsersHeartbeatResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersSearchByTag operation middleware
+func (sh *strictHandler) GetUsersSearchByTag(w http.ResponseWriter, r *http.Request, params GetUsersSearchByTagParams) {
+	var request GetUsersSearchByTagRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersSearchByTag(ctx, request.(GetUsersSearchByTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersSearchByTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersSearchByTagResponseObject); ok {
+		if err := validResponse.VisitGetUsersSearchByTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetCapacity operation middleware
+func (sh *strictHandler) PostUsersSetCapacity(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetCapacityRequestObject
+
+	var body PostUsersSetCapacityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetCapacity(ctx, request.(PostUsersSetCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetCapacity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetCapacityResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetCapacityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostUsersSetIsActive operation middleware
 func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	var request PostUsersSetIsActiveRequestObject
@@ -975,3 +6442,378 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostUsersSetNotifications operation middleware
+func (sh *strictHandler) PostUsersSetNotifications(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetNotificationsRequestObject
+
+	var body PostUsersSetNotificationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetNotifications(ctx, request.(PostUsersSetNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetNotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetNotificationsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetNotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetPrimaryTeam operation middleware
+func (sh *strictHandler) PostUsersSetPrimaryTeam(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetPrimaryTeamRequestObject
+
+	var body PostUsersSetPrimaryTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetPrimaryTeam(ctx, request.(PostUsersSetPrimaryTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetPrimaryTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetPrimaryTeamResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetPrimaryTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetTags operation middleware
+func (sh *strictHandler) PostUsersSetTags(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetTagsRequestObject
+
+	var body PostUsersSetTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetTags(ctx, request.(PostUsersSetTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetTagsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9f3PcxpXgV0Fht2qlDShStBRvqH9C07TNO4lihlQujqiMoRmQxO4MZgJgFGllVolk",
+	"ZDknRYpzubIrd7ac5Kru3xHFkYYUZ/THfYHGN7p673UD3UADM0NSImVza8sRMfjx+vXr9/vHXbPSqDcb",
+	"nuOFgTl112zavl13QsfHvxZatVrJ+W3LCcK56i9ajn8HrladoOK7zdBteOaUyb5hO6zDetEm60a/Z122",
+	"x9rRJutH94yFkmmZLtz0W3zWMj277phTZrNVq5V9enHZrZqWCX+4vlM1p0K/5VhmUFlz6jZ8rW7fvux4",
+	"q+GaOTV58aJl1l1P/H3eMpt2GDo+fOI313+zvBwsLzfvzlTWb/zkn03LDO804WtB6Lveqrm+bplLjl2f",
+	"t+tO3lr+wXq0AvYqesR6rM86Buuy/eiJwfZYn+2zNuuxnehhzsJCx66X8d9HsaTl5UXtKq4Fjn+Q/WCv",
+	"WR8X9pL12TZe7rBX0ZOcxbQCx3+Lu7MO3wmaDS9wkPg+sKuc9uCvSsMLHQ//aTebNbdiw3rH/z2ARd81",
+	"ndt2vVlz8J++3/DpkSp84JfTl+c+nF6auzpfni2VrgJNrrhOrRqYU9fv0j+1JFl3gsBehTfUW0FoeI3Q",
+	"uOkYTr0Z3jHXb8i/37JrbhXhMVZst+ZUcZcSJP2z76yYU+Y/jSdnbZx+DcZnAdoSXzc+Jy+v6Tdu1pz6",
+	"T8Qyh3vnAj1FOE1Rx7esA5Qc3Yvuwb+iTU7lRAusb7CXrM1eR/dYP9pgbaD+V6wLpNOO7rE222edaDO6",
+	"Fz20DNaDa9FW9CVrR4+jP7AunJY+e4439lg3egCEyLrRE056rMN2zXXLnPOALOzabLJVB93dufml2dL8",
+	"9OV4b5NtcflXjMDxbzm+QY+e3K35M+tFW4BcxFovegJ460dfsi57BqfYiDZYJ7rHtvG/bUDkUqNxxfbu",
+	"8HMSHA6Vpeml2fLluStzS7MfKoj07dAxam7dDQ3ndsVxqieawp8iArejh9GXiMg2sO5t1o82WVul7j7b",
+	"ht9esS5nmO1zBvtr8jcQ82t82w4xSqT9DU7O9K7nSNfATveiLeNXY9MLc2P/1bljGewZ67CXcEg6cJMx",
+	"Rg/NLZxb9tjX6pOsa/xqrGSHzmVA8ti/GgjtDh4r/sGuwbaNaCvaYK9ZJ/qS9aKH0X26D+hhk7Wj+8ue",
+	"aZlrjl3l4rvkhP6dsemV0PE1cuL/IhkBjNEG2+OSYY/14U/gDFsg6Qy2z/rsBTAJOsPbJEtYN9qMHino",
+	"NGWC4MwdjuCqQ4dOXiH+VwPT96zNXiKPuRfvWvTQOIOCdy/aQFm8xfY1+wjA7UT3oids5+wooJScuu16",
+	"IIKy4PxNwUv2m/1oAzAP2xhtADq2E1rrjAZE4IQH3yOJvrcRFAKrh9TVlWiWvYKL8HP0KHpcDOF6csSR",
+	"mqaDwF316o4XLjRqbgVVj6bfaDp+6JLEtmu1xu/KTdsPXbumRWefvQSyJuUjemQslIxoA2gMztsjZHX7",
+	"RvSAdaMNPBv7Bj/Mj6LHnO/12bZlsA7e0DVQV+uxHdbF47JJ+9IDgXafvsLacDwSfeNmo1FzbA+454pd",
+	"q920K/+hAfV/ivdvK4of6wDI8H7gDj24oc92ENFwxjPQtIEPRPf4anVrAQGLu9vHLYV3daP7ykctpDwU",
+	"0Pv89nv42S5X4B5x4ti1lr3MrcmbO2w390lgMIirPYPtwEoQwOh+zL6iLYIX5NKyp0VnHcXIXdPxWnVz",
+	"6rrp2161UTcts+bYQViuNWwQHDcymh/ofbdc53eOH5QrjZanPwicJHKQuAPs2SCKR84I27SNnATOQp+U",
+	"lQ2Urn22iyxX1egtY8IYk3cL0ZfR+tPHxDL9Rs0JBgmsUqPmlFpw47pl3nL8wG14mmX+GbG+QSoTbt/v",
+	"WZvtslesLc4vbF2X4CfVLLoPAsEQ+7SBGwyIeQGniShTa0YkWv31GKTsXlipY833WTo9AgXJzjZu/rtT",
+	"CWGpaaaxGNphK8iyjhpQiO8AjZRjvSSFnO8kVQiQgQRBRwZIG/4n2sKD9wAJYddAWYn8E+Qt6KrsJaDu",
+	"EqCJdnojegh8QpHs9GJF2sLrTA3lZuAu20i+Kw2/Dv8yq3bojIVu3dE+jUdipEeaMe8tIrcMrwZW3mj5",
+	"FUeD1/9DJGYlFM9lPGdqCfJeCIVKJcW2JWix6qzYrVo4kNz4KmKgZFRoyahVdcPpSshPjeAwC6XyTGl2",
+	"mvTVhVL5ymzpY/HvhdLc1dLc0qflmU+m5+Or1xY+5LeXZn85N/vfZkvl6cXFuY/n1Wul2YXL0zPpa1eu",
+	"/hIvLc1OX5E+jH8uzi4tzc1/vCh97tribKm8OP3L5I/pmaW5X6ow4fX5q0tzH83NoJEqvwHffGX2ygez",
+	"pcVP5hYyv1yV3j0zvTA9o3n30rQEVIb5WubtMcDm2C3bB7MfjGIZ2wulGd+xQwcMYuXyFcdfzV5d8N2G",
+	"74Z3ZtZsT/PztWY1+64SZzhEs3m/lpxmza7k/1pv3Er/CM4eLfTww6IThq63GmghBQfLon1Ldxn+dStv",
+	"hXDDfCN0V7h1o387fP6KU7/p+MGa28y/paEFYMZu2pUiAJbsZFU3xNmZ9UI3vLOEe5+cH9npAWfWsUFc",
+	"g99HK6bFm3yd7hcfzkK+lIAKL7QroZbTf4Na0Besm7iuumQXIK8nl8KZlP3VZ3tgf8Erx+aqZ5En9VAY",
+	"dIzgThA6dR0/rRCBjMSDHUQmeImm7ub+GnJcD0SHtDXrlulWFThcL/zpBa3a4Tm/K9+yay38iF2tuoA8",
+	"u7Yg7Qo567xWrWbfrDni7wxzbdSqR/Qm37EDrV7zFC3GB7Qj2s20W2GjbCMLsAzfEf8KG81yq2kZddtr",
+	"2TXLqDpAaLfwdFmG/G90vQZ3vMrZxGcFEhxN4ydGrPvtsfZA8YT+P3kf5T23BK0L+o3XrVCTToyhK2SG",
+	"q8lp4wgAZc9Yl1zP0UPQX/a4BQMU/pyU0R1hepIy1Gd76LTosx2ye7gxJfkq4MILthNtCa0e9Uu2jyok",
+	"f+glGmVd/GkT3QrkEdwjBJJa2WfPoj+ILTu37C17n4tPf258srS0YHxusK/FoYPbWMf4fNn7fAz+7/Mx",
+	"+X/wn/CCD6Y/LJdmf3FtdnHJ+Ny4MDEBL/l7jjMSYEXv4kvAFP4rXuYeWi//ZfHqPH7TSPt9k7c/RUP6",
+	"iebtsj9z08DfEC+ggKPS+BpxBKxGupuWugvGInmWjXHD9dArXMaASkAQodCe/dXc4tJiAsxfJesS8MzN",
+	"MLSE4T/8mOwbpH8BIW/BLuDGbEdbaIri6+evLpU/unpt/kN8+YXMy628AMAjcWK4bYtrY7s8jvCQ3r5Q",
+	"kkH/mfE5N95VcHNCD6w/zAJiDU75BD1FP1g55h+nebLberQo9jJ6kiBGaHnxq9nTPFzEGHiZkLLuu/sA",
+	"Hv9AeWZ6/kMgt9nkA9/iygA7gBA0nR+Saa9xWcQGrPJZ5I34CaGGLpKHNvnKPyQkyW4yJYaVuFT0CMRP",
+	"TF8uzU5/+OkIqBKfHQlZ09eWPrkK+uo87AutK/nUn4SHMeZm4H4EREovJedjtIFSfzd+M+q7c/OoZc8O",
+	"t9HS7gDgMblImveQFEOOFtxOZKEZxxEn8bkr06VP8f3Ji78BuwuYEHnsuPGquB5yg3cG7uweexU9jh5w",
+	"zMhHwDKiDb41bfIj9KIt7tGNNjh/4Y9JIERb0WOJa818OnNZQqnEV6It5XOJzd3jR5ycaJ3oKxIiz+jc",
+	"4w1EnOS65U6LDuypSvLl0rXLs4uZc6U5RhZ6E2MDNnqIQi5NjdFDfmRQlIKpjwDBA080fJ+ckDx6xW3k",
+	"jCsm99zOzaMcKifnd+bqtXk8v5OTsJpBnq1tOgewzjZE4XD/e1Jk7hnRBcghIRvRgU6BD4WEeEiiLc4Y",
+	"a3NMS5EfhIxvshQJeR1HVYC7vEq881sZv/glXaQA8MJd2VJYQvVjc4TJAT3jc+MiyckRo2NWHBEAmhNe",
+	"b/JySi79bYN0KwifwPdNKzaOJMXEtHRRZEmck3NB/nfsipCFD/6ZiAr+K8ps0zJzSEXyQXDmDzZfilfD",
+	"JQ1fFU4AwRT5FyXuhtAmPCn2bsB5V7wfcAbhghokVHdLazWq8b/iEKSMDin+6JCbCGPwK42WR+FH1QCN",
+	"X6VerjSqA82wRC1fT3IDCtyPXRGf6whdPbpP5IY8wkrRGIl2DQG5oVMf6Dn+COCZpdB1jF3b92106sVI",
+	"ujvApKmQ01bcn7VOUvcTOnVGjARQBts8m0Jj/22RhNkTcuwxxjNRhrGOykLaxpk6uUeun79xjmehJFYd",
+	"peNgrspYOi0BbbuYvszsa3TmvITD5NFqi2LUTmCIt0wUvCWFPcJDEbot87J906nl2Mp9NJG3ORX10IKl",
+	"NSKrWyhdIo7cR5b+iqsHBtsDFIBtRxSquLhFLJnr0M9B7gDOSHO5T0kbid1oWnJyz08vjJimZJnk4oLA",
+	"hzbQ2yfVKVeryehPZ3CJEP3FRx7wHJPHfH/OXkrJbb1QppfcIwtQBJJBgfgKpZzq19a+QhIRNceGXQ4c",
+	"z0U/AEECyPBt13McLT+UHYTCC6nBEKhYPTKuMAbOtYxUDCvaQlVqBzH1Sliil4Soh9h2zSCrNXoEyORq",
+	"NVCHwNYrUnuINmKnCaigtUbFrjnGmBE9YS+jh2wvJWTNNBOurNlhec32qtpN/wfid5+8QNw9AFzgAe27",
+	"MOFJb4weUYYRgtnlOQE/t2tuxREs9QqSYL0RhII5LLcmJt6r/PzaxOSFD2bfv/wJ/h3fv1ijcFU6aW2U",
+	"NDXLRJzqct804R1AnxISbSH1DOmHlynlMr6rdM20NJdn59HTW2+FThm2QBIHcnwWfo6hT/++rmFR4OYP",
+	"7aod2prd/B94KpHoXpDJg+4pYk49HnTbwY0Gb1ZHnPUnlFazhVZLzJUypFRzPScoV7gjuyginLgTo6/o",
+	"Y9F9yd9nIudy67ADE9oArtNsBG7Y0GZTfs86CPdL1hX6LNvVUGbDXx2HPDMXo1kDSYMUmvJN3/Yqazpi",
+	"yqPJf9XSZGj7q054ZK9r+TrB9Cew3Uh4R5tcKK2FYfNMcHYMc04eslcx16QAPASnueLNeuw5WShZhHbY",
+	"dgppExf+TSdjNRQqQk5alyooFA+SlBeiTWIGa41wxb2dNbra5BiVGb+UhgWXE6dcJxsc5aSuOH0olvyA",
+	"mDfPMO4mZ4T7XUGukedVE+lHkYWubIiKdGUZ1PidaZkeBAwgOL/mrq7B/+DqhuQzCRIv48uSv+fFa5NL",
+	"n9AHpAv8U7AbPC1Pozxzz2nM9vuyNg0yufTRjPH+v028f85g3/Hkt6841qMNY4ayGscgTGLk5Q9C+ouU",
+	"GCRZr7J6CdZr9MdoUzi5u+w1ahupbD7WMaYrFacZXpIDSYrFyfopQLkAUyyec8tehreNbJRUnZCz7URD",
+	"heCdwYN3kmWkOc6uF4S2V3GKFHNFAbcMsPRRAwVfwZc8tyRZfLSl6NnjzaRQYLwOkWE9GLJL/F2xsII4",
+	"YyVe74WJCzo5ErphLWVFzDdC46O8bRERQhUL10pznCqJwmKUWEmumWBMPZHwE6eBKk51EsipvWr53hQ/",
+	"NGMAwZRscxdbNTwURuuMMWMROetMHKl+RBMx5vH+cpxxlEUGt7eyejgX8jqP2ZmJc+cE1Mm7z8rUkLMV",
+	"yabbrXCt4edFeHmUbzo/ZJwTKZXzhm46NYRlKBIlW1Fr//urh4OkLml4hSnXiS6IvpdE6hY/FcvndUvJ",
+	"N8hDrnIPVaJo7spusIaf/B3p4xmcBrY/VByEcxIsXNIpivyjoa012f5OZrUR/RGDMOgIx6P8EsslZL80",
+	"enSBm73ACBuytj34RZsoSvVGwxDKkr06gIlxteHqwuy8aZncTXljYMJWpjwmu0/ysZG4g+aca/dvAANZ",
+	"XGv4Oi5SeFKP7pi9i4fk+HZdt5el2MyCJFBNDijxsnLTD3T56JbZaDregF+JmnLuGN3O49EecrxJxizm",
+	"F1/KTzAxxtD8IR+MzuJBe7cY9xK00tItGUupReuRDr/N3nK0WdV/ka1zOZGdR+oeYiSfnHGviXuhYjzu",
+	"3CICDn3HrhtnhGZmAOWftQy3mn4D/rnNXnMsYjx3I9Ho9g2irnRKGfmYM4Q9lHgeKaVr6LQrSJYSPIsD",
+	"kKlyoEyEh2w3w8iFgInfIDKdHK2GeJTysgjkb7PalQZ4qwB6SJ3nyff6ROkiOf29Lj5LMc59dBiKaizJ",
+	"aYnOT4XGRlLwkkJdvec1ychRoqWyYpBV5OO0Sr9cifNOY2RJG63f/qZfpsM9mDEnIQjrAExarVJOhLJI",
+	"ZxuQxUY85Yod+u7tgUVTFJEmyxiS0LSnQrrAY8t4M2dGyg6wbSQOJbB9iZenKhwXXwe3x1lbe5SqQtVA",
+	"adGDIR6dzzrrt8nmZRjcTrF4UKsvVzF1BFjop+8mPDaGciTKbdquFtCnCqaiLbVq6LVG44225A8XVq/g",
+	"ji/Yrj/wLA2wICXKEzgXS8onNfzwyJofFmbA47EoyGJMKSHpaMI9rJNBm+zglCjTtBLxUShqilnx92mB",
+	"kSRAEavDbD0exclWKMmlcdxpI6+Jh1C3YfOjB5Tlkw9jUOh2T63fMkStrWLlyF+HjDT056UwqhG0KbqR",
+	"uZeMvwRSzY5rCSquw9IHXpPoYZzPBZfaOeZiOpioqRaMI7X4WBJqo8u61CF09exgbBcw2zHIRSqyqjJ3",
+	"Z0Mnjl0NynZQLihw/F+sS+xL6+hOO9himoorXhOv3GEqML1GOWjUnLKIlw6jGyR5gc+jLeVYkAbbpaQv",
+	"gvNF9FXs0z/Dv3JWCwontzIP5uqjCcjBc10HZM9DHmEME8Y/NmG3n0UPCcIu6xn0ERG15GFkTURONQgU",
+	"ALPIszQbrzsD4CPIcV5oXRfGmUy0a7VhGc1GEK76TmAZlZ/85OyldFYBkcAWz0lUiuyGTy34zfXpsV/b",
+	"Y/85Mfazn/zTufJYTkgWymV09uRBhbplSI5HnatGSFnMOOZLGs4/E9f+6GW773hhnl76vaZ0t53qTAPJ",
+	"M+g2R4UIjwBXn89eklL9yZLFpgTqw4V1v5rA5og5IULfLduQ/Vdea7T8YKSyd9w3Om8ZXS9rbL2Mly+V",
+	"Q+xgtO450LKlZk+S8CgHNZsgM85oMZZREJSciLOEJYo3vz85IUWfz+udivhRvxHaYU5RcPRQVDvklpHn",
+	"uBf5wtg2wng/LqCQtG3WTYtxuWwaeQHldT7jlTtDZr/mpuus2LUglwerG/DGSAOyVpGfRffFoeIh/UyC",
+	"ytujgaL694xRpRFAqiTneeBpPbWf0lGih/k7NWlxlnGoFGMFGecH4kKxJg7bKWuQ7aEVjwmLzmaT8uJL",
+	"rS+Nkltk47MouY2CrV+IzjPR/UvZ1GSlu4uVLe2SkqrHQWIF44ETigJRFe0Tg7Jg3KCM5XROobyMQ4u8",
+	"KVROQcS2cvj+yNPJMlZ7eokafQGVXyw8w4oEObEizuoo6Gum4TE8IbFISkupi+tW3AftSNud0WvfBJ0n",
+	"OarxF+TdLSZ5qEjOkn0RbfxJ3hCii+gBpSISxRAta4kAm+Rkt8gNyk3frdtaR/13UplMVvu5xEVUDwtO",
+	"crJ4tAUhFrkTXypefs7OZNZxFBR1QI8JfkjeSwVVeRsbR1uy7g+03YSXKD7RcilOW+dqy60h5IiXBXO6",
+	"mY3uE9EGnXq5mlNYSiRudLEB95ZTLuDHf0OBBhmv4qZsKV4mK4sqbjTM2TImL04YYyLDh+1wwDk6qNES",
+	"L9zKcxjxW6m0ivXixLGXPJ8my5P5KkcwZCxN6plqgm4nkZ70YdTsjRasqtMM17QZoa+iLSxh7onsqB6l",
+	"XAkiIR6R5Bp9GZeB7WZMMF5YRnVjbVROdo0JLUASgnQ/HmVYcUDkpKthLzFVAUJEkJ9iiGZhPqrrDHtu",
+	"F0qaveNiuM1eko5QkH1J1VJJvXE3P2o5pMNYjfWO6DWmX4vdjypT2c6uv4OmjCiMpBAt0FG0pTyrPXoF",
+	"TJiIX0Aou7FTp9XKMKkh47gpCsjj60s+OczSGfhureo7WjsS+ZSSppo6cikiGMWpgdDkbPMwz2txblrJ",
+	"enRogHYnh1bSdexeycMl9pRrJZ2fmDhLzcO0Zc/CFAI8Y6DyAZeQXU2lecpFdjDlPauhoGs8cBxvmEAI",
+	"5D2sObYf3nTs0DjDDYv4ytkiFaDPelgyu8t6xkWDLGly0iZttYRraeiAiSd31BlES9rqGsyQHzllq9h+",
+	"A0S9EK0ZFNcn6wx9cOyRg9PF6u8QptBgHvtncGYMW35uqYXrANKYiFZs865pI3pG0QbRoEWywwqNqQOY",
+	"RzKDl9XrmJVo6iUxo3mlgV+kzF9zoWSIjlRG0oLNWKS6EOPMkhOExpId/IdlfGTXasbkxORFcI/EDQHN",
+	"8+cmzk0IvcNuuuaU+d65iXPvYZQ0XEMsjtvVuuuN25p+nKvURRS4IZ6Auao5ZX7shNPwRKYpXKrh9uTE",
+	"xGgNhKX2dSYsZez8+bGJ80vnJ6Ym4P9/bcrt6lLNQSkbNQlQoVdQ9JBUO0Zq/GMTUttFTbSLXp4JLtHl",
+	"dKAHrspNGaWlyG3zzHEnrIwTJGO80kfag3N36pg5OFz/4py2iLp2xrJ93U7auMY1ivDRC5M/y/tivMPj",
+	"6V7R65Z5cWJi8HNqs26AMGjVyTgfDJ3eN5zTCJWuv4y20NkIgnhT2+gxp6ujKbj8dRPp3bwBwI7b0GSr",
+	"8HDgDZYy++D6XW1LfLUn1JB7nW7ytW4VvpzyKNN9eRNupn9Y5O4c5EFqZjXCakQHN3hn1iDinUZQkwR9",
+	"ikQBNR85I6nkilV4NmcIwYqPLWQT4IbRGdYtbVIXWJNf6KFCTWxE0MLGgQDTvQqbqytvE500ufYn6YID",
+	"POh5n2isrAROzjcG6Jcw7eAAkiL5UKpTgxcK03YozUBqO5jRClLCXbxaL65TJPE1xnuw9s5gLzDQ2eME",
+	"gZWLyJd63L3zXNLc+zyjirqNQ14ZMuFhmKk01OJY+fZTbFO0wfsxKMuPtqSK2ri3GTpIcwOPiSGeFxUA",
+	"zi0zaOS5xKCVZGKJUWc037jYLp1d/FmScfmZZXyWSblULiY5l58BuJ/FaZef4bb/HvaVG2udZS90bocE",
+	"4RgBOGV85lY/E/mulA6hgmMZn+EDcBNVclnGZ5AZDRewPx2ZkhvQuRneYEhZ2jAd4G/K4uRAJSQc4NeS",
+	"kC531ECxI3QCfJq0L+auH4qlpAoihQglp0nsJEkiSxvZgkgg+8t2EI4hoGNzHy57yXZvicdFutNr6vIW",
+	"+xpUHJ0z2FcUKUx/pS2lmhtx2lovBVvS6Ft+L9vFYsuMhEeAg0UisIygz5iESpt9ZWPZ88RPqjWA47in",
+	"pUlH4P26NwoSxAeO4imU70OvRBcU1rY7HzTkaARw3CrtrKzMPRdjJYiAhDM4W4IgQKHpFgksCj3qxbHI",
+	"5T+khMswArXS061OGRcmlz28Y8rIsKBlDzjAlHF32XSry+bUhUlrGcFYNqeWs0niy6a1nM7sxjub/tj5",
+	"iYnz2d8BIXjHdLVqBI7tV9bwpjh1En9s0ZPxFuJFMJocj74pZVfSA5PK5WDZnLoeX229t2zesJZJ+ZTf",
+	"n2SQ41WyqCbGJi8snZ/kxuGyub7sFVKQZrRLzBjUU/8uil/tQkYwl84s4lSjsUXwMBCDOysJWLrCJaxc",
+	"nm1Xq8JHgapZI9BYRQuNIJRq76alZ0jhcoLwg0b1zmj+gkw5i6BmU3LumK33VTtaVR41JTFHHZQ/8tcO",
+	"rKoTX9Wrrer0tfUj1cWb/sDixIQMsivxh9K0+ZQXSmluU8tFrhdoB7zsH/Q8T1wYCRUnaGrVQkmoB/m6",
+	"RbozLy35Z8OfQT6kx3fs6p242/7U3RRjKur0mq3cobSO51gPAJ5M0Uc83dlP07BQbvDHnaYcOCMuKwsb",
+	"RrjmBvByxBpJsxTQB+sZWwitvpdiAjHBYVRsDzpvEPxG43eeIXflEE7iJDQzBKazXWmLAE13d0wgBJ5i",
+	"uIERfx6BqYnBWxIkR9FBuAjGbPfKBEoZXfHur9mBwT0ehteCkIDRWDGSSjVcCS+VU5fyrdyIVmkKncSf",
+	"4s6cuQDL7TsTUPleU0eqBBqj4RkECyfSEzz6UW1YzpOE96WGGnIHPa0ucrxq05/luqVUZRPvL4kuG2iD",
+	"2OGjLZ/rKBYdkYY83I/SDzdTHSOes35C+TlFQAslWe+SxKVO+yLFeGjFi8aXHEbnkqrkzNZ50ypUwjS1",
+	"xJI5UaSWKcV4R6mQHbSDQ92+PUcPTE6cuH4OR4kgbf239IGL3GE8WpnGwRudXCoqbEuO3GvW5352qbta",
+	"JnUtk6hpSa1S2LY8d1YpMN6kYqZs8VWPJgzqdM7RYvbF9DU4of8pd8BpJowO7lKTCs1TVX5aFTtozv3Q",
+	"1fC6gWG7I+Tc5mbUZDIK2F4uEGcPW5R0yJYnBzPZzo9oOPt5DbOum61JMCDfM2/IUB2e1+tbLE0mTWWo",
+	"lwyYzF6lcctJuMX1G0Xm+2j2ZvbtxU2eiGtsEnuyNMXIHZl/vJJGeEKusa7LWI6P9sDZPevD2stJLvqP",
+	"zxpOLLrx9NHPjqcZ2QzOUfnj7v2Jyr9QglY2wixxbruoyZ5UBX+hVDhiJ1Pslze3JJEGqOxPHnZAe95U",
+	"g6z9HxgoLvmU8QAhMQL3P52Ti/Rjnt9xvPZYPNBZDHOW6Euf75o22PJHIqfXLi26QHOA2cWTI9hja24g",
+	"GoLlpQdJb/iE350JIepwmNwiy7S56i8wmnbAnArpoMWgX0+mL6oTUXkDLdIF5K5Y2oCMMuRO1hSUgYbp",
+	"mZFw7/n0NMK0HqLqDHGn1JRtKaYHmua6JS1JN6j1ba1sMrUypXcLqF4y2NIIw5wVSGNl4xW8X7SC96bO",
+	"H3IF7xWv4GJmAGThEkXuBJJvvnZZoABKB+7QyT/DNEgbqN4LgIZKGfqGJCUVw8Qtzt9CotC7HdnQRi6O",
+	"NffpVVxp/MigvH2+q4/1yU/GGaU6tIvVRRr/qFXQqQd+RU/xCPIJ7x/aXYhDmd9MhPa4QrIDzu+bi5ge",
+	"gfmdNGdOiaX3Lkxd/Omv37SBzmMY60dnjg9pvLJtyaqInnDXnwDnlAGeBAZIPW27seJOdc20R8YZPsl1",
+	"n6fiUV0c98X1OYfDURTRkxGYWaw9DMvPSuKBQ7A0UG6k5JJJ84CKi/KeN+jCf+vJJ/LCjp+dQqVT6+Lx",
+	"eTN9p1mzK061fPNOrB8fmTNTeXnBrAMaZ6+PXA7eYd9UvzSUZvs0T2uh9lEP41pYuNg/TdB5cwk6x5XY",
+	"ILhzJqEBKulmbK/qVnnoWoULnIY7JO2jLfa6oC1kEWipuagJdF6Dxvw7BqdpLKmsCHgM10M3oQA0HDGx",
+	"iTe4EtGAwXOsixexNCDByQ1wVE9ufpO4Me5RKi3ha9HNTp1Vz4cdYohTHYAojS3OtHC0eB66aBWLEZDU",
+	"aGZgQFQleXaY/J54RqyyeYlvN7BDN1i5Y4RrDnl2oYTT8HGlP6i8mQIL8LgVvyxgoybOEPmJJop0Gzls",
+	"eTp/uoRgaOWw3rjljJyaXFIfe/PZyZOn2ck/uOxk0TCSV+bk616nxus7p9YMzNd857WGkx+P5m6F4RXo",
+	"geg85rCnIFONfIw2pNk/UgfiEURh4ITyVFMhBzPTlF9o0JWbORfnxtMhSwY+x2NbeOIed4RvUiqatoE3",
+	"v9MQtXzRFuXhoSaUr/zAz121tX1OLnmhyF+U0HMYeR+jWExLPaBn6ASmhQ5OahOQ/LBLjgRt72to+8db",
+	"aHRogX5QISyoDso5ZBn8jgiw45U638RhSW6yZbm13GFSTgUeUu6Ejea15tCW1xLefRpsPGLvuF2t0tdb",
+	"P4UPDo49/vS4nOVF5SC0iFwXt6YUNGec72izsQ4rWiwO+WGrWnVDYvgQGvxAPDpSmteUcJtoiztRXuM7",
+	"98h7Ci6Vs6cC692zQMNG02g1T6ZPXetHf+cMyt5IGDtWKQ4daJ8VjnLBdQwsRdQ49CWKVYabtJV2OXkt",
+	"z5WWyCME9VvNaqqiMSeyyPvqYzVxPDA2PaJHM1nlC+pxTQAaZwTnpFbrYrYjtNKgUkEDa4eofBlfuE3t",
+	"TCnhAVuki+q/2Ep/HcdJeFXaH8TDmdG/rHOWpqLEhq6yLtnGFtbyOYP9je3jILIXUlkJUPMmVaqLknXs",
+	"ji4xoUGW8DXC/SF0MFFeeV00gTEtk6sBN9QyyZrrOUGZFHnIjL0woU5UNhv+6nisQVDXzPJN3/Yqa+aU",
+	"ueLYYct3khtC2191wuSGuu16oM34NbDHw7AZTI2Pr7rhOQ7suUqjPp58AklwXCiLB9IkT1Rl6Q9Sqz1W",
+	"DwD7jj0jvsdeRV9Jp+5UhTr+DLSMLRtzZ/QQarjuQEMWlBdoblQgib7l3qC8Bm6pbnbgI8Wq2szEALVC",
+	"tc92gcWrJVdJYbJkIMjTP7f5dAtr2ZMHjUZPog0+QYlalXCppHwuNbfjEp+Vgf7XvkT20qQoebSUaHy9",
+	"7GkFDHTinq5WDyNW4oEc15XhQdSAWQqmnpfbcE+Z0zW34mDtRNFDk+pDHzRuYjmCVMRsNu07kCYSDN+R",
+	"eSnOITni2l0xAOG4USLke5HZPtKwhsEcWC0dVxLo5VDqwX2OS7PTV3R1m/G635nazTSuBtdxcpaljNPZ",
+	"4BWycMqje9E97jwQCXunpZyHK+UswOzJqMBMSaloI2vucS9Uchihn+54VmoUtPGVjcMlnCEhSWBeRZlX",
+	"TAn3f+yEI1dQwnPzdt05quLJE8ONR5dPWTU3+u9Ejuncqx+dopviohml97jrpTOVZ0Oe16IDB+lvxTEb",
+	"eORK49bhCsPkydTmiuuFTmXNHEbpSpl+hROuv+Ws5gmlrg4edj3UTGuuXBN768b69xsddP0Wpti+fbv9",
+	"iNXDJBsVFBw00TVbfMrFuL433Il4Yx1SUNee+XTm8mxG1RZtMG86BnCjquF6YcNwwwA7YgatmyEOhzux",
+	"et+Qg/XZtjwtXDNxsC9nP/V5E80+2z1uoSMfs03WzTgzkoHvHQxpbqcGBe6nnuFKJK0/zgXHnow6KoUf",
+	"iiQYZX5LI7f13htp8hl+Kr2KvGFt0QaPKPDRHj00rnrUYl52QmmnaS/QdN0lmhygF64leQGHELJaYapo",
+	"lu+ZA6z3o5E5byfTXJ4jcHw55kcs1DJe51ORlivShq+kO7IuX3NXpkuflkGWqW2I6ZSTl6IeTyGUBBsx",
+	"qeoJlmKFuerZLl/p0c9cy8AmknEtT3EjxmOOIUQb8eCabpyyoRUB+tkmBQIJfFhX7NB3b+fPI/qHnBkd",
+	"+/V1g+SxhDaFcGieKSeKp7pd4fhZZUCLJfp8yk0NyeHPp20rW6Ubg0OySlrbsXhhhqN0BU4duf9v2F/M",
+	"cvhC6cx8ylxPmtcjb6ckAv9/X2enJb3G7NURjm3ghAOVSHleJSULod6HqXu8+rVNCnBX+EF72imWObUL",
+	"aqAN+Q6x1MyA+1xtcjFexiFUSSitpTmq1TwvzfCKpTJdOh4aSG7P7LBp+nQxNdMCS3DnkTpLThXXU8X1",
+	"JCqux5tuSJXpfP6JJk1BmcOmn3aesrU5GrrpNI6OKJztEzq6Sk/3mOOKYNruAHZOSkCpEdq0Hfk5hlSO",
+	"dg81I2xMlJ+BLbuDoydcbYPFQr9o0n53pJas8aBF0qOTd2Kpv9yVNNogHIpeAHtxP3RDzBE3znC3fh8n",
+	"CbY1L93Glg4daYo6eWDOXipo70AKrjp7GSvtOmxX2upNQ7TgTyOrSBqlduEwUgnfVPbjDeUyZMQgQuY1",
+	"dzVi6C144K0MJKdS4FTDPo65OGlDmPNnYB3qL/Kpjx7LnCdvvMRQLHrx8nRh3l1HitD1eK0xN2yfg7lM",
+	"GfEb6SrjvVQ6OO/OSRD2ot+zLrqd7yUsf4NYJv63fc5g37EXlPsvdPYNMXUk+Uqfa/mD5y62B7NJwMSh",
+	"OCSVepXtldDxy2uNFtZeXYhZTVCzxdV/Owjn1L3+bjIr5P3JgcNCsoCM9vxpaPSUDf8w2fDXxOOS8rF7",
+	"1A+agHyNwNMoa+IvZEnktks4Mu7s+MGMGENUnCaymHnk0NqeNATpvYOqesogpYPONXqzOmAC4inz+VEz",
+	"n9Mc29NxKUPIh4Ez3niwJ6ncHM0XzpNuBqTiLvLbjjsdt7Lm1qq+4+G3pT941qyasXvDyk1HzIiLyTyR",
+	"s5736kGvEB9bHyl1d4kyoLLn4S9y9pC0vacM9p1L3mV97iyk7Kghs6jOcDalK8w+O8QRXwztMMiPUj9N",
+	"fIjAQncg3V8uGaGmpjoRb+Ft6AXez66GaugM9hxwwp6hXtsxyCnMXcKX+Kw91o5f9Ip140md5B3djh0U",
+	"VG8HJXGazDrTKmRghIRjDmerSlkg9mW4WYGwFnxCNzFQVj3pvUP29NZgP4Xa6BH895TbnERuM2jvNCpP",
+	"9nhJx1YfhdYxGEp/XBXWYJEacQ1u/Ti+c9QzCI/HM9EszaThJJpODXcwnN5l+3EkQ+npJnjqa9bmAR9w",
+	"891TGiy8Zn2hEVKbBWCzLnzutwiGZQptIekymNDbUMdZbfWXPs8aJi1GREEfi7ipxR5rUxioD2Mokw70",
+	"qYxWKPsTo083kKk/4Cz6TBLrafPaYW0nvI74SB4mgoYfKliIswA0vQUdr1XXNRrQdh3kBrZl3h6D58Zu",
+	"2Vg2hRTJPaoNP/zgzhy01pEvxAi+sT4M3aSEM3e7xFq4IqRFMfqREhO2mxidkuK2EwOJKLVkOfck7mb8",
+	"GhPTuskMsxxgpcYeMsTFLpS0y+TQVoFMQMRMsm3OkiaeXsOv27XDtj5L9Ti7cbDe38MLfrmv6RqcMk1b",
+	"MymnpdgtJW60UsAMpS38TWqls1D6F7JCc3ICDqovnBjlfaH0LzhM/jklvxbkcwzV21nIUBSGigxdc2w/",
+	"vOnYYUF87K/A1WnIkhF9CV63aNOIHzRQN/5D4u6ABDXjokHO5GgLm8hxRTt/JfjsMwr+ARs7Z7DvMy2C",
+	"qQ/uGI7wih6CVh/dI72ckiRUuUMcBiTI/SR1Apw1r1CN6mmDZYihT2KkHMK9POy5fBsZYceXBQZfHsRj",
+	"AOdD1+TlkM+G2llENNTZN2p2EJYDx/HKNp3xH5cZ8XSkYUDHxv++izblkW+WET2g85rL+dSDnM/gSHJ+",
+	"cAem3Q8yExalezOGgk4PCfFG9fwM7XazV7V60je8vg+9sHK2LirPOAknp0te2nLSQixFhoZVnAbFnm4c",
+	"OccYXj8h3jHAJ0GvvHFgBoM2DYoSHCmzTwLNEFz11DlxwpwT3wIoVEqa2yVE3VEj+iPbQ8MWNA0w4F8q",
+	"zgcdXwlneNZkger0F9aPc1TJofkFn1bZhpRNJQGSt4OMLUHqDyohmsYXaBXec4bIMd0Q7cGAM+LSuK5E",
+	"Aw2EWohzZKSMV118nyDmA6IhgQkjXfh5jHh12K4l0jVrDsjZWsPG7sFSn+/UuAda86s4sVWUL0CnMX3q",
+	"p3wLzdTvoXmbmvbQl5puSq1G2U7SQSEGAtqh8SxaJIWJQr00o1DHITvYGhRZYqB2VzMjJIYzX99clGjp",
+	"EBpnJabHixPD24UViYql3AU5eWFCl7zwNvVWK4HynVdh9X0f8wjwVGU9qSqrkk1FGfmPFUaX55sYIFXm",
+	"gum4mCk/G0rwjfjuQ/ANqX5qxa4FzvC8Q6m8yua4v10WkQDzVgYhCLaQ8E9kmllk6vpqDezHNcBhcMqP",
+	"TvlRih/9I9NH6pER/R5t5OeaMifWPTCHmm+E7grHd1CYV48mKipNu6QdSe3UE7ed0kGdupfjiAl0Jirx",
+	"fNEwRcnHjx4PoV+pMB+CWXrpxVfW7LC8ZntV+N38+c3GTdMynbrt1pAZ3vy51CHctMxao2LjnY5nWma9",
+	"FTrQtDyMmdKwrDcDRxF5y6tfdMLQ9VaDt8+gVZBPFblTxnnyFDmq3NkkfskzFnoZPhZt4RhqGrP8ilfc",
+	"7x6YocpNnIZqMEWZEkmYN9ogs1rnNlTzwCxRLht37tgtMH5Fm1+5OUshm1XbUZ12mhqVRR5njdApezwh",
+	"7LEr8hDfRt9EmO6LvROvzF75YLakJPK3Amm4L+87BbMRxYT8H2q3qePuma30LOgfrK/gIJmzhD8WTEUC",
+	"52jsIIbvZkYh9XjI/l7iSN++JP4dp7/BkIidxN+7Z6CwBLnVYfvRFrlMnrMuiViUUDuYsksmQZvEMMgr",
+	"rdZvGfkTl1LTkgRkhRIM8XIY0cWRvtowLUTuqu+gsjusYh/aq8OHvzCIOGjmz1sWYQD/qfQ6Ve5PnHIf",
+	"cyZdsG8kRroeX7srAuuUnrxuxRfoZumCMhRIuj7dqrqhfOETx66Fa8ot1brryRdmb1GZ0I31/z8A4TQ3",
+	"eyIfAQA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,140 @@
+package assignment
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"avito-test-task/internal/domain"
+)
+
+// Mode - способ выбора ревьюверов среди подходящих кандидатов
+type Mode string
+
+const (
+	// ModeRandom - равновероятная выборка
+	ModeRandom Mode = "random"
+	// ModeLeastLoaded - сначала кандидаты с наименьшим числом открытых ревью, при равенстве случайно
+	ModeLeastLoaded Mode = "least_loaded"
+)
+
+// DefaultSource - источник политики, когда файл политики не задан
+const DefaultSource = "default"
+
+// Policy - настройки назначения ревьюверов, которые можно менять без перезапуска
+type Policy struct {
+	// Version задаётся в файле вручную; если её нет, версией становится хеш содержимого
+	Version string `yaml:"version"`
+	// ReviewersCount переопределяет число ревьюверов команды для новых PR, 0 - брать из команды
+	ReviewersCount int `yaml:"reviewers_count"`
+	// AllowPartial разрешает создать PR с меньшим числом ревьюверов, если кандидатов не хватает
+	AllowPartial bool `yaml:"allow_partial"`
+	Mode         Mode `yaml:"mode"`
+	// Fallback разрешает брать ревьюверов из соседних и вышестоящих команд, если в команде PR нет ни одного кандидата
+	Fallback bool `yaml:"fallback"`
+	// Roles - правила состава ревьюверов по ролям; проверяются при назначении, доборе и переназначении
+	Roles RoleRules `yaml:"roles"`
+}
+
+// DefaultPolicy повторяет поведение сервиса до появления политик
+func DefaultPolicy() Policy {
+	return Policy{Version: DefaultSource, AllowPartial: true, Mode: ModeRandom}
+}
+
+func (p Policy) Validate() error {
+	var errs []error
+	if p.ReviewersCount != 0 && (p.ReviewersCount < domain.MinReviewersCount || p.ReviewersCount > domain.MaxReviewersCount) {
+		errs = append(errs, fmt.Errorf("reviewers_count must be 0 or between %d and %d",
+			domain.MinReviewersCount, domain.MaxReviewersCount))
+	}
+	if p.Mode != ModeRandom && p.Mode != ModeLeastLoaded {
+		errs = append(errs, fmt.Errorf("mode: %q is not one of [%s %s]", p.Mode, ModeRandom, ModeLeastLoaded))
+	}
+	return errors.Join(errs...)
+}
+
+// ParsePolicy читает политику из YAML; незаданные поля берутся из DefaultPolicy
+func ParsePolicy(data []byte) (Policy, error) {
+	policy := DefaultPolicy()
+	policy.Version = ""
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil && !errors.Is(err, io.EOF) {
+		return Policy{}, err
+	}
+	if err := policy.Validate(); err != nil {
+		return Policy{}, err
+	}
+
+	if policy.Version == "" {
+		sum := sha256.Sum256(data)
+		policy.Version = "sha256:" + hex.EncodeToString(sum[:6])
+	}
+	return policy, nil
+}
+
+// ActivePolicy - политика вместе с тем, откуда и когда она загружена
+type ActivePolicy struct {
+	Policy
+	Source   string
+	LoadedAt time.Time
+}
+
+// PolicyStatus - активная политика и последняя неудачная перезагрузка, если она была после успешной
+type PolicyStatus struct {
+	Active      *ActivePolicy
+	LastError   error
+	LastErrorAt time.Time
+}
+
+// PolicyStore хранит активную политику. Запрос берёт политику один раз через Current и работает с ней до конца,
+// поэтому замена политики не влияет на запросы, которые уже выполняются
+type PolicyStore struct {
+	current atomic.Pointer[ActivePolicy]
+
+	mu          sync.Mutex
+	lastError   error
+	lastErrorAt time.Time
+}
+
+func NewPolicyStore(initial *ActivePolicy) *PolicyStore {
+	s := &PolicyStore{}
+	s.current.Store(initial)
+	return s
+}
+
+func (s *PolicyStore) Current() *ActivePolicy {
+	return s.current.Load()
+}
+
+// Set атомарно заменяет активную политику и сбрасывает ошибку прошлой перезагрузки
+func (s *PolicyStore) Set(policy *ActivePolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current.Store(policy)
+	s.lastError = nil
+	s.lastErrorAt = time.Time{}
+}
+
+// SetError запоминает неудачную перезагрузку; активная политика при этом не меняется
+func (s *PolicyStore) SetError(err error, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastError = err
+	s.lastErrorAt = at
+}
+
+func (s *PolicyStore) Status() PolicyStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return PolicyStatus{Active: s.current.Load(), LastError: s.lastError, LastErrorAt: s.lastErrorAt}
+}
@@ -0,0 +1,168 @@
+package assignment
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Policy
+		wantErr string
+	}{
+		{
+			name: "explicit version and fields",
+			data: "version: v2\nreviewers_count: 3\nallow_partial: false\nmode: least_loaded\nfallback: true\n",
+			want: Policy{Version: "v2", ReviewersCount: 3, AllowPartial: false, Mode: ModeLeastLoaded, Fallback: true},
+		},
+		{
+			name: "missing fields fall back to defaults",
+			data: "version: v1\nreviewers_count: 1\n",
+			want: Policy{Version: "v1", ReviewersCount: 1, AllowPartial: true, Mode: ModeRandom},
+		},
+		{
+			name: "role rules",
+			data: "version: v3\nroles:\n  require_senior: true\n  leads_as_fallback: true\n",
+			want: Policy{Version: "v3", AllowPartial: true, Mode: ModeRandom, Roles: RoleRules{RequireSenior: true, LeadsAsFallback: true}},
+		},
+		{name: "unknown mode", data: "mode: round_robin\n", wantErr: "mode"},
+		{name: "reviewers count out of range", data: "reviewers_count: 11\n", wantErr: "reviewers_count"},
+		{name: "unknown key", data: "allow_partitial: true\n", wantErr: "allow_partitial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolicy([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParsePolicy() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePolicy() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePolicy_VersionFromContent(t *testing.T) {
+	first, _ := ParsePolicy([]byte("mode: random\n"))
+	same, _ := ParsePolicy([]byte("mode: random\n"))
+	changed, _ := ParsePolicy([]byte("mode: least_loaded\n"))
+
+	if !strings.HasPrefix(first.Version, "sha256:") {
+		t.Fatalf("Version = %q, want content hash", first.Version)
+	}
+	if first.Version != same.Version {
+		t.Errorf("Same content gives different versions: %s vs %s", first.Version, same.Version)
+	}
+	if first.Version == changed.Version {
+		t.Error("Changed content should change version")
+	}
+}
+
+func TestPolicyReloader_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yml")
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	write("version: v1\nmode: random\n")
+	active, err := LoadPolicyFile(path, clock)
+	if err != nil {
+		t.Fatalf("LoadPolicyFile() error = %v", err)
+	}
+	store := NewPolicyStore(active)
+	reloader := NewPolicyReloader(path, store, clock)
+
+	// запрос, начавшийся до перезагрузки, продолжает работать со своей версией
+	inFlight := store.Current()
+
+	now = now.Add(time.Minute)
+	write("version: v2\nmode: least_loaded\n")
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if got := store.Current(); got.Version != "v2" || got.Mode != ModeLeastLoaded || !got.LoadedAt.Equal(now) {
+		t.Errorf("Current() = %+v, want v2 loaded at %v", got, now)
+	}
+	if inFlight.Version != "v1" || inFlight.Mode != ModeRandom {
+		t.Errorf("In-flight policy changed to %+v", inFlight)
+	}
+
+	write("version: v3\nmode: fastest\n")
+	if err := reloader.Reload(); err == nil {
+		t.Fatal("Reload() of invalid policy should fail")
+	}
+	status := store.Status()
+	if status.Active.Version != "v2" {
+		t.Errorf("Active version = %s, invalid reload must keep v2", status.Active.Version)
+	}
+	if status.LastError == nil || !status.LastErrorAt.Equal(now) {
+		t.Errorf("Status() = %+v, want last reload error", status)
+	}
+
+	write("version: v3\nmode: random\n")
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if status := store.Status(); status.Active.Version != "v3" || status.LastError != nil {
+		t.Errorf("Status() = %+v, want v3 without error", status)
+	}
+}
+
+func TestLeastLoadedReviewers(t *testing.T) {
+	var candidates []*domain.User
+	for i := 1; i <= 5; i++ {
+		candidates = append(candidates, &domain.User{ID: fmt.Sprintf("user_%d", i), IsActive: true, Capacity: domain.MaxCapacity})
+	}
+	candidates[3].IsActive = false
+	load := map[string]int{"user_1": 3, "user_2": 1, "user_3": 0, "user_4": 0, "user_5": 1}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		got := LeastLoadedReviewers(rnd, candidates, load, "user_3", nil, 2)
+		// user_3 - автор, user_4 неактивен: остаются user_2 и user_5 с нагрузкой 1
+		if len(got) != 2 || !reflect.DeepEqual(map[string]bool{got[0]: true, got[1]: true},
+			map[string]bool{"user_2": true, "user_5": true}) {
+			t.Fatalf("LeastLoadedReviewers() = %v, want user_2 and user_5", got)
+		}
+	}
+
+	firstPicks := make(map[string]int)
+	for i := 0; i < 200; i++ {
+		got := LeastLoadedReviewers(rnd, candidates, load, "user_3", nil, 1)
+		firstPicks[got[0]]++
+	}
+	if firstPicks["user_2"] == 0 || firstPicks["user_5"] == 0 || firstPicks["user_1"] != 0 {
+		t.Errorf("Picks = %v, equal load should be chosen randomly and busier users never", firstPicks)
+	}
+
+	// с Capacity 50 одно ревью user_5 весит как два: он идёт после user_2, но раньше user_1 с тремя ревью
+	candidates[4].Capacity = 50
+	for i := 0; i < 50; i++ {
+		got := LeastLoadedReviewers(rnd, candidates, load, "user_3", nil, 3)
+		if !reflect.DeepEqual(got, []string{"user_2", "user_5", "user_1"}) {
+			t.Fatalf("LeastLoadedReviewers() = %v, want user_2, then half-capacity user_5, then user_1", got)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// PolicyReloader перечитывает файл политики и подменяет политику в PolicyStore
+type PolicyReloader struct {
+	path  string
+	store *PolicyStore
+	now   func() time.Time
+
+	modTime time.Time
+}
+
+func NewPolicyReloader(path string, store *PolicyStore, now func() time.Time) *PolicyReloader {
+	return &PolicyReloader{path: path, store: store, now: now}
+}
+
+// LoadPolicyFile загружает политику из файла для старта сервиса, ошибка здесь должна останавливать запуск
+func LoadPolicyFile(path string, now func() time.Time) (*ActivePolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read assignment policy: %w", err)
+	}
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse assignment policy %s: %w", path, err)
+	}
+	return &ActivePolicy{Policy: policy, Source: path, LoadedAt: now()}, nil
+}
+
+// Reload перечитывает файл; при ошибке остаётся прежняя политика, а ошибка видна в PolicyStore.Status
+func (r *PolicyReloader) Reload() error {
+	if info, err := os.Stat(r.path); err == nil {
+		r.modTime = info.ModTime()
+	}
+
+	active, err := LoadPolicyFile(r.path, r.now)
+	if err != nil {
+		log.Printf("Assignment policy reload failed, keeping version %s: %v", r.store.Current().Version, err)
+		r.store.SetError(err, r.now())
+		return err
+	}
+
+	previous := r.store.Current()
+	if previous.Version == active.Version && previous.Policy == active.Policy {
+		log.Printf("Assignment policy %s is unchanged", active.Version)
+		r.store.Set(previous)
+		return nil
+	}
+
+	r.store.Set(active)
+	log.Printf("Assignment policy reloaded: version %s -> %s (reviewers_count=%d allow_partial=%t mode=%s fallback=%t roles=%+v)",
+		previous.Version, active.Version, active.ReviewersCount, active.AllowPartial, active.Mode, active.Fallback, active.Roles)
+	return nil
+}
+
+// Watch перезагружает политику по сигналу из signals и, если interval > 0, при изменении времени модификации файла
+func (r *PolicyReloader) Watch(ctx context.Context, signals <-chan os.Signal, interval time.Duration) {
+	if info, err := os.Stat(r.path); err == nil {
+		r.modTime = info.ModTime()
+	}
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-signals:
+			log.Printf("Received %v, reloading assignment policy", sig)
+			r.Reload()
+		case <-tick:
+			info, err := os.Stat(r.path)
+			if err != nil || info.ModTime().Equal(r.modTime) {
+				continue
+			}
+			log.Printf("Assignment policy file %s changed, reloading", r.path)
+			r.Reload()
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package assignment
+
+import "avito-test-task/internal/domain"
+
+// RoleRules - правила состава ревьюверов по ролям участников в команде PR; все выключены по умолчанию
+type RoleRules struct {
+	// RequireSenior - среди ревьюверов PR должен быть хотя бы один senior или lead
+	RequireSenior bool `yaml:"require_senior"`
+	// NoSoleTrainee - ревьюверы PR не могут быть одними стажёрами
+	NoSoleTrainee bool `yaml:"no_sole_trainee"`
+	// LeadsAsFallback - лиды назначаются, только если остальных кандидатов не хватает
+	LeadsAsFallback bool `yaml:"leads_as_fallback"`
+}
+
+func (r RoleRules) Enabled() bool {
+	return r.RequireSenior || r.NoSoleTrainee || r.LeadsAsFallback
+}
+
+// ApplyRoleRules выбирает до n ревьюверов из ordered (кандидаты в порядке предпочтения способа выбора) так,
+// чтобы вместе с уже назначенными kept они удовлетворяли правилам. Если нужного кандидата нет, возвращает
+// ErrReviewerRules. roles - роли кандидатов и назначенных, отсутствующий в roles считается member
+func ApplyRoleRules(rules RoleRules, ordered []string, roles map[string]domain.MemberRole, kept []string, n int) ([]string, error) {
+	pool := ordered
+	if rules.LeadsAsFallback {
+		pool = make([]string, 0, len(ordered))
+		for _, id := range ordered {
+			if roles[id] != domain.MemberRoleLead {
+				pool = append(pool, id)
+			}
+		}
+		for _, id := range ordered {
+			if roles[id] == domain.MemberRoleLead {
+				pool = append(pool, id)
+			}
+		}
+	}
+	if n > len(pool) {
+		n = len(pool)
+	}
+	if n == 0 {
+		return []string{}, nil
+	}
+
+	chosen := append([]string{}, pool[:n]...)
+	rest := pool[n:]
+	reviewers := func() []string { return append(append([]string{}, kept...), chosen...) }
+
+	// замену получает последний выбранный: он наименее предпочтителен для способа выбора
+	if rules.RequireSenior && !anyRole(reviewers(), roles, domain.MemberRole.IsSenior) {
+		i := firstRole(rest, roles, domain.MemberRole.IsSenior)
+		if i < 0 {
+			return nil, domain.ErrReviewerRules
+		}
+		chosen[n-1] = rest[i]
+	}
+
+	notTrainee := func(r domain.MemberRole) bool { return r != domain.MemberRoleTrainee }
+	if rules.NoSoleTrainee && !anyRole(reviewers(), roles, notTrainee) {
+		i := firstRole(rest, roles, notTrainee)
+		if i < 0 {
+			return nil, domain.ErrReviewerRules
+		}
+		chosen[n-1] = rest[i]
+	}
+
+	return chosen, nil
+}
+
+func roleOf(roles map[string]domain.MemberRole, id string) domain.MemberRole {
+	if role, ok := roles[id]; ok && role != "" {
+		return role
+	}
+	return domain.MemberRoleMember
+}
+
+func anyRole(ids []string, roles map[string]domain.MemberRole, match func(domain.MemberRole) bool) bool {
+	return firstRole(ids, roles, match) >= 0
+}
+
+func firstRole(ids []string, roles map[string]domain.MemberRole, match func(domain.MemberRole) bool) int {
+	for i, id := range ids {
+		if match(roleOf(roles, id)) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,126 @@
+package assignment
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"avito-test-task/internal/domain"
+)
+
+func TestApplyRoleRules(t *testing.T) {
+	roles := map[string]domain.MemberRole{
+		"lead":     domain.MemberRoleLead,
+		"senior":   domain.MemberRoleSenior,
+		"member":   domain.MemberRoleMember,
+		"trainee1": domain.MemberRoleTrainee,
+		"trainee2": domain.MemberRoleTrainee,
+	}
+
+	tests := []struct {
+		name    string
+		rules   RoleRules
+		ordered []string
+		kept    []string
+		n       int
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "rules off keep the order",
+			ordered: []string{"lead", "trainee1", "senior"},
+			n:       2,
+			want:    []string{"lead", "trainee1"},
+		},
+		{
+			name:    "leads go after everyone else",
+			rules:   RoleRules{LeadsAsFallback: true},
+			ordered: []string{"lead", "trainee1", "member"},
+			n:       2,
+			want:    []string{"trainee1", "member"},
+		},
+		{
+			name:    "lead fills the gap when others are not enough",
+			rules:   RoleRules{LeadsAsFallback: true},
+			ordered: []string{"lead", "member"},
+			n:       2,
+			want:    []string{"member", "lead"},
+		},
+		{
+			name:    "senior replaces the least preferred pick",
+			rules:   RoleRules{RequireSenior: true},
+			ordered: []string{"member", "trainee1", "senior"},
+			n:       2,
+			want:    []string{"member", "senior"},
+		},
+		{
+			name:    "senior already kept on the PR",
+			rules:   RoleRules{RequireSenior: true},
+			ordered: []string{"member", "trainee1"},
+			kept:    []string{"senior"},
+			n:       1,
+			want:    []string{"member"},
+		},
+		{
+			name:    "senior preferred over lead when leads are fallback",
+			rules:   RoleRules{RequireSenior: true, LeadsAsFallback: true},
+			ordered: []string{"lead", "member", "trainee1", "senior"},
+			n:       2,
+			want:    []string{"member", "senior"},
+		},
+		{
+			name:    "no senior available",
+			rules:   RoleRules{RequireSenior: true},
+			ordered: []string{"member", "trainee1"},
+			n:       2,
+			wantErr: domain.ErrReviewerRules,
+		},
+		{
+			name:    "trainee is never the sole reviewer",
+			rules:   RoleRules{NoSoleTrainee: true},
+			ordered: []string{"trainee1", "member"},
+			n:       1,
+			want:    []string{"member"},
+		},
+		{
+			name:    "two trainees are still only trainees",
+			rules:   RoleRules{NoSoleTrainee: true},
+			ordered: []string{"trainee1", "trainee2", "member"},
+			n:       2,
+			want:    []string{"trainee1", "member"},
+		},
+		{
+			name:    "trainee with a kept member",
+			rules:   RoleRules{NoSoleTrainee: true},
+			ordered: []string{"trainee1"},
+			kept:    []string{"member"},
+			n:       1,
+			want:    []string{"trainee1"},
+		},
+		{
+			name:    "only trainees available",
+			rules:   RoleRules{NoSoleTrainee: true},
+			ordered: []string{"trainee1", "trainee2"},
+			n:       1,
+			wantErr: domain.ErrReviewerRules,
+		},
+		{
+			name:  "no candidates is not a rules violation",
+			rules: RoleRules{RequireSenior: true},
+			n:     2,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyRoleRules(tt.rules, tt.ordered, roles, tt.kept, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ApplyRoleRules() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyRoleRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
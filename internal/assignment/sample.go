@@ -0,0 +1,127 @@
+package assignment
+
+import (
+	"sort"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// Intner - источник случайных индексов в диапазоне [0, n)
+type Intner interface {
+	Intn(n int) int
+}
+
+// SampleReviewers выбирает до n разных ревьюверов из candidates без возвращения с вероятностью, пропорциональной Capacity;
+// при одинаковой Capacity выборка равновероятна. Автор, неактивные пользователи, пользователи с нулевой Capacity
+// и пользователи из exclude не выбираются никогда
+func SampleReviewers(rnd Intner, candidates []*domain.User, authorID string, exclude []string, n int) []string {
+	pool := Eligible(candidates, authorID, exclude)
+	if n > len(pool) {
+		n = len(pool)
+	}
+
+	if equalCapacity(pool) {
+		// частичный Fisher-Yates: первые n элементов pool становятся выборкой
+		for i := 0; i < n; i++ {
+			j := i + rnd.Intn(len(pool)-i)
+			pool[i], pool[j] = pool[j], pool[i]
+		}
+	} else {
+		// тот же обмен, но j выбирается среди оставшихся с весом Capacity
+		total := 0
+		for _, candidate := range pool {
+			total += candidate.Capacity
+		}
+		for i := 0; i < n; i++ {
+			j, r := i, rnd.Intn(total)
+			for r >= pool[j].Capacity {
+				r -= pool[j].Capacity
+				j++
+			}
+			total -= pool[j].Capacity
+			pool[i], pool[j] = pool[j], pool[i]
+		}
+	}
+
+	reviewers := make([]string, n)
+	for i := 0; i < n; i++ {
+		reviewers[i] = pool[i].ID
+	}
+	return reviewers
+}
+
+// LeastLoadedReviewers выбирает до n кандидатов с наименьшей нагрузкой load (число открытых ревью) относительно Capacity:
+// при Capacity 50 одно открытое ревью весит как два у кандидата с полной Capacity.
+// Кандидаты с одинаковой нагрузкой выбираются равновероятно. Ограничения те же, что у SampleReviewers
+func LeastLoadedReviewers(rnd Intner, candidates []*domain.User, load map[string]int, authorID string, exclude []string, n int) []string {
+	return firstBy(rnd, Eligible(candidates, authorID, exclude), n, func(a, b *domain.User) bool {
+		// load[a]/a.Capacity < load[b]/b.Capacity без деления; Capacity у кандидатов после Eligible больше нуля
+		return load[a.ID]*b.Capacity < load[b.ID]*a.Capacity
+	})
+}
+
+// RotationReviewers выбирает до n кандидатов, которые дольше всех не ревьюили автора с поправкой на Capacity:
+// lastReviewed - последнее назначение на PR автора, простой до now умножается на Capacity, поэтому при доле 25
+// очередь подходит вчетверо реже, чем при полной. Кандидаты без назначений идут первыми.
+// Равные выбираются равновероятно, ограничения те же, что у SampleReviewers
+func RotationReviewers(rnd Intner, candidates []*domain.User, lastReviewed map[string]time.Time, now time.Time, authorID string, exclude []string, n int) []string {
+	return firstBy(rnd, Eligible(candidates, authorID, exclude), n, func(a, b *domain.User) bool {
+		lastA, okA := lastReviewed[a.ID]
+		lastB, okB := lastReviewed[b.ID]
+		if !okA || !okB {
+			return !okA && okB
+		}
+		return now.Sub(lastA).Seconds()*float64(a.Capacity) > now.Sub(lastB).Seconds()*float64(b.Capacity)
+	})
+}
+
+// firstBy возвращает первые n id pool в порядке less, порядок равных случаен
+func firstBy(rnd Intner, pool []*domain.User, n int, less func(a, b *domain.User) bool) []string {
+	if n > len(pool) {
+		n = len(pool)
+	}
+
+	// перемешивание до устойчивой сортировки делает порядок внутри равных случайным
+	for i := len(pool) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	sort.SliceStable(pool, func(i, j int) bool {
+		return less(pool[i], pool[j])
+	})
+
+	reviewers := make([]string, n)
+	for i := 0; i < n; i++ {
+		reviewers[i] = pool[i].ID
+	}
+	return reviewers
+}
+
+// Eligible оставляет активных кандидатов с ненулевой Capacity без автора, без исключённых и без повторов
+func Eligible(candidates []*domain.User, authorID string, exclude []string) []*domain.User {
+	skip := make(map[string]bool, len(exclude)+1)
+	skip[authorID] = true
+	for _, id := range exclude {
+		skip[id] = true
+	}
+
+	pool := make([]*domain.User, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate == nil || !candidate.IsActive || candidate.Capacity <= 0 || skip[candidate.ID] {
+			continue
+		}
+		skip[candidate.ID] = true
+		pool = append(pool, candidate)
+	}
+	return pool
+}
+
+func equalCapacity(pool []*domain.User) bool {
+	for _, candidate := range pool {
+		if candidate.Capacity != pool[0].Capacity {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,279 @@
+package assignment
+
+import (
+	"avito-test-task/internal/domain"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// randomTeam строит команду со случайной активностью и Capacity участников, автором и уже назначенными ревьюверами
+func randomTeam(rnd *rand.Rand, size int) (candidates []*domain.User, authorID string, exclude []string) {
+	for i := 0; i < size; i++ {
+		candidates = append(candidates, &domain.User{
+			ID:       fmt.Sprintf("user_%d", i),
+			IsActive: rnd.Intn(4) != 0,
+			// нулевая Capacity проверяет исключение кандидата, неполная - взвешенную выборку
+			Capacity: []int{0, 30, domain.MaxCapacity, domain.MaxCapacity, domain.MaxCapacity}[rnd.Intn(5)],
+		})
+	}
+	if size > 0 {
+		authorID = candidates[rnd.Intn(size)].ID
+		for i := rnd.Intn(3); i > 0; i-- {
+			exclude = append(exclude, candidates[rnd.Intn(size)].ID)
+		}
+	}
+	// повторы в выдаче репозитория не должны приводить к повторам в выборке
+	if size > 1 && rnd.Intn(2) == 0 {
+		candidates = append(candidates, candidates[rnd.Intn(size)])
+	}
+	return candidates, authorID, exclude
+}
+
+func TestSampleReviewers_Invariants(t *testing.T) {
+	property := func(seed int64, size uint8, n uint8) bool {
+		rnd := rand.New(rand.NewSource(seed))
+		candidates, authorID, exclude := randomTeam(rnd, int(size%12))
+		want := int(n % 5)
+
+		got := SampleReviewers(rnd, candidates, authorID, exclude, want)
+
+		byID := make(map[string]*domain.User)
+		for _, c := range candidates {
+			byID[c.ID] = c
+		}
+		excluded := make(map[string]bool)
+		for _, id := range exclude {
+			excluded[id] = true
+		}
+
+		seen := make(map[string]bool)
+		for _, id := range got {
+			switch {
+			case id == authorID:
+				t.Logf("seed %d: author %s selected", seed, id)
+				return false
+			case byID[id] == nil || !byID[id].IsActive || byID[id].Capacity <= 0:
+				t.Logf("seed %d: inactive, zero capacity or unknown %s selected", seed, id)
+				return false
+			case excluded[id]:
+				t.Logf("seed %d: excluded %s selected", seed, id)
+				return false
+			case seen[id]:
+				t.Logf("seed %d: duplicate %s selected", seed, id)
+				return false
+			}
+			seen[id] = true
+		}
+
+		expected := len(Eligible(candidates, authorID, exclude))
+		if expected > want {
+			expected = want
+		}
+		if len(got) != expected {
+			t.Logf("seed %d: got %d reviewers, want %d", seed, len(got), expected)
+			return false
+		}
+		return true
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000, Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSampleReviewers_AtMostTwo(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name      string
+		active    int
+		wantCount int
+	}{
+		{name: "no candidates", active: 0, wantCount: 0},
+		{name: "single candidate", active: 1, wantCount: 1},
+		{name: "exactly two candidates", active: 2, wantCount: 2},
+		{name: "many candidates", active: 7, wantCount: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := []*domain.User{{ID: "author", IsActive: true, Capacity: domain.MaxCapacity}}
+			for i := 0; i < tt.active; i++ {
+				candidates = append(candidates, &domain.User{ID: fmt.Sprintf("user_%d", i), IsActive: true, Capacity: domain.MaxCapacity})
+			}
+
+			got := SampleReviewers(rnd, candidates, "author", nil, 2)
+			if len(got) != tt.wantCount {
+				t.Errorf("SampleReviewers() returned %d reviewers, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestSampleReviewers_UniformDistribution(t *testing.T) {
+	const (
+		runs      = 60000
+		tolerance = 0.05
+	)
+
+	rnd := rand.New(rand.NewSource(42))
+	candidates := []*domain.User{
+		{ID: "author", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "user_1", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "user_2", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "user_3", IsActive: false, Capacity: domain.MaxCapacity},
+		{ID: "user_4", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "user_5", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "user_6", IsActive: true, Capacity: domain.MaxCapacity},
+	}
+	eligible := []string{"user_1", "user_2", "user_4", "user_5", "user_6"}
+
+	picks := make(map[string]int)
+	pairs := make(map[string]int)
+	for i := 0; i < runs; i++ {
+		got := SampleReviewers(rnd, candidates, "author", nil, 2)
+		if len(got) != 2 {
+			t.Fatalf("Expected 2 reviewers, got %v", got)
+		}
+		picks[got[0]]++
+		picks[got[1]]++
+
+		a, b := got[0], got[1]
+		if a > b {
+			a, b = b, a
+		}
+		pairs[a+"+"+b]++
+	}
+
+	// каждый из 5 кандидатов попадает в пару с вероятностью 2/5
+	wantPicks := float64(runs) * 2 / float64(len(eligible))
+	for _, id := range eligible {
+		if deviation := math.Abs(float64(picks[id])-wantPicks) / wantPicks; deviation > tolerance {
+			t.Errorf("Candidate %s picked %d times, want ~%.0f (deviation %.3f)", id, picks[id], wantPicks, deviation)
+		}
+	}
+
+	// все 10 пар равновероятны
+	wantPairs := float64(runs) / 10
+	if len(pairs) != 10 {
+		t.Errorf("Expected 10 distinct pairs, got %d", len(pairs))
+	}
+	for pair, count := range pairs {
+		if deviation := math.Abs(float64(count)-wantPairs) / wantPairs; deviation > tolerance {
+			t.Errorf("Pair %s picked %d times, want ~%.0f (deviation %.3f)", pair, count, wantPairs, deviation)
+		}
+	}
+}
+
+func TestSampleReviewers_CapacityWeights(t *testing.T) {
+	const (
+		runs      = 40000
+		tolerance = 0.05
+	)
+
+	rnd := rand.New(rand.NewSource(7))
+	candidates := []*domain.User{
+		{ID: "full", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "half", IsActive: true, Capacity: 50},
+		{ID: "quarter", IsActive: true, Capacity: 25},
+		{ID: "away", IsActive: true, Capacity: 0},
+	}
+
+	picks := make(map[string]int)
+	for i := 0; i < runs; i++ {
+		got := SampleReviewers(rnd, candidates, "author", nil, 1)
+		picks[got[0]]++
+	}
+
+	if picks["away"] != 0 {
+		t.Errorf("Candidate with zero capacity picked %d times", picks["away"])
+	}
+	// вероятность выбора одного ревьювера пропорциональна Capacity: 100, 50 и 25 из 175
+	for id, weight := range map[string]int{"full": 100, "half": 50, "quarter": 25} {
+		want := float64(runs) * float64(weight) / 175
+		if deviation := math.Abs(float64(picks[id])-want) / want; deviation > tolerance {
+			t.Errorf("Candidate %s picked %d times, want ~%.0f (deviation %.3f)", id, picks[id], want, deviation)
+		}
+	}
+
+	// при выборе всех кандидатов попадают все с ненулевой Capacity
+	got := SampleReviewers(rnd, candidates, "author", nil, 4)
+	if len(got) != 3 {
+		t.Errorf("SampleReviewers() = %v, want all three candidates with capacity", got)
+	}
+}
+
+func TestRotationReviewers(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candidates := []*domain.User{
+		{ID: "author", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "recent", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "old", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "never", IsActive: true, Capacity: domain.MaxCapacity},
+		{ID: "inactive", IsActive: false, Capacity: domain.MaxCapacity},
+		{ID: "excluded", IsActive: true, Capacity: domain.MaxCapacity},
+	}
+	lastReviewed := map[string]time.Time{
+		"recent":   base.Add(48 * time.Hour),
+		"old":      base,
+		"inactive": base.Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{name: "never reviewed first", n: 1, want: []string{"never"}},
+		{name: "then least recent", n: 2, want: []string{"never", "old"}},
+		{name: "all eligible", n: 5, want: []string{"never", "old", "recent"}},
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RotationReviewers(rnd, candidates, lastReviewed, base.Add(72*time.Hour), "author", []string{"excluded"}, tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RotationReviewers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("ties are random", func(t *testing.T) {
+		counts := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			got := RotationReviewers(rnd, candidates, nil, base, "author", []string{"inactive", "excluded"}, 1)
+			counts[got[0]]++
+		}
+		for _, id := range []string{"recent", "old", "never"} {
+			if counts[id] < 250 {
+				t.Errorf("candidate %s picked %d times of 1000, want roughly a third", id, counts[id])
+			}
+		}
+	})
+
+	t.Run("idle time is weighted by capacity", func(t *testing.T) {
+		candidates := []*domain.User{
+			{ID: "part_time", IsActive: true, Capacity: 25},
+			{ID: "full_time", IsActive: true, Capacity: domain.MaxCapacity},
+		}
+		now := base.Add(72 * time.Hour)
+		// part_time простаивает втрое дольше, но при доле 25 это меньше суток простоя full_time
+		lastReviewed := map[string]time.Time{"part_time": base, "full_time": now.Add(-24 * time.Hour)}
+
+		got := RotationReviewers(rnd, candidates, lastReviewed, now, "author", nil, 2)
+		if want := []string{"full_time", "part_time"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("RotationReviewers() = %v, want %v", got, want)
+		}
+
+		// после вчетверо большего простоя part_time снова в очереди первым
+		lastReviewed["part_time"] = now.Add(-100 * time.Hour)
+		if got := RotationReviewers(rnd, candidates, lastReviewed, now, "author", nil, 1); got[0] != "part_time" {
+			t.Errorf("RotationReviewers() = %v, want part_time first", got)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package assignment
+
+// PreferTags переставляет ordered (кандидаты в порядке предпочтения способа выбора) так, чтобы первые n
+// покрывали как можно больше тегов required. Жадно берётся кандидат, у которого больше всего ещё не покрытых тегов,
+// при равенстве - стоящий в ordered раньше; когда покрывать больше нечем, остальные идут в исходном порядке.
+// tags - теги кандидатов
+func PreferTags(ordered []string, tags map[string][]string, required []string, n int) []string {
+	uncovered := make(map[string]bool, len(required))
+	for _, tag := range required {
+		uncovered[tag] = true
+	}
+
+	result := make([]string, 0, len(ordered))
+	taken := make(map[string]bool, n)
+	for len(result) < n && len(uncovered) > 0 {
+		best, bestCount := "", 0
+		for _, id := range ordered {
+			if taken[id] {
+				continue
+			}
+			count := 0
+			for _, tag := range tags[id] {
+				if uncovered[tag] {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = id, count
+			}
+		}
+		if bestCount == 0 {
+			break
+		}
+
+		taken[best] = true
+		result = append(result, best)
+		for _, tag := range tags[best] {
+			delete(uncovered, tag)
+		}
+	}
+
+	for _, id := range ordered {
+		if !taken[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// UncoveredTags возвращает теги required, которых нет ни у одного из reviewers, в порядке required
+func UncoveredTags(required []string, reviewers []string, tags map[string][]string) []string {
+	covered := make(map[string]bool)
+	for _, id := range reviewers {
+		for _, tag := range tags[id] {
+			covered[tag] = true
+		}
+	}
+
+	uncovered := []string{}
+	for _, tag := range required {
+		if !covered[tag] {
+			uncovered = append(uncovered, tag)
+		}
+	}
+	return uncovered
+}
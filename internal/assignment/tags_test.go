@@ -0,0 +1,84 @@
+package assignment
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPreferTags(t *testing.T) {
+	tags := map[string][]string{
+		"go":        {"go"},
+		"fullstack": {"frontend", "go"},
+		"dba":       {"postgres"},
+		"designer":  {"frontend"},
+	}
+	ordered := []string{"none", "go", "designer", "dba", "fullstack"}
+
+	tests := []struct {
+		name     string
+		required []string
+		n        int
+		want     []string
+	}{
+		{
+			name: "no tags keep the order",
+			n:    2,
+			want: ordered,
+		},
+		{
+			name:     "candidate covering more tags goes first",
+			required: []string{"frontend", "go"},
+			n:        2,
+			want:     []string{"fullstack", "none", "go", "designer", "dba"},
+		},
+		{
+			name:     "each slot covers a new tag",
+			required: []string{"go", "postgres"},
+			n:        2,
+			want:     []string{"go", "dba", "none", "designer", "fullstack"},
+		},
+		{
+			name:     "equal coverage keeps the order",
+			required: []string{"frontend"},
+			n:        1,
+			want:     []string{"designer", "none", "go", "dba", "fullstack"},
+		},
+		{
+			name:     "slots limit coverage",
+			required: []string{"go", "postgres", "frontend"},
+			n:        1,
+			want:     []string{"fullstack", "none", "go", "designer", "dba"},
+		},
+		{
+			name:     "tag nobody has",
+			required: []string{"rust"},
+			n:        2,
+			want:     ordered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PreferTags(ordered, tags, tt.required, tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PreferTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUncoveredTags(t *testing.T) {
+	tags := map[string][]string{
+		"alice": {"go", "postgres"},
+		"bob":   {"frontend"},
+	}
+
+	got := UncoveredTags([]string{"rust", "go", "frontend", "k8s"}, []string{"alice", "bob"}, tags)
+	if want := []string{"rust", "k8s"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UncoveredTags() = %v, want %v", got, want)
+	}
+
+	if got := UncoveredTags(nil, []string{"alice"}, tags); len(got) != 0 || got == nil {
+		t.Errorf("UncoveredTags() without required tags = %#v, want empty slice", got)
+	}
+}
@@ -1,38 +1,408 @@
 package config
 
 import (
+	"errors"
 	"fmt"
-	"os"
+	"io"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"avito-test-task/internal/ratelimit"
+)
+
+const redactedValue = "******"
+
+var (
+	// режимы, которые поддерживает lib/pq
+	sslModes  = []string{"disable", "require", "verify-ca", "verify-full"}
+	logLevels = []string{"debug", "info", "warn", "error"}
+	// совпадает с domain.Locales
+	notifyLocales = []string{"ru", "en"}
+	// совпадает с ключами publish.SchemaVersions
+	publishEventTypes = []string{"PRCreated", "ReviewerReplaced", "PRMerged", "UserActivityChanged", "TeamCreated"}
+	publisherBackends = []string{"none", "log", "nats", "kafka"}
 )
 
+// Config собирается слоями: значения по умолчанию -> YAML-файл -> переменные окружения -> флаги
 type Config struct {
-	DBHost     string
-	DBPort     string
-	DBName     string
-	DBUser     string
-	DBPassword string
-	ServerPort string
+	Server     ServerConfig     `yaml:"server"`
+	DB         DBConfig         `yaml:"db"`
+	Log        LogConfig        `yaml:"log"`
+	Assignment AssignmentConfig `yaml:"assignment"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	GraphQL    GraphQLConfig    `yaml:"graphql"`
+	Reminders  RemindersConfig  `yaml:"reminders"`
+	Notify     NotifyConfig     `yaml:"notify"`
+	Outbox     OutboxConfig     `yaml:"outbox"`
+	Publisher  PublisherConfig  `yaml:"publisher"`
+	Features   FeaturesConfig   `yaml:"features"`
+}
+
+type ServerConfig struct {
+	Port         string        `yaml:"port"`
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	IdleTimeout  time.Duration `yaml:"idle_timeout"`
+	// TLSCertFile и TLSKeyFile включают HTTPS, задаются только вместе
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// GRPCPort - порт gRPC API, отдельный от HTTP
+	GRPCPort string `yaml:"grpc_port"`
+}
+
+type DBConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Name     string `yaml:"name"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	// SSLMode - режим sslmode драйвера lib/pq, SSLRootCert - CA для verify-ca и verify-full
+	SSLMode         string        `yaml:"sslmode"`
+	SSLRootCert     string        `yaml:"sslrootcert"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnectTimeout  time.Duration `yaml:"connect_timeout"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level"`
+}
+
+type AssignmentConfig struct {
+	// Seed фиксирует seed генератора для выбора ревьюверов, 0 - случайный seed
+	Seed int64 `yaml:"seed"`
+	// PolicyFile - YAML с политикой назначения, перечитывается по SIGHUP; пусто - политика по умолчанию
+	PolicyFile string `yaml:"policy_file"`
+	// ReloadInterval - как часто проверять изменение PolicyFile, 0 - только по SIGHUP
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Backend - memory (квота на реплику) или postgres (общая квота всех реплик)
+	Backend string `yaml:"backend"`
+	// Default - лимит клиента в формате rate:burst для маршрутов без своего лимита
+	Default ratelimit.Limit `yaml:"default"`
+	// Routes - лимиты отдельных маршрутов в формате rate:burst
+	Routes map[string]ratelimit.Limit `yaml:"routes"`
+	// APIKeys - ключи X-API-Key с собственной квотой; запросы с другими ключами считаются по IP
+	APIKeys []string `yaml:"api_keys"`
+}
+
+// GraphQLConfig ограничивает запросы к /graphql до их выполнения
+type GraphQLConfig struct {
+	MaxDepth      int `yaml:"max_depth"`
+	MaxComplexity int `yaml:"max_complexity"`
+}
+
+// RemindersConfig управляет фоновой задачей напоминаний о зависших ревью; пороги команды важнее значений по умолчанию
+type RemindersConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Interval - как часто искать зависшие ревью; задача выполняется только на одной реплике
+	Interval time.Duration `yaml:"interval"`
+	// DefaultSLA - через сколько после назначения ревьюверу приходит напоминание
+	DefaultSLA time.Duration `yaml:"default_sla"`
+	// DefaultReassignAfter - через сколько после назначения ревьювер заменяется другим
+	DefaultReassignAfter time.Duration `yaml:"default_reassign_after"`
+}
+
+// NotifyConfig описывает каналы уведомлений участников PR; канал без адреса выключен
+type NotifyConfig struct {
+	// Locale - язык уведомлений для пользователей, которые не выбрали свой
+	Locale string `yaml:"locale"`
+	// QueueSize - сколько уведомлений из обработчиков API ждут отправки; при переполнении новые отбрасываются
+	QueueSize int           `yaml:"queue_size"`
+	Email     EmailConfig   `yaml:"email"`
+	Webhook   WebhookConfig `yaml:"webhook"`
+}
+
+// EmailConfig - SMTP-сервер для писем; пустой Host выключает письма
+type EmailConfig struct {
+	Host     string        `yaml:"host"`
+	Port     string        `yaml:"port"`
+	Username string        `yaml:"username"`
+	Password string        `yaml:"password"`
+	From     string        `yaml:"from"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// WebhookConfig - входящий вебхук чата, совместимый со Slack и Mattermost; пустой URL выключает канал
+type WebhookConfig struct {
+	URL     string        `yaml:"url"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// OutboxConfig управляет доставкой событий из таблицы outbox; события пишутся в неё всегда
+type OutboxConfig struct {
+	// RelayEnabled запускает доставку; её выполняет одна реплика за раз
+	RelayEnabled bool          `yaml:"relay_enabled"`
+	Interval     time.Duration `yaml:"interval"`
+	BatchSize    int           `yaml:"batch_size"`
+	// Retention - сколько хранить доставленные события, 0 - не удалять
+	Retention time.Duration `yaml:"retention"`
 }
 
-func Load() *Config {
+// PublisherConfig - шина, в которую доставляются события outbox
+type PublisherConfig struct {
+	// Backend - none (события отбрасываются), log, nats или kafka
+	Backend string `yaml:"backend"`
+	// Topic - топик для типов событий, которых нет в Topics
+	Topic  string            `yaml:"topic"`
+	Topics map[string]string `yaml:"topics,omitempty"`
+	NATS   NATSConfig        `yaml:"nats"`
+	Kafka  KafkaConfig       `yaml:"kafka"`
+}
+
+type NATSConfig struct {
+	URL string `yaml:"url"`
+	// Partitions - на сколько subject'ов <topic>.<N> делится топик; события одного PR всегда в одном
+	Partitions int `yaml:"partitions"`
+	// JetStream - ждать подтверждения записи в поток JetStream
+	JetStream bool          `yaml:"jetstream"`
+	Timeout   time.Duration `yaml:"timeout"`
+}
+
+type KafkaConfig struct {
+	Brokers []string      `yaml:"brokers,omitempty"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type FeaturesConfig struct {
+	// RequestValidation включает проверку запросов по api/openapi.yml
+	RequestValidation bool `yaml:"request_validation"`
+}
+
+// Default возвращает значения по умолчанию; пароля БД среди них нет, его нужно задать явно
+func Default() *Config {
 	return &Config{
-		DBHost:     getEnv("DB_HOST", "localhost"),
-		DBPort:     getEnv("DB_PORT", "5432"),
-		DBName:     getEnv("DB_NAME", "review_service"),
-		DBUser:     getEnv("DB_USER", "postgres"),
-		DBPassword: getEnv("DB_PASSWORD", "password"),
-		ServerPort: getEnv("SERVER_PORT", "8080"),
+		Server: ServerConfig{
+			Port:         "8080",
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 10 * time.Second,
+			IdleTimeout:  time.Minute,
+			GRPCPort:     "50051",
+		},
+		DB: DBConfig{
+			Host:            "localhost",
+			Port:            "5432",
+			Name:            "review_service",
+			User:            "postgres",
+			SSLMode:         "disable",
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: 5 * time.Minute,
+			ConnectTimeout:  5 * time.Second,
+		},
+		Log:        LogConfig{Level: "info"},
+		Assignment: AssignmentConfig{ReloadInterval: 10 * time.Second},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Backend: "memory",
+			Default: ratelimit.Limit{Rate: 50, Burst: 100},
+			Routes:  map[string]ratelimit.Limit{"/pullRequest/create": {Rate: 10, Burst: 20}},
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      7,
+			MaxComplexity: 20000,
+		},
+		Reminders: RemindersConfig{
+			Enabled:              true,
+			Interval:             5 * time.Minute,
+			DefaultSLA:           24 * time.Hour,
+			DefaultReassignAfter: 72 * time.Hour,
+		},
+		Notify: NotifyConfig{
+			Locale:    "ru",
+			QueueSize: 100,
+			Email:     EmailConfig{Port: "587", Timeout: 10 * time.Second},
+			Webhook:   WebhookConfig{Timeout: 5 * time.Second},
+		},
+		Outbox: OutboxConfig{
+			RelayEnabled: true,
+			Interval:     time.Second,
+			BatchSize:    100,
+			Retention:    7 * 24 * time.Hour,
+		},
+		Publisher: PublisherConfig{
+			Backend: "none",
+			Topic:   "review.events",
+			NATS:    NATSConfig{Partitions: 8, Timeout: 5 * time.Second},
+			Kafka:   KafkaConfig{Timeout: 10 * time.Second},
+		},
+		Features: FeaturesConfig{RequestValidation: true},
 	}
 }
 
+// Validate проверяет конфигурацию целиком и возвращает все найденные ошибки разом
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(validPort(c.Server.Port), "server.port: %q is not a valid port", c.Server.Port)
+	check(validPort(c.Server.GRPCPort), "server.grpc_port: %q is not a valid port", c.Server.GRPCPort)
+	check(c.Server.GRPCPort != c.Server.Port, "server.grpc_port must differ from server.port")
+	check(c.Server.ReadTimeout > 0, "server.read_timeout must be positive")
+	check(c.Server.WriteTimeout > 0, "server.write_timeout must be positive")
+	check(c.Server.IdleTimeout > 0, "server.idle_timeout must be positive")
+	check((c.Server.TLSCertFile == "") == (c.Server.TLSKeyFile == ""),
+		"server.tls_cert_file and server.tls_key_file must be set together")
+
+	check(c.DB.Host != "", "db.host must not be empty")
+	check(validPort(c.DB.Port), "db.port: %q is not a valid port", c.DB.Port)
+	check(c.DB.Name != "", "db.name must not be empty")
+	check(c.DB.User != "", "db.user must not be empty")
+	check(c.DB.Password != "", "db.password must be set (env DB_PASSWORD or config file)")
+	check(slices.Contains(sslModes, c.DB.SSLMode), "db.sslmode: %q is not one of %v", c.DB.SSLMode, sslModes)
+	check(c.DB.MaxOpenConns > 0, "db.max_open_conns must be positive")
+	check(c.DB.MaxIdleConns >= 0 && c.DB.MaxIdleConns <= c.DB.MaxOpenConns,
+		"db.max_idle_conns must be between 0 and db.max_open_conns")
+	check(c.DB.ConnMaxLifetime > 0, "db.conn_max_lifetime must be positive")
+	check(c.DB.ConnectTimeout >= time.Second, "db.connect_timeout must be at least 1s")
+
+	check(slices.Contains(logLevels, c.Log.Level), "log.level: %q is not one of %v", c.Log.Level, logLevels)
+
+	check(c.Assignment.ReloadInterval >= 0, "assignment.reload_interval must not be negative")
+
+	check(c.RateLimit.Backend == "memory" || c.RateLimit.Backend == "postgres",
+		"rate_limit.backend: %q is not one of [memory postgres]", c.RateLimit.Backend)
+	check(c.RateLimit.Default.Valid(), "rate_limit.default: rate and burst must be positive")
+	for route, limit := range c.RateLimit.Routes {
+		check(strings.HasPrefix(route, "/"), "rate_limit.routes: route %q must start with /", route)
+		check(limit.Valid(), "rate_limit.routes: %s: rate and burst must be positive", route)
+	}
+
+	check(c.GraphQL.MaxDepth > 0, "graphql.max_depth must be positive")
+	check(c.GraphQL.MaxComplexity > 0, "graphql.max_complexity must be positive")
+
+	check(c.Reminders.Interval > 0, "reminders.interval must be positive")
+	check(c.Reminders.DefaultSLA > 0, "reminders.default_sla must be positive")
+	check(c.Reminders.DefaultReassignAfter > c.Reminders.DefaultSLA,
+		"reminders.default_reassign_after must be greater than reminders.default_sla")
+
+	check(slices.Contains(notifyLocales, c.Notify.Locale), "notify.locale: %q is not one of %v", c.Notify.Locale, notifyLocales)
+	check(c.Notify.QueueSize > 0, "notify.queue_size must be positive")
+	if c.Notify.Email.Host != "" {
+		check(validPort(c.Notify.Email.Port), "notify.email.port: %q is not a valid port", c.Notify.Email.Port)
+		_, err := mail.ParseAddress(c.Notify.Email.From)
+		check(err == nil, "notify.email.from must be a valid address when notify.email.host is set")
+		check(c.Notify.Email.Timeout > 0, "notify.email.timeout must be positive")
+	}
+	if c.Notify.Webhook.URL != "" {
+		u, err := url.Parse(c.Notify.Webhook.URL)
+		check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "",
+			"notify.webhook.url must be an absolute http(s) URL")
+		check(c.Notify.Webhook.Timeout > 0, "notify.webhook.timeout must be positive")
+	}
+
+	check(c.Outbox.Interval > 0, "outbox.interval must be positive")
+	check(c.Outbox.BatchSize > 0, "outbox.batch_size must be positive")
+	check(c.Outbox.Retention >= 0, "outbox.retention must not be negative")
+
+	check(slices.Contains(publisherBackends, c.Publisher.Backend),
+		"publisher.backend: %q is not one of %v", c.Publisher.Backend, publisherBackends)
+	check(c.Publisher.Topic != "", "publisher.topic must not be empty")
+	for eventType, topic := range c.Publisher.Topics {
+		check(slices.Contains(publishEventTypes, eventType),
+			"publisher.topics: %q is not one of %v", eventType, publishEventTypes)
+		check(topic != "", "publisher.topics: %s: topic must not be empty", eventType)
+	}
+	switch c.Publisher.Backend {
+	case "nats":
+		check(c.Publisher.NATS.URL != "", "publisher.nats.url must be set when publisher.backend is nats")
+		check(c.Publisher.NATS.Partitions > 0, "publisher.nats.partitions must be positive")
+		check(c.Publisher.NATS.Timeout > 0, "publisher.nats.timeout must be positive")
+	case "kafka":
+		check(len(c.Publisher.Kafka.Brokers) > 0, "publisher.kafka.brokers must be set when publisher.backend is kafka")
+		check(c.Publisher.Kafka.Timeout > 0, "publisher.kafka.timeout must be positive")
+	}
+
+	return errors.Join(errs...)
+}
+
+// Rules переводит лимиты из конфигурации в правила лимитера
+func (c RateLimitConfig) Rules() ratelimit.Rules {
+	return ratelimit.Rules{Default: c.Default, Routes: c.Routes}
+}
+
+// GetDBConnectionString собирает DSN для lib/pq; значения экранируются, поэтому пароль может содержать пробелы и кавычки
 func (c *Config) GetDBConnectionString() string {
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName)
+	params := []struct{ key, value string }{
+		{"host", c.DB.Host},
+		{"port", c.DB.Port},
+		{"user", c.DB.User},
+		{"password", c.DB.Password},
+		{"dbname", c.DB.Name},
+		{"sslmode", c.DB.SSLMode},
+		{"sslrootcert", c.DB.SSLRootCert},
+		{"connect_timeout", strconv.Itoa(int(c.DB.ConnectTimeout.Seconds()))},
+	}
+
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		parts = append(parts, p.key+"="+quoteDSNValue(p.value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Redacted возвращает копию, в которой секреты заменены заглушкой
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	if redacted.DB.Password != "" {
+		redacted.DB.Password = redactedValue
+	}
+	if redacted.Notify.Email.Password != "" {
+		redacted.Notify.Email.Password = redactedValue
+	}
+	// в URL вебхука чата зашит токен
+	if redacted.Notify.Webhook.URL != "" {
+		redacted.Notify.Webhook.URL = redactedValue
+	}
+	if len(redacted.RateLimit.APIKeys) > 0 {
+		redacted.RateLimit.APIKeys = make([]string, len(c.RateLimit.APIKeys))
+		for i := range redacted.RateLimit.APIKeys {
+			redacted.RateLimit.APIKeys[i] = redactedValue
+		}
+	}
+	if u, err := url.Parse(redacted.Publisher.NATS.URL); err == nil && u.User != nil {
+		u.User = url.User(redactedValue)
+		redacted.Publisher.NATS.URL = u.String()
+	}
+	return &redacted
+}
+
+// WriteYAML печатает конфигурацию в том же формате, в каком её читает Load
+func (c *Config) WriteYAML(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
+func quoteDSNValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, ` '\`) {
 		return value
 	}
-	return defaultValue
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + replacer.Replace(value) + "'"
 }
@@ -0,0 +1,277 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"avito-test-task/internal/ratelimit"
+)
+
+// Load собирает конфигурацию из значений по умолчанию, YAML-файла, окружения и флагов args и проверяет её.
+// Путь к файлу задаётся флагом -config или переменной CONFIG_FILE; без него файл не читается
+func Load(args []string) (*Config, error) {
+	return load(args, os.LookupEnv, os.ReadFile)
+}
+
+func load(args []string, lookupEnv func(string) (string, bool), readFile func(string) ([]byte, error)) (*Config, error) {
+	cfg := Default()
+
+	configFile, _ := lookupEnv("CONFIG_FILE")
+	fs, apply := newFlagSet(&configFile)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	if configFile != "" {
+		data, err := readFile(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("read config file: %w", err)
+		}
+		if err := decodeYAML(data, cfg); err != nil {
+			return nil, fmt.Errorf("parse config file %s: %w", configFile, err)
+		}
+	}
+
+	if err := applyEnv(cfg, lookupEnv); err != nil {
+		return nil, err
+	}
+	apply(cfg)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// decodeYAML не пропускает неизвестные ключи, чтобы опечатка в файле не превращалась в молчаливое значение по умолчанию.
+// Лимиты маршрутов из файла заменяют значения по умолчанию целиком, а не дополняют их
+func decodeYAML(data []byte, cfg *Config) error {
+	defaultRoutes := cfg.RateLimit.Routes
+	cfg.RateLimit.Routes = nil
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if cfg.RateLimit.Routes == nil {
+		cfg.RateLimit.Routes = defaultRoutes
+	}
+	return nil
+}
+
+// applyEnv накладывает переменные окружения; имена прежних переменных сохранены
+func applyEnv(cfg *Config, lookupEnv func(string) (string, bool)) error {
+	var errs []error
+	str := func(key string, dst *string) {
+		if value, ok := lookupEnv(key); ok && value != "" {
+			*dst = value
+		}
+	}
+	parse := func(key string, fn func(string) error) {
+		if value, ok := lookupEnv(key); ok && value != "" {
+			if err := fn(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s=%q: %w", key, value, err))
+			}
+		}
+	}
+	integer := func(key string, dst *int) {
+		parse(key, func(v string) (err error) { *dst, err = strconv.Atoi(v); return })
+	}
+	duration := func(key string, dst *time.Duration) {
+		parse(key, func(v string) (err error) { *dst, err = time.ParseDuration(v); return })
+	}
+	boolean := func(key string, dst *bool) {
+		parse(key, func(v string) (err error) { *dst, err = strconv.ParseBool(v); return })
+	}
+
+	str("SERVER_PORT", &cfg.Server.Port)
+	str("GRPC_PORT", &cfg.Server.GRPCPort)
+	duration("SERVER_READ_TIMEOUT", &cfg.Server.ReadTimeout)
+	duration("SERVER_WRITE_TIMEOUT", &cfg.Server.WriteTimeout)
+	duration("SERVER_IDLE_TIMEOUT", &cfg.Server.IdleTimeout)
+	str("TLS_CERT_FILE", &cfg.Server.TLSCertFile)
+	str("TLS_KEY_FILE", &cfg.Server.TLSKeyFile)
+
+	str("DB_HOST", &cfg.DB.Host)
+	str("DB_PORT", &cfg.DB.Port)
+	str("DB_NAME", &cfg.DB.Name)
+	str("DB_USER", &cfg.DB.User)
+	str("DB_PASSWORD", &cfg.DB.Password)
+	str("DB_SSLMODE", &cfg.DB.SSLMode)
+	str("DB_SSLROOTCERT", &cfg.DB.SSLRootCert)
+	integer("DB_MAX_OPEN_CONNS", &cfg.DB.MaxOpenConns)
+	integer("DB_MAX_IDLE_CONNS", &cfg.DB.MaxIdleConns)
+	duration("DB_CONN_MAX_LIFETIME", &cfg.DB.ConnMaxLifetime)
+	duration("DB_CONNECT_TIMEOUT", &cfg.DB.ConnectTimeout)
+
+	str("LOG_LEVEL", &cfg.Log.Level)
+
+	parse("ASSIGNMENT_SEED", func(v string) (err error) {
+		cfg.Assignment.Seed, err = strconv.ParseInt(v, 10, 64)
+		return
+	})
+	str("ASSIGNMENT_POLICY_FILE", &cfg.Assignment.PolicyFile)
+	duration("ASSIGNMENT_POLICY_RELOAD_INTERVAL", &cfg.Assignment.ReloadInterval)
+
+	boolean("RATE_LIMIT_ENABLED", &cfg.RateLimit.Enabled)
+	str("RATE_LIMIT_BACKEND", &cfg.RateLimit.Backend)
+	parse("RATE_LIMIT_DEFAULT", func(v string) error { return cfg.RateLimit.Default.UnmarshalText([]byte(v)) })
+	parse("RATE_LIMIT_ROUTES", func(v string) (err error) {
+		cfg.RateLimit.Routes, err = ratelimit.ParseRoutes(v)
+		return
+	})
+	parse("RATE_LIMIT_API_KEYS", func(v string) error {
+		cfg.RateLimit.APIKeys = parseList(v)
+		return nil
+	})
+
+	integer("GRAPHQL_MAX_DEPTH", &cfg.GraphQL.MaxDepth)
+	integer("GRAPHQL_MAX_COMPLEXITY", &cfg.GraphQL.MaxComplexity)
+
+	boolean("REMINDERS_ENABLED", &cfg.Reminders.Enabled)
+	duration("REMINDERS_INTERVAL", &cfg.Reminders.Interval)
+	duration("REMINDERS_DEFAULT_SLA", &cfg.Reminders.DefaultSLA)
+	duration("REMINDERS_DEFAULT_REASSIGN_AFTER", &cfg.Reminders.DefaultReassignAfter)
+
+	str("NOTIFY_LOCALE", &cfg.Notify.Locale)
+	integer("NOTIFY_QUEUE_SIZE", &cfg.Notify.QueueSize)
+	str("SMTP_HOST", &cfg.Notify.Email.Host)
+	str("SMTP_PORT", &cfg.Notify.Email.Port)
+	str("SMTP_USERNAME", &cfg.Notify.Email.Username)
+	str("SMTP_PASSWORD", &cfg.Notify.Email.Password)
+	str("SMTP_FROM", &cfg.Notify.Email.From)
+	duration("SMTP_TIMEOUT", &cfg.Notify.Email.Timeout)
+	str("CHAT_WEBHOOK_URL", &cfg.Notify.Webhook.URL)
+	duration("CHAT_WEBHOOK_TIMEOUT", &cfg.Notify.Webhook.Timeout)
+
+	boolean("OUTBOX_RELAY_ENABLED", &cfg.Outbox.RelayEnabled)
+	duration("OUTBOX_RELAY_INTERVAL", &cfg.Outbox.Interval)
+	integer("OUTBOX_BATCH_SIZE", &cfg.Outbox.BatchSize)
+	duration("OUTBOX_RETENTION", &cfg.Outbox.Retention)
+
+	str("PUBLISHER_BACKEND", &cfg.Publisher.Backend)
+	str("PUBLISHER_TOPIC", &cfg.Publisher.Topic)
+	parse("PUBLISHER_TOPICS", func(v string) (err error) {
+		cfg.Publisher.Topics, err = parseTopics(v)
+		return
+	})
+	str("NATS_URL", &cfg.Publisher.NATS.URL)
+	integer("NATS_PARTITIONS", &cfg.Publisher.NATS.Partitions)
+	boolean("NATS_JETSTREAM", &cfg.Publisher.NATS.JetStream)
+	duration("NATS_TIMEOUT", &cfg.Publisher.NATS.Timeout)
+	parse("KAFKA_BROKERS", func(v string) error {
+		cfg.Publisher.Kafka.Brokers = strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })
+		return nil
+	})
+	duration("KAFKA_TIMEOUT", &cfg.Publisher.Kafka.Timeout)
+
+	boolean("FEATURE_REQUEST_VALIDATION", &cfg.Features.RequestValidation)
+
+	return errors.Join(errs...)
+}
+
+// newFlagSet описывает флаги командной строки; apply переносит в конфигурацию только явно заданные флаги,
+// чтобы значения по умолчанию флагов не затирали файл и окружение. Пароля среди флагов нет: он попал бы в список процессов
+func newFlagSet(configFile *string) (*flag.FlagSet, func(*Config)) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(configFile, "config", *configFile, "path to YAML config file (env CONFIG_FILE)")
+
+	port := fs.String("port", "", "HTTP port (env SERVER_PORT)")
+	grpcPort := fs.String("grpc-port", "", "gRPC port (env GRPC_PORT)")
+	dbHost := fs.String("db-host", "", "database host (env DB_HOST)")
+	dbPort := fs.String("db-port", "", "database port (env DB_PORT)")
+	dbName := fs.String("db-name", "", "database name (env DB_NAME)")
+	dbUser := fs.String("db-user", "", "database user (env DB_USER)")
+	dbSSLMode := fs.String("db-sslmode", "", "database sslmode: disable, require, verify-ca, verify-full (env DB_SSLMODE)")
+	dbMaxOpen := fs.Int("db-max-open-conns", 0, "max open database connections (env DB_MAX_OPEN_CONNS)")
+	logLevel := fs.String("log-level", "", "log level: debug, info, warn, error (env LOG_LEVEL)")
+	seed := fs.Int64("assignment-seed", 0, "fixed seed for reviewer assignment, 0 - random (env ASSIGNMENT_SEED)")
+	policyFile := fs.String("assignment-policy", "", "path to YAML assignment policy, reloaded on SIGHUP (env ASSIGNMENT_POLICY_FILE)")
+	rateLimit := fs.Bool("rate-limit", true, "enable rate limiting (env RATE_LIMIT_ENABLED)")
+	reminders := fs.Bool("reminders", true, "enable stale review reminders (env REMINDERS_ENABLED)")
+	outboxRelay := fs.Bool("outbox-relay", true, "deliver domain events from the outbox (env OUTBOX_RELAY_ENABLED)")
+	publisher := fs.String("publisher", "", "event bus for outbox events: none, log, nats, kafka (env PUBLISHER_BACKEND)")
+	validation := fs.Bool("request-validation", true, "validate requests against the OpenAPI spec (env FEATURE_REQUEST_VALIDATION)")
+
+	apply := func(cfg *Config) {
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "port":
+				cfg.Server.Port = *port
+			case "grpc-port":
+				cfg.Server.GRPCPort = *grpcPort
+			case "db-host":
+				cfg.DB.Host = *dbHost
+			case "db-port":
+				cfg.DB.Port = *dbPort
+			case "db-name":
+				cfg.DB.Name = *dbName
+			case "db-user":
+				cfg.DB.User = *dbUser
+			case "db-sslmode":
+				cfg.DB.SSLMode = *dbSSLMode
+			case "db-max-open-conns":
+				cfg.DB.MaxOpenConns = *dbMaxOpen
+			case "log-level":
+				cfg.Log.Level = *logLevel
+			case "assignment-seed":
+				cfg.Assignment.Seed = *seed
+			case "assignment-policy":
+				cfg.Assignment.PolicyFile = *policyFile
+			case "rate-limit":
+				cfg.RateLimit.Enabled = *rateLimit
+			case "reminders":
+				cfg.Reminders.Enabled = *reminders
+			case "outbox-relay":
+				cfg.Outbox.RelayEnabled = *outboxRelay
+			case "publisher":
+				cfg.Publisher.Backend = *publisher
+			case "request-validation":
+				cfg.Features.RequestValidation = *validation
+			}
+		})
+	}
+	return fs, apply
+}
+
+// parseList разбирает список через запятую, пустые элементы отбрасываются
+func parseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseTopics разбирает список вида PRCreated=review.created,PRMerged=review.merged
+func parseTopics(value string) (map[string]string, error) {
+	topics := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		eventType, topic, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("topic %q: expected type=topic", item)
+		}
+		topics[strings.TrimSpace(eventType)] = strings.TrimSpace(topic)
+	}
+	return topics, nil
+}
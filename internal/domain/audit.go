@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+type AuditEntityType string
+
+const (
+	AuditEntityPullRequest AuditEntityType = "pull_request"
+	AuditEntityTeam        AuditEntityType = "team"
+	AuditEntityUser        AuditEntityType = "user"
+)
+
+type AuditAction string
+
+const (
+	AuditActionPRCreated           AuditAction = "PR_CREATED"
+	AuditActionPRMerged            AuditAction = "PR_MERGED"
+	AuditActionPRPriorityChanged   AuditAction = "PR_PRIORITY_CHANGED"
+	AuditActionPRUpdated           AuditAction = "PR_UPDATED"
+	AuditActionReviewerAssigned    AuditAction = "REVIEWER_ASSIGNED"
+	AuditActionReviewerReplaced    AuditAction = "REVIEWER_REPLACED"
+	AuditActionReviewerRemoved     AuditAction = "REVIEWER_REMOVED"
+	AuditActionTeamCreated         AuditAction = "TEAM_CREATED"
+	AuditActionTeamSettingsChanged AuditAction = "TEAM_SETTINGS_CHANGED"
+	AuditActionMembershipChanged   AuditAction = "TEAM_MEMBERSHIP_CHANGED"
+	AuditActionTeamMoved           AuditAction = "TEAM_MOVED"
+	AuditActionUserSaved           AuditAction = "USER_SAVED"
+	AuditActionUserActivityChanged AuditAction = "USER_ACTIVITY_CHANGED"
+	AuditActionUserNotifications   AuditAction = "USER_NOTIFICATIONS_CHANGED"
+	AuditActionUserCapacityChanged AuditAction = "USER_CAPACITY_CHANGED"
+	AuditActionUserTagsChanged     AuditAction = "USER_TAGS_CHANGED"
+)
+
+type AuditReason string
+
+const (
+	AuditReasonNone         AuditReason = ""
+	AuditReasonAutoAssign   AuditReason = "auto_assign"
+	AuditReasonReassign     AuditReason = "reassign"
+	AuditReasonDeactivation AuditReason = "deactivation"
+	AuditReasonActivation   AuditReason = "activation"
+	AuditReasonTeamSync     AuditReason = "team_sync"
+	AuditReasonTopUp        AuditReason = "top_up"
+	AuditReasonManual       AuditReason = "manual"
+	AuditReasonSLAExpired   AuditReason = "sla_expired"
+)
+
+// AuditEntry описывает одну запись журнала изменений (журнал только дополняется)
+type AuditEntry struct {
+	ID         int64           `json:"id"`
+	EntityType AuditEntityType `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Action     AuditAction     `json:"action"`
+	Actor      string          `json:"actor"`
+	Reason     AuditReason     `json:"reason"`
+	OldValue   map[string]any  `json:"old_value,omitempty"`
+	NewValue   map[string]any  `json:"new_value,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditFilter задаёт условия выборки журнала, пустые поля не фильтруют
+type AuditFilter struct {
+	EntityType AuditEntityType
+	EntityID   string
+	Actor      string
+	Action     AuditAction
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
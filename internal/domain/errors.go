@@ -3,12 +3,22 @@ package domain
 import "errors"
 
 var (
-	ErrUserNotFound        = errors.New("user not found")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrTeamExists          = errors.New("team already exists")
-	ErrPRNotFound          = errors.New("pull request not found")
-	ErrPRExists            = errors.New("pull request already exists")
-	ErrPRMerged            = errors.New("pull request is merged")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned to this PR")
-	ErrNoCandidates        = errors.New("no active candidates available")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrTeamNotFound            = errors.New("team not found")
+	ErrTeamExists              = errors.New("team already exists")
+	ErrPRNotFound              = errors.New("pull request not found")
+	ErrPRExists                = errors.New("pull request already exists")
+	ErrPRMerged                = errors.New("pull request is merged")
+	ErrReviewerNotAssigned     = errors.New("reviewer not assigned to this PR")
+	ErrNoCandidates            = errors.New("no active candidates available")
+	ErrInvalidReviewersCount   = errors.New("reviewers count exceeds team size or allowed range")
+	ErrReviewersLimit          = errors.New("pull request already has maximum number of reviewers")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer already assigned to this PR")
+	ErrAuthorAsReviewer        = errors.New("author cannot review own pull request")
+	ErrUserInactive            = errors.New("user is inactive")
+	ErrNotTeamMember           = errors.New("user is not a member of the team")
+	ErrPrimaryTeam             = errors.New("primary team membership cannot be removed")
+	ErrTeamCycle               = errors.New("team cannot be moved into its own subtree")
+	ErrReviewerRules           = errors.New("no reviewers satisfy the team role rules")
+	ErrValidation              = errors.New("validation failed")
 )
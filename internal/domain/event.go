@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+type ReviewEventType string
+
+const (
+	EventPRCreated          ReviewEventType = "pr_created"
+	EventReviewerAssigned   ReviewEventType = "reviewer_assigned"
+	EventReviewerReassigned ReviewEventType = "reviewer_reassigned"
+	EventPRMerged           ReviewEventType = "pr_merged"
+)
+
+// ReviewEvent - событие потока назначений. ID совпадает с id записи audit_log, по нему клиент возобновляет поток
+type ReviewEvent struct {
+	ID            int64
+	Type          ReviewEventType
+	PullRequestID string
+	Title         string
+	AuthorID      string
+	// TeamName - команда, в которой создан PR; для PR без команды - основная команда автора
+	TeamName string
+	// ReviewerID - назначенный ревьювер, для переназначения - новый
+	ReviewerID    string
+	OldReviewerID string
+	// Reviewers - ревьюверы PR на момент чтения события
+	Reviewers []string
+	Actor     string
+	CreatedAt time.Time
+}
+
+// EventFilter выбирает события для подписчика, пустые поля не фильтруют
+type EventFilter struct {
+	// UserID - события, где пользователь автор, ревьювер или снятый ревьювер
+	UserID   string
+	TeamName string
+}
+
+func (f EventFilter) Matches(event *ReviewEvent) bool {
+	if f.TeamName != "" && event.TeamName != f.TeamName {
+		return false
+	}
+	if f.UserID == "" {
+		return true
+	}
+	if event.AuthorID == f.UserID || event.ReviewerID == f.UserID || event.OldReviewerID == f.UserID {
+		return true
+	}
+	for _, reviewerID := range event.Reviewers {
+		if reviewerID == f.UserID {
+			return true
+		}
+	}
+	return false
+}
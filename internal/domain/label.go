@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxLabelLength ограничивает длину метки PR
+	MaxLabelLength = 64
+	// MaxLabels ограничивает число меток у PR
+	MaxLabels = 20
+	// MaxURLLength совпадает с размером колонки pull_requests.url
+	MaxURLLength = 2048
+)
+
+// NormalizeLabels убирает пробелы по краям меток и повторы и сортирует; регистр сохраняется
+func NormalizeLabels(labels []string) []string {
+	result := make([]string, 0, len(labels))
+	for _, label := range labels {
+		result = append(result, strings.TrimSpace(label))
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
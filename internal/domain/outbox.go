@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DomainEventType - тип события для внешних потребителей; значения не меняются, от них зависят подписчики
+type DomainEventType string
+
+const (
+	DomainEventPRCreated           DomainEventType = "PRCreated"
+	DomainEventReviewerReplaced    DomainEventType = "ReviewerReplaced"
+	DomainEventPRMerged            DomainEventType = "PRMerged"
+	DomainEventUserActivityChanged DomainEventType = "UserActivityChanged"
+	DomainEventTeamCreated         DomainEventType = "TeamCreated"
+)
+
+// DomainEvent - запись outbox, сохранённая в одной транзакции с изменением.
+// События одного агрегата доставляются в порядке ID; доставка не реже одного раза, потребитель отбрасывает повторы по ID
+type DomainEvent struct {
+	ID            int64
+	Type          DomainEventType
+	AggregateType AuditEntityType
+	AggregateID   string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	// Attempts - число неудачных попыток доставки
+	Attempts int
+}
+
+type PRCreatedPayload struct {
+	PullRequestID     string    `json:"pull_request_id"`
+	Title             string    `json:"pull_request_name"`
+	AuthorID          string    `json:"author_id"`
+	Reviewers         []string  `json:"assigned_reviewers"`
+	RequiredReviewers int       `json:"required_reviewers"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type ReviewerReplacedPayload struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type PRMergedPayload struct {
+	PullRequestID string    `json:"pull_request_id"`
+	MergedAt      time.Time `json:"merged_at"`
+}
+
+type UserActivityChangedPayload struct {
+	UserID   string `json:"user_id"`
+	IsActive bool   `json:"is_active"`
+}
+
+// TeamCreatedPayload не содержит участников: они сохраняются после команды отдельно
+type TeamCreatedPayload struct {
+	TeamName           string `json:"team_name"`
+	ReviewersCount     int    `json:"reviewers_count"`
+	ReviewSLAHours     int    `json:"review_sla_hours,omitempty"`
+	ReassignAfterHours int    `json:"reassign_after_hours,omitempty"`
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type PRStatus string
 
@@ -9,12 +12,94 @@ const (
 	PRStatusMerged PRStatus = "MERGED"
 )
 
+// PRPriority - срочность PR
+type PRPriority string
+
+const (
+	PRPriorityLow    PRPriority = "low"
+	PRPriorityNormal PRPriority = "normal"
+	PRPriorityHigh   PRPriority = "high"
+	PRPriorityHotfix PRPriority = "hotfix"
+)
+
+// PRPriorities - допустимые приоритеты PR от низшего к высшему
+var PRPriorities = []PRPriority{PRPriorityLow, PRPriorityNormal, PRPriorityHigh, PRPriorityHotfix}
+
+// ReviewListFilter - фильтр и порядок списка PR ревьювера
+type ReviewListFilter struct {
+	// Priorities - пустой список означает любой приоритет
+	Priorities []PRPriority
+	// ByPriority - сначала срочные PR, при равном приоритете по id; иначе просто по id
+	ByPriority bool
+	// Labels - у PR должны быть все перечисленные метки
+	Labels []string
+	// Repository - пустая строка означает любой репозиторий
+	Repository string
+}
+
+// PRMetadata - где лежит код PR; пустые строки и 0 означают, что значение не задано
+type PRMetadata struct {
+	Repository   string `json:"repository,omitempty"`
+	SourceBranch string `json:"source_branch,omitempty"`
+	TargetBranch string `json:"target_branch,omitempty"`
+	URL          string `json:"url,omitempty"`
+	LinesChanged int    `json:"lines_changed,omitempty"`
+}
+
+// PRUpdate - изменение меток и метаданных PR; nil-поле не меняется, заданное заменяет значение целиком
+type PRUpdate struct {
+	Labels   *[]string
+	Metadata *PRMetadata
+}
+
+// Changes возвращает прежние и новые значения полей, которые update меняет у pr, для журнала; ключи - labels и metadata
+func (u PRUpdate) Changes(pr *PullRequest) (oldValue, newValue map[string]any) {
+	oldValue = make(map[string]any)
+	newValue = make(map[string]any)
+	if u.Labels != nil && !slices.Equal(pr.Labels, *u.Labels) {
+		oldValue["labels"] = pr.Labels
+		newValue["labels"] = *u.Labels
+	}
+	if u.Metadata != nil && pr.Metadata != *u.Metadata {
+		oldValue["metadata"] = pr.Metadata
+		newValue["metadata"] = *u.Metadata
+	}
+	return oldValue, newValue
+}
+
 type PullRequest struct {
 	ID                string     `json:"pull_request_id"`
 	Title             string     `json:"pull_request_name"`
 	AuthorID          string     `json:"author_id"`
 	Status            PRStatus   `json:"status"`
 	AssignedReviewers []string   `json:"assigned_reviewers"`
+	RequiredReviewers int        `json:"required_reviewers"`
 	CreatedAt         *time.Time `json:"createdAt"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	// TeamID - команда, из которой назначаются ревьюверы; 0 - основная команда автора
+	TeamID int `json:"-"`
+	// RequiredTags - экспертиза, которую при создании PR старались покрыть ревьюверами
+	RequiredTags []string `json:"required_tags,omitempty"`
+	// UncoveredTags - теги из RequiredTags, которых нет ни у одного назначенного ревьювера; заполняется только при создании
+	UncoveredTags []string `json:"uncovered_tags,omitempty"`
+	// Priority пустой - PRPriorityNormal
+	Priority PRPriority `json:"priority"`
+	Labels   []string   `json:"labels,omitempty"`
+	Metadata PRMetadata `json:"metadata"`
+}
+
+// StaleReview - назначение ревьювера на открытый PR, которое ждёт дольше порога SLA команды автора
+type StaleReview struct {
+	PullRequestID string
+	Title         string
+	AuthorID      string
+	TeamName      string
+	ReviewerID    string
+	ReviewerName  string
+	// WaitingSince - момент назначения; для назначений до появления assigned_at - создание PR
+	WaitingSince time.Time
+	RemindedAt   *time.Time
+	// ReviewSLAHours и ReassignAfterHours - пороги команды автора, 0 - значение из конфигурации
+	ReviewSLAHours     int
+	ReassignAfterHours int
 }
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxTagLength совпадает с размером колонки user_tags.tag
+	MaxTagLength = 64
+	// MaxTags ограничивает число тегов у пользователя и у PR
+	MaxTags = 20
+)
+
+// NormalizeTags приводит теги к нижнему регистру без пробелов по краям, убирает повторы и сортирует
+func NormalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, strings.ToLower(strings.TrimSpace(tag)))
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// isTagRune - допустимый символ тега: строчная латиница, цифры и + # . _ -
+func isTagRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || strings.ContainsRune("+#._-", r)
+}
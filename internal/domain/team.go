@@ -1,13 +1,120 @@
 package domain
 
+import "time"
+
+const (
+	// DefaultReviewersCount - сколько ревьюверов назначается, если команда не задала своё значение
+	DefaultReviewersCount = 2
+	MinReviewersCount     = 1
+	MaxReviewersCount     = 10
+	// MaxReviewSLAHours ограничивает SLA ревью и порог переназначения месяцем
+	MaxReviewSLAHours = 720
+)
+
 type Team struct {
 	ID      int          `json:"-"`
 	Name    string       `json:"team_name"`
 	Members []TeamMember `json:"members"`
+	// ReviewersCount - требуемое число ревьюверов на PR автора из этой команды, 0 - значение по умолчанию
+	ReviewersCount int `json:"reviewers_count"`
+	// ReviewSLAHours - через сколько часов без ревью ревьюверу приходит напоминание, 0 - значение из конфигурации
+	ReviewSLAHours int `json:"review_sla_hours,omitempty"`
+	// ReassignAfterHours - через сколько часов без ревью ревьювер заменяется, 0 - значение из конфигурации
+	ReassignAfterHours int `json:"reassign_after_hours,omitempty"`
+	// ParentID - родительская команда (департамент), 0 - команда верхнего уровня
+	ParentID   int    `json:"-"`
+	ParentName string `json:"parent_name,omitempty"`
+	// ReviewRotation - ревьюверы выбираются по давности последнего ревью автора, а не способом из политики
+	ReviewRotation bool `json:"review_rotation"`
+}
+
+// TeamTree - команда со всеми вложенными командами
+type TeamTree struct {
+	Team     *Team       `json:"team"`
+	Children []*TeamTree `json:"children"`
+}
+
+// TeamStats - показатели поддерева команды: самой команды и всех вложенных.
+// Пользователь, состоящий в нескольких командах поддерева, считается один раз
+type TeamStats struct {
+	TeamName string `json:"team_name"`
+	// Depth - глубина команды относительно корня запрошенного поддерева
+	Depth         int `json:"depth"`
+	Teams         int `json:"teams"`
+	Members       int `json:"members"`
+	ActiveMembers int `json:"active_members"`
+	// ActiveCapacity - сумма Capacity активных участников в процентах: 250 - как два с половиной ревьювера на полную нагрузку
+	ActiveCapacity int `json:"active_capacity"`
+	OpenPRs        int `json:"open_prs"`
+	MergedPRs      int `json:"merged_prs"`
+	// OpenReviews - назначения ревьюверов в открытых PR команд поддерева
+	OpenReviews int `json:"open_reviews"`
+	// Repositories - те же показатели PR в разрезе репозиториев, по имени репозитория
+	Repositories []RepositoryStats `json:"repositories"`
+}
+
+// RepositoryStats - показатели PR одного репозитория в поддереве команды
+type RepositoryStats struct {
+	// Repository - репозиторий из метаданных PR, пустой - PR без репозитория
+	Repository  string `json:"repository"`
+	OpenPRs     int    `json:"open_prs"`
+	MergedPRs   int    `json:"merged_prs"`
+	OpenReviews int    `json:"open_reviews"`
+}
+
+// ReviewMatrix - кто сколько раз ревьюил кого в PR команды
+type ReviewMatrix struct {
+	TeamName string `json:"team_name"`
+	// Members - участники команды, включая тех, у кого ещё не было ни одного ревью
+	Members []string     `json:"members"`
+	Pairs   []ReviewPair `json:"pairs"`
+}
+
+// ReviewPair - ревью одного автора одним ревьювером
+type ReviewPair struct {
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+	Reviews    int    `json:"reviews"`
+	// LastReviewedAt - последнее назначение ревьювера на PR автора
+	LastReviewedAt time.Time `json:"last_reviewed_at"`
 }
 
 type TeamMember struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	// IsActive - участвует ли пользователь в ревью этой команды: активен и он сам, и его членство в команде
+	IsActive bool `json:"is_active"`
+	// Role пустая - MemberRoleMember
+	Role MemberRole `json:"role"`
+	// Capacity - доля ревью пользователя в процентах, общая для всех его команд
+	Capacity int `json:"capacity"`
+}
+
+// MemberRole - роль пользователя в конкретной команде
+type MemberRole string
+
+const (
+	MemberRoleLead    MemberRole = "lead"
+	MemberRoleSenior  MemberRole = "senior"
+	MemberRoleMember  MemberRole = "member"
+	MemberRoleTrainee MemberRole = "trainee"
+)
+
+// MemberRoles - допустимые роли участника команды
+var MemberRoles = []MemberRole{MemberRoleLead, MemberRoleSenior, MemberRoleMember, MemberRoleTrainee}
+
+// IsSenior - может ли участник быть старшим ревьювером: senior или lead
+func (r MemberRole) IsSenior() bool {
+	return r == MemberRoleSenior || r == MemberRoleLead
+}
+
+// TeamMembership - членство пользователя в одной из его команд
+type TeamMembership struct {
+	TeamID   int        `json:"-"`
+	TeamName string     `json:"team_name"`
+	Role     MemberRole `json:"role"`
+	// IsActive - флаг самого членства, активность пользователя в целом хранит User.IsActive
+	IsActive bool `json:"is_active"`
+	// IsPrimary - основная команда пользователя, из неё назначаются ревьюверы PR, созданных без указания команды
+	IsPrimary bool `json:"is_primary"`
 }
@@ -1,9 +1,78 @@
 package domain
 
+import "time"
+
+// MaxCapacity - полная нагрузка ревью в процентах, она же значение для новых пользователей
+const MaxCapacity = 100
+
+// OnlineWindow - сколько пользователь считается онлайн после последнего heartbeat; клиенты шлют его чаще
+const OnlineWindow = 5 * time.Minute
+
 type User struct {
 	ID       string `json:"user_id"`
 	Username string `json:"username"`
+	// TeamID и TeamName - основная команда пользователя
 	TeamID   int    `json:"-"`
 	TeamName string `json:"team_name"`
 	IsActive bool   `json:"is_active"`
+	// Capacity - доля ревью в процентах от полной, 0 - ревьювером автоматически не назначается
+	Capacity int `json:"capacity"`
+	// Tags - теги экспертизы, нормализованные и отсортированные
+	Tags []string `json:"tags,omitempty"`
+	// Teams - все команды пользователя, включая основную; заполняется только при загрузке одного пользователя
+	Teams []TeamMembership `json:"teams,omitempty"`
+	// Role - роль в команде, по которой пользователь найден как кандидат в ревьюверы
+	Role MemberRole `json:"-"`
+	// Notifications - адреса и предпочтения уведомлений, задаются отдельно от команды
+	Notifications NotificationSettings `json:"notifications"`
+	// LastSeenAt - время последнего heartbeat, nil - пользователь ещё не появлялся онлайн
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// Online сообщает, был ли heartbeat пользователя в пределах OnlineWindow до now
+func (u *User) Online(now time.Time) bool {
+	return u.LastSeenAt != nil && now.Sub(*u.LastSeenAt) < OnlineWindow
+}
+
+// Membership возвращает членство пользователя в команде teamID
+func (u *User) Membership(teamID int) (TeamMembership, bool) {
+	for _, m := range u.Teams {
+		if m.TeamID == teamID {
+			return m, true
+		}
+	}
+	return TeamMembership{}, false
+}
+
+// Locale - язык уведомлений
+type Locale string
+
+const (
+	LocaleRU Locale = "ru"
+	LocaleEN Locale = "en"
+)
+
+// Locales - поддерживаемые языки уведомлений
+var Locales = []Locale{LocaleRU, LocaleEN}
+
+// NotificationSettings описывает, куда и на каком языке присылать уведомления пользователю.
+// Нулевое значение - уведомления в чат без упоминания, писем нет, язык сервера
+type NotificationSettings struct {
+	Email string `json:"email,omitempty"`
+	// ChatHandle вставляется в сообщение чата как есть: @alice для Mattermost, <@U024BE7LH> для Slack
+	ChatHandle string `json:"chat_handle,omitempty"`
+	// Locale пустой - язык по умолчанию из конфигурации сервера
+	Locale    Locale `json:"locale,omitempty"`
+	MuteEmail bool   `json:"mute_email"`
+	MuteChat  bool   `json:"mute_chat"`
+}
+
+// EmailEnabled сообщает, нужно ли отправлять пользователю письма
+func (s NotificationSettings) EmailEnabled() bool {
+	return s.Email != "" && !s.MuteEmail
+}
+
+// ChatEnabled сообщает, нужно ли писать о пользователе в чат
+func (s NotificationSettings) ChatEnabled() bool {
+	return !s.MuteChat
 }
@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ограничения длины совпадают с размерами колонок в migrations
+const (
+	MaxIDLength      = 255
+	MaxNameLength    = 255
+	MaxPRTitleLength = 500
+)
+
+// maxErrorFields - сколько ошибок полей перечислять в тексте ValidationError
+const maxErrorFields = 3
+
+// FieldError описывает нарушение правила для одного поля запроса
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError собирает все ошибки полей; errors.Is(err, ErrValidation) для неё истинно
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, maxErrorFields)
+	for i, f := range e.Fields {
+		if i == maxErrorFields {
+			parts = append(parts, fmt.Sprintf("and %d more", len(e.Fields)-i))
+			break
+		}
+		parts = append(parts, f.Field+": "+f.Message)
+	}
+	return ErrValidation.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// validator накапливает ошибки полей, чтобы вернуть их клиенту разом
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field, message string) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: message})
+}
+
+// id проверяет идентификатор: непустой, не длиннее MaxIDLength, без пробелов и управляющих символов
+func (v *validator) id(field, value string) {
+	switch {
+	case value == "":
+		v.add(field, "must not be empty")
+	case utf8.RuneCountInString(value) > MaxIDLength:
+		v.add(field, fmt.Sprintf("must be at most %d characters", MaxIDLength))
+	case strings.IndexFunc(value, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0:
+		v.add(field, "must not contain whitespace or control characters")
+	}
+}
+
+// name проверяет человекочитаемое имя: не пустое после обрезки пробелов, не длиннее max, без управляющих символов
+func (v *validator) name(field, value string, max int) {
+	switch {
+	case strings.TrimSpace(value) == "":
+		v.add(field, "must not be blank")
+	case utf8.RuneCountInString(value) > max:
+		v.add(field, fmt.Sprintf("must be at most %d characters", max))
+	case strings.IndexFunc(value, unicode.IsControl) >= 0:
+		v.add(field, "must not contain control characters")
+	}
+}
+
+// reviewSLA проверяет пороги SLA в часах: 0 - значение по умолчанию, переназначение позже напоминания
+func (v *validator) reviewSLA(slaHours, reassignAfterHours int) {
+	inRange := func(field string, hours int) bool {
+		if hours < 0 || hours > MaxReviewSLAHours {
+			v.add(field, fmt.Sprintf("must be between 0 and %d hours (0 = default)", MaxReviewSLAHours))
+			return false
+		}
+		return true
+	}
+	ok := inRange("review_sla_hours", slaHours)
+	ok = inRange("reassign_after_hours", reassignAfterHours) && ok
+	if ok && slaHours != 0 && reassignAfterHours != 0 && reassignAfterHours <= slaHours {
+		v.add("reassign_after_hours", "must be greater than review_sla_hours")
+	}
+}
+
+// role проверяет роль участника команды, пустая роль - MemberRoleMember
+func (v *validator) role(field string, role MemberRole) {
+	if role != "" && !slices.Contains(MemberRoles, role) {
+		v.add(field, fmt.Sprintf("must be one of %v", MemberRoles))
+	}
+}
+
+// priority проверяет приоритет PR, пустой приоритет - PRPriorityNormal
+func (v *validator) priority(field string, priority PRPriority) {
+	if priority != "" && !slices.Contains(PRPriorities, priority) {
+		v.add(field, fmt.Sprintf("must be one of %v", PRPriorities))
+	}
+}
+
+// tags проверяет нормализованные теги: не больше MaxTags, каждый непустой, не длиннее MaxTagLength и из допустимых символов
+func (v *validator) tags(field string, tags []string) {
+	if len(tags) > MaxTags {
+		v.add(field, fmt.Sprintf("must contain at most %d tags", MaxTags))
+		return
+	}
+	for i, tag := range tags {
+		switch {
+		case tag == "":
+			v.add(fmt.Sprintf("%s[%d]", field, i), "must not be empty")
+		case len(tag) > MaxTagLength:
+			v.add(fmt.Sprintf("%s[%d]", field, i), fmt.Sprintf("must be at most %d characters", MaxTagLength))
+		case strings.IndexFunc(tag, func(r rune) bool { return !isTagRune(r) }) >= 0:
+			v.add(fmt.Sprintf("%s[%d]", field, i), "must contain only letters, digits and + # . _ -")
+		}
+	}
+}
+
+// labels проверяет нормализованные метки: не больше MaxLabels, каждая непустая, не длиннее MaxLabelLength, без управляющих символов
+func (v *validator) labels(field string, labels []string) {
+	if len(labels) > MaxLabels {
+		v.add(field, fmt.Sprintf("must contain at most %d labels", MaxLabels))
+		return
+	}
+	for i, label := range labels {
+		v.name(fmt.Sprintf("%s[%d]", field, i), label, MaxLabelLength)
+	}
+}
+
+// metadata проверяет метаданные PR: ветки - как идентификаторы, URL - абсолютный http(s); пустые значения допустимы
+func (v *validator) metadata(prefix string, m PRMetadata) {
+	if m.Repository != "" {
+		v.name(prefix+"repository", m.Repository, MaxNameLength)
+	}
+	if m.SourceBranch != "" {
+		v.id(prefix+"source_branch", m.SourceBranch)
+	}
+	if m.TargetBranch != "" {
+		v.id(prefix+"target_branch", m.TargetBranch)
+	}
+	if m.URL != "" {
+		if len(m.URL) > MaxURLLength {
+			v.add(prefix+"url", fmt.Sprintf("must be at most %d characters", MaxURLLength))
+		} else if u, err := url.Parse(m.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.add(prefix+"url", "must be an absolute http or https URL")
+		}
+	}
+	if m.LinesChanged < 0 {
+		v.add(prefix+"lines_changed", "must not be negative")
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// Validate проверяет имя команды, пороги SLA и участников, включая повторяющиеся user_id
+func (t *Team) Validate() error {
+	var v validator
+	v.name("team_name", t.Name, MaxNameLength)
+	v.reviewSLA(t.ReviewSLAHours, t.ReassignAfterHours)
+	if t.ParentName != "" && t.ParentName == t.Name {
+		v.add("parent_name", "must differ from team_name")
+	}
+
+	seen := make(map[string]int, len(t.Members))
+	for i, member := range t.Members {
+		prefix := fmt.Sprintf("members[%d].", i)
+		v.id(prefix+"user_id", member.UserID)
+		v.name(prefix+"username", member.Username, MaxNameLength)
+		v.role(prefix+"role", member.Role)
+
+		if first, ok := seen[member.UserID]; ok && member.UserID != "" {
+			v.add(prefix+"user_id", fmt.Sprintf("duplicates members[%d].user_id", first))
+			continue
+		}
+		seen[member.UserID] = i
+	}
+
+	return v.err()
+}
+
+// Validate проверяет адреса и язык уведомлений; пустой адрес означает, что канала нет
+func (s *NotificationSettings) Validate() error {
+	var v validator
+	if s.Email != "" {
+		if addr, err := mail.ParseAddress(s.Email); err != nil || addr.Address != s.Email {
+			v.add("email", "must be a plain email address")
+		} else if utf8.RuneCountInString(s.Email) > MaxNameLength {
+			v.add("email", fmt.Sprintf("must be at most %d characters", MaxNameLength))
+		}
+	}
+	if s.ChatHandle != "" {
+		v.id("chat_handle", s.ChatHandle)
+	}
+	if s.Locale != "" && !slices.Contains(Locales, s.Locale) {
+		v.add("locale", fmt.Sprintf("must be one of %v", Locales))
+	}
+	return v.err()
+}
+
+// ValidateMember проверяет участника, которого добавляют в существующую команду
+func ValidateMember(userID string, role MemberRole) error {
+	var v validator
+	v.id("user_id", userID)
+	v.role("role", role)
+	return v.err()
+}
+
+// ValidateReviewSLA проверяет пороги SLA команды в часах, 0 - значение по умолчанию
+func ValidateReviewSLA(slaHours, reassignAfterHours int) error {
+	var v validator
+	v.reviewSLA(slaHours, reassignAfterHours)
+	return v.err()
+}
+
+// ValidateCapacity проверяет долю ревью пользователя в процентах
+func ValidateCapacity(capacity int) error {
+	var v validator
+	if capacity < 0 || capacity > MaxCapacity {
+		v.add("capacity", fmt.Sprintf("must be between 0 and %d", MaxCapacity))
+	}
+	return v.err()
+}
+
+// ValidatePriority проверяет приоритет, на который меняют PR
+func ValidatePriority(priority PRPriority) error {
+	var v validator
+	if priority == "" {
+		v.add("priority", "must not be empty")
+	}
+	v.priority("priority", priority)
+	return v.err()
+}
+
+// ValidateTags проверяет теги экспертизы пользователя после NormalizeTags
+func ValidateTags(tags []string) error {
+	var v validator
+	v.tags("tags", tags)
+	return v.err()
+}
+
+// Validate проверяет поля PR, которые задаёт клиент при создании; RequiredTags и Labels должны быть нормализованы
+func (pr *PullRequest) Validate() error {
+	var v validator
+	v.id("pull_request_id", pr.ID)
+	v.name("pull_request_name", pr.Title, MaxPRTitleLength)
+	v.id("author_id", pr.AuthorID)
+	v.tags("required_tags", pr.RequiredTags)
+	v.priority("priority", pr.Priority)
+	v.labels("labels", pr.Labels)
+	v.metadata("metadata.", pr.Metadata)
+	return v.err()
+}
+
+// Validate проверяет заданные поля изменения PR; метки должны быть нормализованы
+func (u *PRUpdate) Validate() error {
+	var v validator
+	if u.Labels == nil && u.Metadata == nil {
+		v.add("labels", "labels or metadata must be set")
+	}
+	if u.Labels != nil {
+		v.labels("labels", *u.Labels)
+	}
+	if u.Metadata != nil {
+		v.metadata("metadata.", *u.Metadata)
+	}
+	return v.err()
+}
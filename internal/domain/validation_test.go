@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func fieldNames(err error) []string {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	names := make([]string, 0, len(validationErr.Fields))
+	for _, f := range validationErr.Fields {
+		names = append(names, f.Field)
+	}
+	return names
+}
+
+func TestTeam_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		team       Team
+		wantFields []string
+	}{
+		{
+			name: "valid team",
+			team: Team{
+				Name: "backend",
+				Members: []TeamMember{
+					{UserID: "u1", Username: "Alice"},
+					{UserID: "u2", Username: "Bob"},
+				},
+			},
+		},
+		{
+			name:       "blank team name",
+			team:       Team{Name: "   "},
+			wantFields: []string{"team_name"},
+		},
+		{
+			name:       "team name longer than column",
+			team:       Team{Name: strings.Repeat("a", MaxNameLength+1)},
+			wantFields: []string{"team_name"},
+		},
+		{
+			name: "invalid members",
+			team: Team{
+				Name: "backend",
+				Members: []TeamMember{
+					{UserID: "u1", Username: "Alice"},
+					{UserID: "u 2", Username: ""},
+					{UserID: "u1", Username: "Alice again"},
+				},
+			},
+			wantFields: []string{"members[1].user_id", "members[1].username", "members[2].user_id"},
+		},
+		{
+			name: "member roles",
+			team: Team{
+				Name: "backend",
+				Members: []TeamMember{
+					{UserID: "u1", Username: "Alice", Role: MemberRoleLead},
+					{UserID: "u2", Username: "Bob", Role: "owner"},
+				},
+			},
+			wantFields: []string{"members[1].role"},
+		},
+		{
+			name:       "team is its own parent",
+			team:       Team{Name: "backend", ParentName: "backend"},
+			wantFields: []string{"parent_name"},
+		},
+		{
+			name:       "valid review sla",
+			team:       Team{Name: "backend", ReviewSLAHours: 24, ReassignAfterHours: 72},
+			wantFields: nil,
+		},
+		{
+			name:       "review sla out of range",
+			team:       Team{Name: "backend", ReviewSLAHours: -1, ReassignAfterHours: MaxReviewSLAHours + 1},
+			wantFields: []string{"review_sla_hours", "reassign_after_hours"},
+		},
+		{
+			name:       "reassign before reminder",
+			team:       Team{Name: "backend", ReviewSLAHours: 24, ReassignAfterHours: 24},
+			wantFields: []string{"reassign_after_hours"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.team.Validate()
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrValidation) {
+				t.Fatalf("Validate() error = %v, want %v", err, ErrValidation)
+			}
+			if got := fieldNames(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestPullRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pr         PullRequest
+		wantFields []string
+	}{
+		{
+			name: "valid PR",
+			pr:   PullRequest{ID: "pr-1001", Title: "Add search", AuthorID: "u1"},
+		},
+		{
+			name:       "empty fields",
+			pr:         PullRequest{},
+			wantFields: []string{"pull_request_id", "pull_request_name", "author_id"},
+		},
+		{
+			name:       "title longer than column",
+			pr:         PullRequest{ID: "pr-1", Title: strings.Repeat("t", MaxPRTitleLength+1), AuthorID: "u1"},
+			wantFields: []string{"pull_request_name"},
+		},
+		{
+			name:       "control characters",
+			pr:         PullRequest{ID: "pr\x00", Title: "title\x07", AuthorID: "u1"},
+			wantFields: []string{"pull_request_id", "pull_request_name"},
+		},
+		{
+			name:       "invalid required tags",
+			pr:         PullRequest{ID: "pr-1", Title: "title", AuthorID: "u1", RequiredTags: []string{"go", "", "Go Lang"}},
+			wantFields: []string{"required_tags[1]", "required_tags[2]"},
+		},
+		{
+			name:       "unknown priority",
+			pr:         PullRequest{ID: "pr-1", Title: "title", AuthorID: "u1", Priority: "urgent"},
+			wantFields: []string{"priority"},
+		},
+		{
+			name: "labels and metadata",
+			pr: PullRequest{ID: "pr-1", Title: "title", AuthorID: "u1", Labels: []string{"needs review", "v2"}, Metadata: PRMetadata{
+				Repository:   "org/search",
+				SourceBranch: "feature/search",
+				TargetBranch: "main",
+				URL:          "https://git.example.com/org/search/pull/1",
+				LinesChanged: 120,
+			}},
+		},
+		{
+			name: "invalid labels and metadata",
+			pr: PullRequest{ID: "pr-1", Title: "title", AuthorID: "u1", Labels: []string{" ", strings.Repeat("l", MaxLabelLength+1)}, Metadata: PRMetadata{
+				SourceBranch: "my branch",
+				URL:          "git.example.com/pull/1",
+				LinesChanged: -1,
+			}},
+			wantFields: []string{"labels[0]", "labels[1]", "metadata.source_branch", "metadata.url", "metadata.lines_changed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pr.Validate()
+			if got := fieldNames(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v (err = %v)", got, tt.wantFields, err)
+			}
+		})
+	}
+}
+
+func TestNotificationSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		settings   NotificationSettings
+		wantFields []string
+	}{
+		{name: "empty settings", settings: NotificationSettings{}},
+		{
+			name:     "valid contacts",
+			settings: NotificationSettings{Email: "alice@example.com", ChatHandle: "<@U024BE7LH>", Locale: LocaleEN},
+		},
+		{
+			name:       "display name instead of address",
+			settings:   NotificationSettings{Email: "Alice <alice@example.com>"},
+			wantFields: []string{"email"},
+		},
+		{
+			name:       "invalid handle and locale",
+			settings:   NotificationSettings{Email: "alice", ChatHandle: "@alice smith", Locale: "de"},
+			wantFields: []string{"email", "chat_handle", "locale"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if got := fieldNames(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	got := NormalizeTags([]string{" Go ", "postgres", "go", "C++"})
+	want := []string{"c++", "go", "postgres"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeTags() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	tooMany := make([]string, MaxTags+1)
+	for i := range tooMany {
+		tooMany[i] = "t" + strings.Repeat("x", i)
+	}
+
+	tests := []struct {
+		name       string
+		tags       []string
+		wantFields []string
+	}{
+		{name: "valid tags", tags: []string{"c#", "c++", "go", "node.js", "ci_cd", "k8s-ops"}},
+		{name: "no tags"},
+		{name: "too many tags", tags: tooMany, wantFields: []string{"tags"}},
+		{name: "tag longer than column", tags: []string{strings.Repeat("a", MaxTagLength+1)}, wantFields: []string{"tags[0]"}},
+		{name: "upper case and spaces", tags: []string{"ok", "Go", "two words"}, wantFields: []string{"tags[1]", "tags[2]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTags(tt.tags)
+			if got := fieldNames(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v (err = %v)", got, tt.wantFields, err)
+			}
+		})
+	}
+}
+
+func TestValidatePriority(t *testing.T) {
+	for _, priority := range PRPriorities {
+		if err := ValidatePriority(priority); err != nil {
+			t.Errorf("ValidatePriority(%q) error = %v", priority, err)
+		}
+	}
+	for _, priority := range []PRPriority{"", "urgent", "HOTFIX"} {
+		if got := fieldNames(ValidatePriority(priority)); !reflect.DeepEqual(got, []string{"priority"}) {
+			t.Errorf("ValidatePriority(%q) fields = %v, want [priority]", priority, got)
+		}
+	}
+}
+
+func TestNormalizeLabels(t *testing.T) {
+	got := NormalizeLabels([]string{" needs review", "Backend", "needs review "})
+	want := []string{"Backend", "needs review"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeLabels() = %v, want %v", got, want)
+	}
+}
+
+func TestPRUpdate_Validate(t *testing.T) {
+	labels := []string{"backend"}
+	tooMany := make([]string, MaxLabels+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("l%d", i)
+	}
+
+	tests := []struct {
+		name       string
+		update     PRUpdate
+		wantFields []string
+	}{
+		{name: "labels only", update: PRUpdate{Labels: &labels}},
+		{name: "clear metadata", update: PRUpdate{Metadata: &PRMetadata{}}},
+		{name: "nothing to update", wantFields: []string{"labels"}},
+		{name: "too many labels", update: PRUpdate{Labels: &tooMany}, wantFields: []string{"labels"}},
+		{name: "ftp url", update: PRUpdate{Metadata: &PRMetadata{URL: "ftp://git.example.com/pull/1"}}, wantFields: []string{"metadata.url"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.update.Validate()
+			if got := fieldNames(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v (err = %v)", got, tt.wantFields, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+const (
+	// HeartbeatInterval - как часто в простаивающий поток пишется комментарий, чтобы прокси не рвали соединение
+	HeartbeatInterval = 15 * time.Second
+
+	// subscriberBuffer - на сколько событий подписчик может отстать, прежде чем его поток будет закрыт
+	subscriberBuffer = 256
+	pageSize         = 100
+)
+
+// ErrSubscriberLagged - подписчик не успевал читать события; клиент переподключается с Last-Event-ID и ничего не теряет
+var ErrSubscriberLagged = errors.New("subscriber is too slow")
+
+// Loader читает события из журнала. EventsAfter кроме событий возвращает id последней просмотренной записи:
+// записи, из которых не удалось построить событие, пропускаются, и курсор всё равно сдвигается за них
+type Loader interface {
+	LastEventID(ctx context.Context) (int64, error)
+	EventsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.ReviewEvent, int64, error)
+}
+
+// Sink - получатель событий одного подписчика, например SSE-ответ
+type Sink interface {
+	Send(event *domain.ReviewEvent) error
+	Heartbeat() error
+}
+
+type subscriber struct {
+	filter domain.EventFilter
+	events chan *domain.ReviewEvent
+	lagged chan struct{}
+}
+
+// Broker раздаёт события подписчикам своей реплики. Уведомления о новых событиях приходят через wake
+// (LISTEN/NOTIFY), сами события читаются из журнала, поэтому пропущенное уведомление не теряет события
+type Broker struct {
+	loader Loader
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	lastID      int64
+}
+
+func NewBroker(loader Loader) *Broker {
+	return &Broker{
+		loader:      loader,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Run рассылает новые события после каждого сигнала wake, пока не отменён ctx
+func (b *Broker) Run(ctx context.Context, wake <-chan struct{}) error {
+	lastID, err := b.loader.LastEventID(ctx)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.lastID = lastID
+	b.mu.Unlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+			b.publishNew(ctx)
+		}
+	}
+}
+
+func (b *Broker) publishNew(ctx context.Context) {
+	for {
+		b.mu.Lock()
+		lastID := b.lastID
+		b.mu.Unlock()
+
+		events, next, err := b.loader.EventsAfter(ctx, lastID, pageSize)
+		if err != nil {
+			log.Printf("Failed to load review events after %d: %v", lastID, err)
+			return
+		}
+		for _, event := range events {
+			b.publish(event)
+		}
+		if next <= lastID {
+			return
+		}
+
+		b.mu.Lock()
+		b.lastID = next
+		b.mu.Unlock()
+	}
+}
+
+func (b *Broker) publish(event *domain.ReviewEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID = event.ID
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.lagged)
+		}
+	}
+}
+
+// Stream отправляет в sink события, подходящие под filter, пока не отменён ctx.
+// Если lastEventID задан, сначала досылаются события после него, иначе поток начинается с новых событий
+func (b *Broker) Stream(ctx context.Context, filter domain.EventFilter, lastEventID *int64, sink Sink) error {
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan *domain.ReviewEvent, subscriberBuffer),
+		lagged: make(chan struct{}),
+	}
+
+	// подписка и чтение курсора под одной блокировкой: всё, что опубликовано позже, попадёт в sub.events
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	cursor := b.lastID
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.subscribers, sub)
+		b.mu.Unlock()
+	}()
+
+	if lastEventID != nil {
+		var err error
+		if cursor, err = b.replay(ctx, filter, *lastEventID, sink); err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.lagged:
+			return ErrSubscriberLagged
+		case event := <-sub.events:
+			// событие уже отправлено при досылке
+			if event.ID <= cursor {
+				continue
+			}
+			if err := sink.Send(event); err != nil {
+				return err
+			}
+			cursor = event.ID
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replay досылает события из журнала и возвращает id последнего просмотренного события
+func (b *Broker) replay(ctx context.Context, filter domain.EventFilter, afterID int64, sink Sink) (int64, error) {
+	cursor := afterID
+	for {
+		events, next, err := b.loader.EventsAfter(ctx, cursor, pageSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range events {
+			if !filter.Matches(event) {
+				continue
+			}
+			if err := sink.Send(event); err != nil {
+				return 0, err
+			}
+		}
+		if next <= cursor {
+			return cursor, nil
+		}
+		cursor = next
+	}
+}
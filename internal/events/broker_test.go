@@ -0,0 +1,275 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// fakeLoader хранит журнал в памяти; записи из skipped просматриваются, но событий не дают
+type fakeLoader struct {
+	mu      sync.Mutex
+	events  []*domain.ReviewEvent
+	skipped map[int64]bool
+}
+
+func (l *fakeLoader) skip(ids ...int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.skipped == nil {
+		l.skipped = make(map[int64]bool)
+	}
+	for _, id := range ids {
+		l.skipped[id] = true
+		l.events = append(l.events, &domain.ReviewEvent{ID: id})
+	}
+}
+
+func (l *fakeLoader) add(events ...*domain.ReviewEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, events...)
+}
+
+func (l *fakeLoader) LastEventID(ctx context.Context) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.events) == 0 {
+		return 0, nil
+	}
+	return l.events[len(l.events)-1].ID, nil
+}
+
+func (l *fakeLoader) EventsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.ReviewEvent, int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var result []*domain.ReviewEvent
+	next, scanned := afterID, 0
+	for _, event := range l.events {
+		if event.ID <= afterID || scanned == limit {
+			continue
+		}
+		scanned++
+		if !l.skipped[event.ID] {
+			result = append(result, event)
+		}
+		next = event.ID
+	}
+	return result, next, nil
+}
+
+// chanSink передаёт отправленные события в канал
+type chanSink struct {
+	events chan *domain.ReviewEvent
+}
+
+func (s *chanSink) Send(event *domain.ReviewEvent) error {
+	s.events <- event
+	return nil
+}
+
+func (s *chanSink) Heartbeat() error {
+	return nil
+}
+
+func event(id int64, authorID string, reviewers ...string) *domain.ReviewEvent {
+	return &domain.ReviewEvent{
+		ID:            id,
+		Type:          domain.EventReviewerAssigned,
+		PullRequestID: "pr_1",
+		AuthorID:      authorID,
+		TeamName:      "backend",
+		Reviewers:     reviewers,
+	}
+}
+
+func receive(t *testing.T, sink *chanSink) *domain.ReviewEvent {
+	t.Helper()
+	select {
+	case e := <-sink.events:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func expectNothing(t *testing.T, sink *chanSink) {
+	t.Helper()
+	select {
+	case e := <-sink.events:
+		t.Fatalf("unexpected event %d", e.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// startBroker запускает Broker и ждёт, пока он прочитает последний id журнала
+func startBroker(t *testing.T, loader *fakeLoader) (*Broker, chan struct{}) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	broker := NewBroker(loader)
+	wake := make(chan struct{})
+	go broker.Run(ctx, wake)
+	wake <- struct{}{}
+	return broker, wake
+}
+
+func stream(t *testing.T, broker *Broker, filter domain.EventFilter, lastEventID *int64) *chanSink {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sink := &chanSink{events: make(chan *domain.ReviewEvent, 16)}
+	subscribers := subscriberCount(broker)
+	go broker.Stream(ctx, filter, lastEventID, sink)
+	waitSubscribers(t, broker, subscribers+1)
+	return sink
+}
+
+func subscriberCount(broker *Broker) int {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	return len(broker.subscribers)
+}
+
+// waitSubscribers ждёт, пока Stream зарегистрирует подписку
+func waitSubscribers(t *testing.T, broker *Broker, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for subscriberCount(broker) < want {
+		if time.Now().After(deadline) {
+			t.Fatal("subscription was not registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBroker_LiveEventsWithFilter(t *testing.T) {
+	loader := &fakeLoader{}
+	loader.add(event(1, "user_1", "user_2"))
+	broker, wake := startBroker(t, loader)
+
+	all := stream(t, broker, domain.EventFilter{}, nil)
+	reviewer := stream(t, broker, domain.EventFilter{UserID: "user_3"}, nil)
+	otherTeam := stream(t, broker, domain.EventFilter{TeamName: "frontend"}, nil)
+
+	loader.add(event(2, "user_1", "user_2"), event(3, "user_1", "user_3"))
+	wake <- struct{}{}
+
+	// без Last-Event-ID старые события не досылаются
+	if got := receive(t, all).ID; got != 2 {
+		t.Errorf("First live event = %d, want 2", got)
+	}
+	if got := receive(t, all).ID; got != 3 {
+		t.Errorf("Second live event = %d, want 3", got)
+	}
+	if got := receive(t, reviewer).ID; got != 3 {
+		t.Errorf("Reviewer event = %d, want 3", got)
+	}
+	expectNothing(t, reviewer)
+	expectNothing(t, otherTeam)
+}
+
+func TestBroker_ResumeFromLastEventID(t *testing.T) {
+	loader := &fakeLoader{}
+	for id := int64(1); id <= 150; id++ {
+		loader.add(event(id, "user_1", "user_2"))
+	}
+	broker, wake := startBroker(t, loader)
+	// событие уже в журнале, но Broker ещё не разослал его
+	loader.add(event(151, "user_1", "user_2"))
+
+	lastEventID := int64(120)
+	sink := stream(t, broker, domain.EventFilter{}, &lastEventID)
+	for want := int64(121); want <= 151; want++ {
+		if got := receive(t, sink).ID; got != want {
+			t.Fatalf("Replayed event = %d, want %d", got, want)
+		}
+	}
+
+	// событие, попавшее и в досылку, и в живой поток, не дублируется
+	wake <- struct{}{}
+	expectNothing(t, sink)
+
+	loader.add(event(152, "user_1", "user_2"))
+	wake <- struct{}{}
+	if got := receive(t, sink).ID; got != 152 {
+		t.Errorf("Live event after replay = %d, want 152", got)
+	}
+}
+
+func TestBroker_SkippedEntriesAdvanceCursor(t *testing.T) {
+	loader := &fakeLoader{}
+	broker, wake := startBroker(t, loader)
+	live := stream(t, broker, domain.EventFilter{}, nil)
+
+	// записи, из которых нельзя построить событие, занимают больше страницы
+	for id := int64(1); id <= pageSize+20; id++ {
+		loader.skip(id)
+	}
+	loader.add(event(pageSize+21, "user_1", "user_2"))
+	wake <- struct{}{}
+
+	if got := receive(t, live).ID; got != pageSize+21 {
+		t.Errorf("Live event = %d, want %d", got, pageSize+21)
+	}
+
+	lastEventID := int64(0)
+	replayed := stream(t, broker, domain.EventFilter{}, &lastEventID)
+	if got := receive(t, replayed).ID; got != pageSize+21 {
+		t.Errorf("Replayed event = %d, want %d", got, pageSize+21)
+	}
+	expectNothing(t, replayed)
+}
+
+func TestBroker_SlowSubscriberIsDisconnected(t *testing.T) {
+	loader := &fakeLoader{}
+	broker, wake := startBroker(t, loader)
+
+	release := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- broker.Stream(context.Background(), domain.EventFilter{}, nil, blockingSink{release})
+	}()
+	waitSubscribers(t, broker, 1)
+
+	for id := int64(1); id <= subscriberBuffer+2; id++ {
+		loader.add(event(id, "user_1"))
+	}
+	wake <- struct{}{}
+	// второй сигнал принимается только после того, как первая рассылка закончилась
+	wake <- struct{}{}
+	close(release)
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, ErrSubscriberLagged) {
+			t.Errorf("Stream() error = %v, want ErrSubscriberLagged", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("slow subscriber was not disconnected")
+	}
+	if subscriberCount(broker) != 0 {
+		t.Error("slow subscriber should be removed")
+	}
+}
+
+// blockingSink не принимает события, пока не закрыт release
+type blockingSink struct {
+	release chan struct{}
+}
+
+func (s blockingSink) Send(event *domain.ReviewEvent) error {
+	<-s.release
+	return nil
+}
+
+func (s blockingSink) Heartbeat() error {
+	return nil
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// NotifyChannel - канал NOTIFY, в который триггер на audit_log пишет id новых записей о PR
+const NotifyChannel = "review_events"
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	// pollInterval - страховка на случай потерянного уведомления: журнал перечитывается и без NOTIFY
+	pollInterval = 30 * time.Second
+)
+
+// Listen подписывается на NotifyChannel и сигнализирует в возвращаемый канал о новых событиях.
+// Сигналы склеиваются: Broker сам дочитывает все события после последнего отправленного
+func Listen(ctx context.Context, dsn string) (<-chan struct{}, error) {
+	listener := pq.NewListener(dsn, minReconnectInterval, maxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Review events listener: %v", err)
+		}
+	})
+	if err := listener.Listen(NotifyChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	wake := make(chan struct{}, 1)
+	signal := func() {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+
+	go func() {
+		defer listener.Close()
+
+		poll := time.NewTicker(pollInterval)
+		defer poll.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			// после переподключения приходит nil: уведомления за время обрыва потеряны, журнал надо перечитать
+			case <-listener.Notify:
+				signal()
+			case <-poll.C:
+				go listener.Ping()
+				signal()
+			}
+		}
+	}()
+
+	return wake, nil
+}
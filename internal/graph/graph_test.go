@@ -0,0 +1,383 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"avito-test-task/internal/domain"
+)
+
+// store - данные в памяти, считающие обращения, чтобы проверять отсутствие N+1
+type store struct {
+	teams []*domain.Team
+	users []*domain.User
+	prs   []*domain.PullRequest
+	err   error
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (s *store) count(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.calls == nil {
+		s.calls = make(map[string]int)
+	}
+	s.calls[method]++
+}
+
+func (s *store) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	s.count("GetTeam")
+	for _, t := range s.teams {
+		if t.Name == teamName {
+			return t, nil
+		}
+	}
+	return nil, domain.ErrTeamNotFound
+}
+
+func (s *store) ListTeams(ctx context.Context) ([]*domain.Team, error) {
+	s.count("ListTeams")
+	return s.teams, s.err
+}
+
+func (s *store) GetTeamsByIDs(ctx context.Context, ids []int) ([]*domain.Team, error) {
+	s.count("GetTeamsByIDs")
+	var result []*domain.Team
+	for _, t := range s.teams {
+		for _, id := range ids {
+			if t.ID == id {
+				result = append(result, t)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *store) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	s.count("GetUsersByIDs")
+	var result []*domain.User
+	for _, u := range s.users {
+		for _, id := range userIDs {
+			if u.ID == id {
+				result = append(result, u)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *store) GetUsersByTeamIDs(ctx context.Context, teamIDs []int) (map[int][]*domain.User, error) {
+	s.count("GetUsersByTeamIDs")
+	result := make(map[int][]*domain.User)
+	for _, u := range s.users {
+		for _, id := range teamIDs {
+			if u.TeamID == id {
+				result[id] = append(result[id], u)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *store) GetPR(ctx context.Context, id string) (*domain.PullRequest, error) {
+	s.count("GetPR")
+	for _, pr := range s.prs {
+		if pr.ID == id {
+			return pr, nil
+		}
+	}
+	return nil, domain.ErrPRNotFound
+}
+
+func (s *store) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]*domain.PullRequest, error) {
+	s.count("GetOpenPRsByReviewers")
+	result := make(map[string][]*domain.PullRequest)
+	for _, pr := range s.prs {
+		if pr.Status != domain.PRStatusOpen {
+			continue
+		}
+		for _, reviewer := range pr.AssignedReviewers {
+			for _, id := range reviewerIDs {
+				if reviewer == id {
+					result[id] = append(result[id], pr)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func newStore() *store {
+	s := &store{
+		teams: []*domain.Team{
+			{ID: 1, Name: "backend", ReviewersCount: 2},
+			{ID: 2, Name: "frontend", ReviewersCount: 2},
+		},
+	}
+	for _, u := range []struct {
+		id     string
+		teamID int
+	}{{"u1", 1}, {"u2", 1}, {"u3", 1}, {"u4", 2}, {"u5", 2}} {
+		teamName := "backend"
+		if u.teamID == 2 {
+			teamName = "frontend"
+		}
+		s.users = append(s.users, &domain.User{ID: u.id, Username: "name_" + u.id, TeamID: u.teamID, TeamName: teamName, IsActive: true})
+	}
+	s.prs = []*domain.PullRequest{
+		{ID: "pr_1", Title: "Search", AuthorID: "u1", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2", "u3"}},
+		{ID: "pr_2", Title: "Cart", AuthorID: "u4", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u5"}},
+		{ID: "pr_3", Title: "Old", AuthorID: "u2", Status: domain.PRStatusMerged, AssignedReviewers: []string{"u3"}},
+	}
+	return s
+}
+
+func newTestHandler(t *testing.T, s *store) *Handler {
+	t.Helper()
+	h, err := NewHandler(s, s, s, Limits{MaxDepth: 7, MaxComplexity: 20000})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	return h
+}
+
+func execute(t *testing.T, h *Handler, query string) map[string]any {
+	t.Helper()
+	result, ok := h.Execute(context.Background(), query, "", nil)
+	if !ok || result.HasErrors() {
+		t.Fatalf("Execute() ok = %v, errors = %v", ok, result.Errors)
+	}
+
+	// сравнение через JSON избавляет тест от внутренних типов исполнителя
+	data, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatalf("marshal result: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal result: %v", err)
+	}
+	return decoded
+}
+
+func TestExecute_DashboardBatchesLoads(t *testing.T) {
+	s := newStore()
+	h := newTestHandler(t, s)
+
+	data := execute(t, h, `{
+		teams {
+			name
+			members {
+				id
+				openReviews {
+					id
+					status
+					author { username }
+					reviewers { id team { name } }
+				}
+			}
+		}
+	}`)
+
+	// каждый уровень запроса - одно обращение к usecase, сколько бы ни было команд, участников и PR
+	want := map[string]int{
+		"ListTeams":             1,
+		"GetUsersByTeamIDs":     1,
+		"GetOpenPRsByReviewers": 1,
+		"GetUsersByIDs":         1,
+		"GetTeamsByIDs":         1,
+	}
+	for method, calls := range want {
+		if s.calls[method] != calls {
+			t.Errorf("%s called %d times, want %d", method, s.calls[method], calls)
+		}
+	}
+
+	got, _ := json.Marshal(data["teams"].([]any)[0])
+	wantJSON := `{"members":[` +
+		`{"id":"u1","openReviews":[]},` +
+		`{"id":"u2","openReviews":[{"author":{"username":"name_u1"},"id":"pr_1",` +
+		`"reviewers":[{"id":"u2","team":{"name":"backend"}},{"id":"u3","team":{"name":"backend"}}],"status":"OPEN"}]},` +
+		`{"id":"u3","openReviews":[{"author":{"username":"name_u1"},"id":"pr_1",` +
+		`"reviewers":[{"id":"u2","team":{"name":"backend"}},{"id":"u3","team":{"name":"backend"}}],"status":"OPEN"}]}` +
+		`],"name":"backend"}`
+	if string(got) != wantJSON {
+		t.Errorf("teams[0] = %s\nwant %s", got, wantJSON)
+	}
+}
+
+func TestExecute_NotFoundIsNull(t *testing.T) {
+	h := newTestHandler(t, newStore())
+
+	data := execute(t, h, `{ team(name: "mobile") { name } user(id: "u9") { id } pullRequest(id: "pr_9") { id } }`)
+	for _, field := range []string{"team", "user", "pullRequest"} {
+		if data[field] != nil {
+			t.Errorf("%s = %v, want null", field, data[field])
+		}
+	}
+}
+
+func TestExecute_PullRequestDetails(t *testing.T) {
+	s := newStore()
+	s.prs[0].Priority = domain.PRPriorityHotfix
+	s.prs[0].Labels = []string{"backend", "search"}
+	s.prs[0].Metadata = domain.PRMetadata{Repository: "org/search", URL: "https://git.example.com/org/search/pull/1", LinesChanged: 42}
+	h := newTestHandler(t, s)
+
+	data := execute(t, h, `{
+		a: pullRequest(id: "pr_1") { priority labels repository sourceBranch url linesChanged }
+		b: pullRequest(id: "pr_2") { priority labels repository linesChanged }
+	}`)
+
+	got, _ := json.Marshal(data)
+	want := `{"a":{"labels":["backend","search"],"linesChanged":42,"priority":"HOTFIX","repository":"org/search",` +
+		`"sourceBranch":null,"url":"https://git.example.com/org/search/pull/1"},` +
+		`"b":{"labels":[],"linesChanged":0,"priority":"NORMAL","repository":null}}`
+	if string(got) != want {
+		t.Errorf("data = %s\nwant %s", got, want)
+	}
+}
+
+func TestExecute_TeamMembersFromGetTeam(t *testing.T) {
+	s := newStore()
+	s.teams[0].Members = []domain.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}
+	h := newTestHandler(t, s)
+
+	data := execute(t, h, `{ team(name: "backend") { members { username teamName } } }`)
+	members := data["team"].(map[string]any)["members"].([]any)
+	if len(members) != 1 || members[0].(map[string]any)["username"] != "Alice" {
+		t.Errorf("members = %v, want Alice from GetTeam", members)
+	}
+	if s.calls["GetUsersByTeamIDs"] != 0 {
+		t.Error("members already loaded by GetTeam should not be loaded again")
+	}
+}
+
+func TestExecute_InternalErrorIsHidden(t *testing.T) {
+	s := newStore()
+	s.err = errors.New(`pq: relation "teams" does not exist`)
+	h := newTestHandler(t, s)
+
+	result, ok := h.Execute(context.Background(), `{ teams { name } }`, "", nil)
+	if !ok {
+		t.Fatal("query should reach execution")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Message != internalMessage {
+		t.Errorf("errors = %v, want %q", result.Errors, internalMessage)
+	}
+}
+
+func TestExecute_Limits(t *testing.T) {
+	h := newTestHandler(t, newStore())
+	h.limits = Limits{MaxDepth: 4, MaxComplexity: 300}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{
+			name:  "within limits",
+			query: `{ teams { name members { id } } }`,
+		},
+		{
+			name:    "too deep",
+			query:   `{ teams { members { openReviews { reviewers { team { name } } } } } }`,
+			wantErr: "depth 6, maximum is 4",
+		},
+		{
+			// teams: 1 + 10 * (members: 1 + 10 * (id + username + isActive)) = 311
+			name:    "too complex through fragment",
+			query:   `query Dashboard { teams { ...Members } } fragment Members on Team { members { id username isActive } }`,
+			wantErr: "query Dashboard has complexity 311, maximum is 300",
+		},
+		{
+			name:  "introspection is not counted",
+			query: `{ __schema { types { name fields { name type { name ofType { name ofType { name } } } } } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := h.Execute(context.Background(), tt.query, "", nil)
+			if tt.wantErr == "" {
+				if !ok || result.HasErrors() {
+					t.Errorf("Execute() errors = %v, want none", result.Errors)
+				}
+				return
+			}
+			if ok {
+				t.Fatal("query should be rejected before execution")
+			}
+			var found bool
+			for _, err := range result.Errors {
+				found = found || strings.Contains(err.Message, tt.wantErr)
+			}
+			if !found {
+				t.Errorf("errors = %v, want %q", result.Errors, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	h := newTestHandler(t, newStore())
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "query",
+			method:     http.MethodPost,
+			body:       `{"query":"query One($id: ID!) { user(id: $id) { username } }","variables":{"id":"u2"}}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"data":{"user":{"username":"name_u2"}}}`,
+		},
+		{
+			name:       "unknown field",
+			method:     http.MethodPost,
+			body:       `{"query":"{ teams { title } }"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `Cannot query field \"title\" on type \"Team\".`,
+		},
+		{
+			name:       "malformed body",
+			method:     http.MethodPost,
+			body:       `query { teams }`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "request body must be JSON",
+		},
+		{
+			name:       "get is not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "only POST is supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, "/graphql", strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// maxRequestSize ограничивает тело запроса; запросы дашбордов занимают единицы килобайт
+const maxRequestSize = 1 << 20
+
+// Handler обслуживает POST /graphql - API только для чтения поверх usecase
+type Handler struct {
+	schema   graphql.Schema
+	resolver *resolver
+	limits   Limits
+}
+
+func NewHandler(teams TeamReader, users UserReader, prs PRReader, limits Limits) (*Handler, error) {
+	r := &resolver{teams: teams, users: users, prs: prs}
+	schema, err := newSchema(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{schema: schema, resolver: r, limits: limits}, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeResult(w, http.StatusMethodNotAllowed, errorResult("only POST is supported"))
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); err != nil {
+		writeResult(w, http.StatusBadRequest, errorResult("request body must be JSON with a query field"))
+		return
+	}
+
+	result, ok := h.Execute(r.Context(), req.Query, req.OperationName, req.Variables)
+	status := http.StatusOK
+	// запрос не дошёл до выполнения: синтаксис, валидация или лимиты
+	if !ok {
+		status = http.StatusBadRequest
+	}
+	writeResult(w, status, result)
+}
+
+// Execute разбирает и проверяет запрос, затем выполняет его с загрузчиками этого запроса.
+// ok ложно, если запрос отклонён до выполнения
+func (h *Handler) Execute(ctx context.Context, query, operationName string, variables map[string]any) (*graphql.Result, bool) {
+	if query == "" {
+		return errorResult("query must not be empty"), false
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, false
+	}
+	if validation := graphql.ValidateDocument(&h.schema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, false
+	}
+	if errs := checkLimits(&h.schema, doc, h.limits); len(errs) > 0 {
+		return &graphql.Result{Errors: errs}, false
+	}
+
+	ctx = withLoaders(ctx, newLoaders(h.resolver.teams, h.resolver.users, h.resolver.prs))
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: operationName,
+		Args:          variables,
+		Context:       ctx,
+	}), true
+}
+
+func errorResult(message string) *graphql.Result {
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(message)}}
+}
+
+func writeResult(w http.ResponseWriter, status int, result *graphql.Result) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		slog.Warn("Failed to write GraphQL response", "error", err)
+	}
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits ограничивает запрос до выполнения. Глубина - число вложенных уровней полей,
+// сложность - оценка числа разрешаемых полей: поле стоит 1, поля внутри списка умножаются на его ожидаемый размер
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// listSizes - ожидаемый размер списков для оценки сложности; для остальных списков используется defaultListSize
+var listSizes = map[string]int{
+	"Query.teams":           10,
+	"Team.members":          10,
+	"User.openReviews":      5,
+	"PullRequest.reviewers": 5,
+}
+
+const defaultListSize = 10
+
+// checkLimits проверяет каждую операцию документа. Поля интроспекции (__schema, __type) не учитываются:
+// стандартный запрос интроспекции глубже любого запроса к данным
+func checkLimits(schema *graphql.Schema, doc *ast.Document, limits Limits) []gqlerrors.FormattedError {
+	a := analyzer{fragments: make(map[string]*ast.FragmentDefinition)}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			a.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var errs []gqlerrors.FormattedError
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok || op.Operation != ast.OperationTypeQuery {
+			continue
+		}
+
+		cost, depth := a.selectionSet(schema.QueryType(), op.SelectionSet, map[string]bool{})
+		name := "query"
+		if op.Name != nil {
+			name = "query " + op.Name.Value
+		}
+		if depth > limits.MaxDepth {
+			errs = append(errs, gqlerrors.NewFormattedError(
+				fmt.Sprintf("%s has depth %d, maximum is %d", name, depth, limits.MaxDepth)))
+		}
+		if cost > limits.MaxComplexity {
+			errs = append(errs, gqlerrors.NewFormattedError(
+				fmt.Sprintf("%s has complexity %d, maximum is %d", name, cost, limits.MaxComplexity)))
+		}
+	}
+	return errs
+}
+
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+}
+
+// selectionSet возвращает сложность и глубину набора полей объекта parent.
+// visiting защищает от циклов фрагментов, если документ не прошёл валидацию
+func (a *analyzer) selectionSet(parent *graphql.Object, set *ast.SelectionSet, visiting map[string]bool) (cost, depth int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, selection := range set.Selections {
+		var c, d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			c, d = a.field(parent, s, visiting)
+		// в схеме нет интерфейсов и объединений, поэтому фрагмент всегда относится к типу родителя
+		case *ast.InlineFragment:
+			c, d = a.selectionSet(parent, s.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			fragment, ok := a.fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			c, d = a.selectionSet(parent, fragment.SelectionSet, visiting)
+			delete(visiting, name)
+		}
+		cost += c
+		depth = max(depth, d)
+	}
+	return cost, depth
+}
+
+func (a *analyzer) field(parent *graphql.Object, field *ast.Field, visiting map[string]bool) (cost, depth int) {
+	name := field.Name.Value
+	if strings.HasPrefix(name, "__") {
+		return 0, 0
+	}
+	def, ok := parent.Fields()[name]
+	if !ok {
+		return 0, 0
+	}
+
+	fieldType, isList := unwrap(def.Type)
+	object, ok := fieldType.(*graphql.Object)
+	if !ok {
+		return 1, 1
+	}
+
+	childCost, childDepth := a.selectionSet(object, field.SelectionSet, visiting)
+	if isList {
+		size, ok := listSizes[parent.Name()+"."+name]
+		if !ok {
+			size = defaultListSize
+		}
+		childCost *= size
+	}
+	return 1 + childCost, 1 + childDepth
+}
+
+// unwrap снимает NonNull и List и сообщает, был ли среди обёрток список
+func unwrap(t graphql.Type) (graphql.Type, bool) {
+	var isList bool
+	for {
+		switch wrapped := t.(type) {
+		case *graphql.NonNull:
+			t = wrapped.OfType
+		case *graphql.List:
+			isList = true
+			t = wrapped.OfType
+		default:
+			return t, isList
+		}
+	}
+}
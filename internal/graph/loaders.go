@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"avito-test-task/internal/domain"
+)
+
+// batchWait - сколько загрузчик копит ключи. Исполнитель сначала собирает все поля одного уровня запроса
+// и только потом ждёт результаты, поэтому короткого окна достаточно, чтобы уровень ушёл в БД одним запросом
+const batchWait = 2 * time.Millisecond
+
+// TeamReader, UserReader и PRReader - операции чтения usecase, которыми пользуется схема
+type TeamReader interface {
+	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
+	ListTeams(ctx context.Context) ([]*domain.Team, error)
+	GetTeamsByIDs(ctx context.Context, ids []int) ([]*domain.Team, error)
+}
+
+type UserReader interface {
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error)
+	GetUsersByTeamIDs(ctx context.Context, teamIDs []int) (map[int][]*domain.User, error)
+}
+
+type PRReader interface {
+	GetPR(ctx context.Context, id string) (*domain.PullRequest, error)
+	GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]*domain.PullRequest, error)
+}
+
+// loaders живут один запрос: кэш загрузчика не должен отдавать данные, изменённые после запроса
+type loaders struct {
+	teams       *dataloader.Loader[int, *domain.Team]
+	users       *dataloader.Loader[string, *domain.User]
+	members     *dataloader.Loader[int, []*domain.User]
+	openReviews *dataloader.Loader[string, []*domain.PullRequest]
+}
+
+func newLoaders(teams TeamReader, users UserReader, prs PRReader) *loaders {
+	return &loaders{
+		teams: dataloader.NewBatchedLoader(func(ctx context.Context, ids []int) []*dataloader.Result[*domain.Team] {
+			found, err := teams.GetTeamsByIDs(ctx, ids)
+			return byKey(ids, found, err, func(t *domain.Team) int { return t.ID })
+		}, dataloader.WithWait[int, *domain.Team](batchWait)),
+
+		users: dataloader.NewBatchedLoader(func(ctx context.Context, ids []string) []*dataloader.Result[*domain.User] {
+			found, err := users.GetUsersByIDs(ctx, ids)
+			return byKey(ids, found, err, func(u *domain.User) string { return u.ID })
+		}, dataloader.WithWait[string, *domain.User](batchWait)),
+
+		members: dataloader.NewBatchedLoader(func(ctx context.Context, teamIDs []int) []*dataloader.Result[[]*domain.User] {
+			found, err := users.GetUsersByTeamIDs(ctx, teamIDs)
+			return grouped(teamIDs, found, err)
+		}, dataloader.WithWait[int, []*domain.User](batchWait)),
+
+		openReviews: dataloader.NewBatchedLoader(func(ctx context.Context, userIDs []string) []*dataloader.Result[[]*domain.PullRequest] {
+			found, err := prs.GetOpenPRsByReviewers(ctx, userIDs)
+			return grouped(userIDs, found, err)
+		}, dataloader.WithWait[string, []*domain.PullRequest](batchWait)),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// byKey раскладывает найденные значения в порядке ключей; для отсутствующих ключей значение nil
+func byKey[K comparable, V any](keys []K, found []V, err error, key func(V) K) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], len(keys))
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[V]{Error: err}
+		}
+		return results
+	}
+
+	index := make(map[K]V, len(found))
+	for _, v := range found {
+		index[key(v)] = v
+	}
+	for i, k := range keys {
+		results[i] = &dataloader.Result[V]{Data: index[k]}
+	}
+	return results
+}
+
+// grouped раскладывает списки по ключам; для ключей без записей - пустой список
+func grouped[K comparable, V any](keys []K, groups map[K][]V, err error) []*dataloader.Result[[]V] {
+	results := make([]*dataloader.Result[[]V], len(keys))
+	for i, k := range keys {
+		if err != nil {
+			results[i] = &dataloader.Result[[]V]{Error: err}
+			continue
+		}
+		list := groups[k]
+		if list == nil {
+			list = []V{}
+		}
+		results[i] = &dataloader.Result[[]V]{Data: list}
+	}
+	return results
+}
@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/graphql-go/graphql"
+
+	"avito-test-task/internal/domain"
+)
+
+const internalMessage = "internal server error"
+
+// resolver связывает поля схемы с usecase; связи между объектами загружаются пакетно через loaders
+type resolver struct {
+	teams TeamReader
+	users UserReader
+	prs   PRReader
+}
+
+func newSchema(r *resolver) (graphql.Schema, error) {
+	prStatus := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestStatus",
+		Values: graphql.EnumValueConfigMap{
+			"OPEN":   &graphql.EnumValueConfig{Value: domain.PRStatusOpen},
+			"MERGED": &graphql.EnumValueConfig{Value: domain.PRStatusMerged},
+		},
+	})
+	prPriority := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestPriority",
+		Values: graphql.EnumValueConfigMap{
+			"LOW":    &graphql.EnumValueConfig{Value: domain.PRPriorityLow},
+			"NORMAL": &graphql.EnumValueConfig{Value: domain.PRPriorityNormal},
+			"HIGH":   &graphql.EnumValueConfig{Value: domain.PRPriorityHigh},
+			"HOTFIX": &graphql.EnumValueConfig{Value: domain.PRPriorityHotfix},
+		},
+	})
+
+	team := graphql.NewObject(graphql.ObjectConfig{Name: "Team", Fields: graphql.Fields{}})
+	user := graphql.NewObject(graphql.ObjectConfig{Name: "User", Fields: graphql.Fields{}})
+	pullRequest := graphql.NewObject(graphql.ObjectConfig{Name: "PullRequest", Fields: graphql.Fields{}})
+
+	team.AddFieldConfig("name", teamField(graphql.NewNonNull(graphql.String), func(t *domain.Team) any { return t.Name }))
+	team.AddFieldConfig("reviewersCount", teamField(graphql.NewNonNull(graphql.Int), func(t *domain.Team) any { return t.ReviewersCount }))
+	team.AddFieldConfig("members", &graphql.Field{
+		Type: listOf(user),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			t := p.Source.(*domain.Team)
+			// GetTeam уже загрузил участников
+			if t.Members != nil {
+				return membersToUsers(t), nil
+			}
+			return thunk(p.Context, loadersFrom(p.Context).members.Load(p.Context, t.ID)), nil
+		},
+	})
+
+	user.AddFieldConfig("id", userField(graphql.NewNonNull(graphql.ID), func(u *domain.User) any { return u.ID }))
+	user.AddFieldConfig("username", userField(graphql.NewNonNull(graphql.String), func(u *domain.User) any { return u.Username }))
+	user.AddFieldConfig("isActive", userField(graphql.NewNonNull(graphql.Boolean), func(u *domain.User) any { return u.IsActive }))
+	user.AddFieldConfig("capacity", userField(graphql.NewNonNull(graphql.Int), func(u *domain.User) any { return u.Capacity }))
+	user.AddFieldConfig("teamName", userField(graphql.NewNonNull(graphql.String), func(u *domain.User) any { return u.TeamName }))
+	user.AddFieldConfig("team", &graphql.Field{
+		Type: graphql.NewNonNull(team),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			u := p.Source.(*domain.User)
+			return thunk(p.Context, loadersFrom(p.Context).teams.Load(p.Context, u.TeamID)), nil
+		},
+	})
+	user.AddFieldConfig("openReviews", &graphql.Field{
+		Type:        listOf(pullRequest),
+		Description: "Открытые PR, где пользователь назначен ревьювером",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			u := p.Source.(*domain.User)
+			return thunk(p.Context, loadersFrom(p.Context).openReviews.Load(p.Context, u.ID)), nil
+		},
+	})
+
+	pullRequest.AddFieldConfig("id", prField(graphql.NewNonNull(graphql.ID), func(pr *domain.PullRequest) any { return pr.ID }))
+	pullRequest.AddFieldConfig("name", prField(graphql.NewNonNull(graphql.String), func(pr *domain.PullRequest) any { return pr.Title }))
+	pullRequest.AddFieldConfig("status", prField(graphql.NewNonNull(prStatus), func(pr *domain.PullRequest) any { return pr.Status }))
+	pullRequest.AddFieldConfig("priority", prField(graphql.NewNonNull(prPriority), func(pr *domain.PullRequest) any {
+		if pr.Priority == "" {
+			return domain.PRPriorityNormal
+		}
+		return pr.Priority
+	}))
+	pullRequest.AddFieldConfig("labels", prField(listOf(graphql.String), func(pr *domain.PullRequest) any {
+		if pr.Labels == nil {
+			return []string{}
+		}
+		return pr.Labels
+	}))
+	pullRequest.AddFieldConfig("repository", prField(graphql.String, func(pr *domain.PullRequest) any { return nilIfEmpty(pr.Metadata.Repository) }))
+	pullRequest.AddFieldConfig("sourceBranch", prField(graphql.String, func(pr *domain.PullRequest) any { return nilIfEmpty(pr.Metadata.SourceBranch) }))
+	pullRequest.AddFieldConfig("targetBranch", prField(graphql.String, func(pr *domain.PullRequest) any { return nilIfEmpty(pr.Metadata.TargetBranch) }))
+	pullRequest.AddFieldConfig("url", prField(graphql.String, func(pr *domain.PullRequest) any { return nilIfEmpty(pr.Metadata.URL) }))
+	pullRequest.AddFieldConfig("linesChanged", prField(graphql.NewNonNull(graphql.Int), func(pr *domain.PullRequest) any { return pr.Metadata.LinesChanged }))
+	pullRequest.AddFieldConfig("requiredReviewers", prField(graphql.NewNonNull(graphql.Int), func(pr *domain.PullRequest) any { return pr.RequiredReviewers }))
+	pullRequest.AddFieldConfig("createdAt", prField(graphql.DateTime, func(pr *domain.PullRequest) any { return pr.CreatedAt }))
+	pullRequest.AddFieldConfig("mergedAt", prField(graphql.DateTime, func(pr *domain.PullRequest) any { return pr.MergedAt }))
+	pullRequest.AddFieldConfig("authorId", prField(graphql.NewNonNull(graphql.ID), func(pr *domain.PullRequest) any { return pr.AuthorID }))
+	pullRequest.AddFieldConfig("author", &graphql.Field{
+		Type: graphql.NewNonNull(user),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			pr := p.Source.(*domain.PullRequest)
+			return thunk(p.Context, loadersFrom(p.Context).users.Load(p.Context, pr.AuthorID)), nil
+		},
+	})
+	pullRequest.AddFieldConfig("reviewers", &graphql.Field{
+		Type: listOf(user),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			pr := p.Source.(*domain.PullRequest)
+			load := loadersFrom(p.Context).users.LoadMany(p.Context, pr.AssignedReviewers)
+			return func() (any, error) {
+				users, errs := load()
+				for _, err := range errs {
+					if err != nil {
+						return nil, publicError(p.Context, err)
+					}
+				}
+				return users, nil
+			}, nil
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"teams": &graphql.Field{
+				Type:        listOf(team),
+				Description: "Все команды",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					teams, err := r.teams.ListTeams(p.Context)
+					if err != nil {
+						return nil, publicError(p.Context, err)
+					}
+					return teams, nil
+				},
+			},
+			"team": &graphql.Field{
+				Type: team,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					t, err := r.teams.GetTeam(p.Context, p.Args["name"].(string))
+					return nullIfNotFound(p.Context, t, err, domain.ErrTeamNotFound)
+				},
+			},
+			"user": &graphql.Field{
+				Type: user,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return thunk(p.Context, loadersFrom(p.Context).users.Load(p.Context, p.Args["id"].(string))), nil
+				},
+			},
+			"pullRequest": &graphql.Field{
+				Type: pullRequest,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					pr, err := r.prs.GetPR(p.Context, p.Args["id"].(string))
+					return nullIfNotFound(p.Context, pr, err, domain.ErrPRNotFound)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func listOf(t graphql.Type) graphql.Output {
+	return graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(t)))
+}
+
+func teamField(t graphql.Output, get func(*domain.Team) any) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (any, error) {
+		return get(p.Source.(*domain.Team)), nil
+	}}
+}
+
+func userField(t graphql.Output, get func(*domain.User) any) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (any, error) {
+		return get(p.Source.(*domain.User)), nil
+	}}
+}
+
+// nilIfEmpty превращает незаданное строковое поле в null
+func nilIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func prField(t graphql.Output, get func(*domain.PullRequest) any) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (any, error) {
+		return get(p.Source.(*domain.PullRequest)), nil
+	}}
+}
+
+// thunk откладывает ожидание загрузчика: исполнитель вызывает его после того, как соберёт ключи всего уровня
+func thunk[V any](ctx context.Context, load func() (V, error)) func() (any, error) {
+	return func() (any, error) {
+		v, err := load()
+		if err != nil {
+			return nil, publicError(ctx, err)
+		}
+		return v, nil
+	}
+}
+
+func membersToUsers(t *domain.Team) []*domain.User {
+	users := make([]*domain.User, 0, len(t.Members))
+	for _, m := range t.Members {
+		users = append(users, &domain.User{
+			ID:       m.UserID,
+			Username: m.Username,
+			TeamID:   t.ID,
+			TeamName: t.Name,
+			IsActive: m.IsActive,
+			Capacity: m.Capacity,
+		})
+	}
+	return users
+}
+
+// nullIfNotFound превращает «не найдено» в null: для необязательного поля это не ошибка
+func nullIfNotFound[V any](ctx context.Context, v V, err error, notFound error) (any, error) {
+	if errors.Is(err, notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, publicError(ctx, err)
+	}
+	return v, nil
+}
+
+// publicError скрывает от клиента текст внутренних ошибок, как и HTTP API
+func publicError(ctx context.Context, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	slog.ErrorContext(ctx, "GraphQL resolver failed", "error", err)
+	return errors.New(internalMessage)
+}
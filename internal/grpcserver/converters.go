@@ -0,0 +1,98 @@
+package grpcserver
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"avito-test-task/internal/api/reviewv1"
+	"avito-test-task/internal/domain"
+)
+
+func convertTeamToDomain(team *reviewv1.Team) *domain.Team {
+	result := &domain.Team{
+		Name:           team.GetTeamName(),
+		ReviewersCount: int(team.GetReviewersCount()),
+	}
+	for _, member := range team.GetMembers() {
+		result.Members = append(result.Members, domain.TeamMember{
+			UserID:   member.GetUserId(),
+			Username: member.GetUsername(),
+			IsActive: member.GetIsActive(),
+		})
+	}
+	return result
+}
+
+func convertDomainTeam(team *domain.Team) *reviewv1.Team {
+	result := &reviewv1.Team{
+		TeamName:       team.Name,
+		ReviewersCount: int32(team.ReviewersCount),
+	}
+	for _, member := range team.Members {
+		result.Members = append(result.Members, &reviewv1.TeamMember{
+			UserId:   member.UserID,
+			Username: member.Username,
+			IsActive: member.IsActive,
+		})
+	}
+	return result
+}
+
+func convertDomainUser(user *domain.User) *reviewv1.User {
+	return &reviewv1.User{
+		UserId:   user.ID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+}
+
+func convertDomainPR(pr *domain.PullRequest) *reviewv1.PullRequest {
+	result := &reviewv1.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Title,
+		AuthorId:          pr.AuthorID,
+		Status:            convertPRStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		RequiredReviewers: int32(pr.RequiredReviewers),
+	}
+	if pr.CreatedAt != nil {
+		result.CreatedAt = timestamppb.New(*pr.CreatedAt)
+	}
+	if pr.MergedAt != nil {
+		result.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	return result
+}
+
+func convertPRStatus(status domain.PRStatus) reviewv1.PullRequestStatus {
+	switch status {
+	case domain.PRStatusOpen:
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	}
+	return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+var eventTypes = map[domain.ReviewEventType]reviewv1.ReviewEventType{
+	domain.EventPRCreated:          reviewv1.ReviewEventType_REVIEW_EVENT_TYPE_PR_CREATED,
+	domain.EventReviewerAssigned:   reviewv1.ReviewEventType_REVIEW_EVENT_TYPE_REVIEWER_ASSIGNED,
+	domain.EventReviewerReassigned: reviewv1.ReviewEventType_REVIEW_EVENT_TYPE_REVIEWER_REASSIGNED,
+	domain.EventPRMerged:           reviewv1.ReviewEventType_REVIEW_EVENT_TYPE_PR_MERGED,
+}
+
+func convertDomainEvent(event *domain.ReviewEvent) *reviewv1.ReviewEvent {
+	return &reviewv1.ReviewEvent{
+		Id:              event.ID,
+		Type:            eventTypes[event.Type],
+		PullRequestId:   event.PullRequestID,
+		PullRequestName: event.Title,
+		AuthorId:        event.AuthorID,
+		TeamName:        event.TeamName,
+		ReviewerId:      event.ReviewerID,
+		OldReviewerId:   event.OldReviewerID,
+		Reviewers:       event.Reviewers,
+		Actor:           event.Actor,
+		CreatedAt:       timestamppb.New(event.CreatedAt),
+	}
+}
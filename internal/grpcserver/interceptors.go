@@ -0,0 +1,131 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	"avito-test-task/internal/usecase"
+)
+
+// ActorMetadataKey - ключ метаданных с инициатором запроса, аналог заголовка X-Actor-Id
+const ActorMetadataKey = "x-actor-id"
+
+// errorDomain заполняет ErrorInfo.Domain; Reason совпадает с кодом ошибки HTTP API
+const errorDomain = "review-service"
+
+const internalMessage = "internal server error"
+
+// codeMapping связывает доменную ошибку с кодом gRPC и кодом из каталога openapi.yml
+type codeMapping struct {
+	target error
+	code   codes.Code
+	reason api.ErrorCode
+}
+
+// codeCatalog проверяется по порядку через errors.Is, как errorCatalog HTTP API
+var codeCatalog = []codeMapping{
+	{domain.ErrValidation, codes.InvalidArgument, api.VALIDATIONERROR},
+	{domain.ErrTeamExists, codes.AlreadyExists, api.TEAMEXISTS},
+	{domain.ErrTeamNotFound, codes.NotFound, api.NOTFOUND},
+	{domain.ErrUserNotFound, codes.NotFound, api.NOTFOUND},
+	{domain.ErrPRNotFound, codes.NotFound, api.NOTFOUND},
+	{domain.ErrPRExists, codes.AlreadyExists, api.PREXISTS},
+	{domain.ErrPRMerged, codes.FailedPrecondition, api.PRMERGED},
+	{domain.ErrReviewerNotAssigned, codes.FailedPrecondition, api.NOTASSIGNED},
+	{domain.ErrNoCandidates, codes.FailedPrecondition, api.NOCANDIDATE},
+	{domain.ErrReviewersLimit, codes.FailedPrecondition, api.REVIEWERSLIMIT},
+	{domain.ErrReviewerAlreadyAssigned, codes.FailedPrecondition, api.ALREADYASSIGNED},
+	{domain.ErrAuthorAsReviewer, codes.FailedPrecondition, api.AUTHORCANNOTREVIEW},
+	{domain.ErrUserInactive, codes.FailedPrecondition, api.USERINACTIVE},
+	{domain.ErrNotTeamMember, codes.FailedPrecondition, api.NOTTEAMMEMBER},
+	{domain.ErrPrimaryTeam, codes.FailedPrecondition, api.PRIMARYTEAM},
+	{domain.ErrTeamCycle, codes.FailedPrecondition, api.TEAMCYCLE},
+	{domain.ErrReviewerRules, codes.FailedPrecondition, api.REVIEWERRULES},
+	{domain.ErrInvalidReviewersCount, codes.InvalidArgument, api.INVALIDREVIEWERSCOUNT},
+}
+
+// toStatus переводит ошибку usecase в статус gRPC; неизвестные ошибки становятся Internal без раскрытия текста
+func toStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	// клиент переподключается с last_event_id и ничего не теряет
+	if errors.Is(err, events.ErrSubscriberLagged) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	for _, m := range codeCatalog {
+		if !errors.Is(err, m.target) {
+			continue
+		}
+
+		details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(m.reason), Domain: errorDomain}}
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			badRequest := &errdetails.BadRequest{}
+			for _, f := range validationErr.Fields {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       f.Field,
+					Description: f.Message,
+				})
+			}
+			details = append(details, badRequest)
+		}
+
+		st, detailsErr := status.New(m.code, err.Error()).WithDetails(details...)
+		if detailsErr != nil {
+			return status.Error(m.code, err.Error())
+		}
+		return st.Err()
+	}
+
+	slog.Error("Internal error", "method", method, "error", err)
+	return status.Error(codes.Internal, internalMessage)
+}
+
+// withActor переносит инициатора из метаданных в контекст для журнала изменений
+func withActor(ctx context.Context) context.Context {
+	if values := metadata.ValueFromIncomingContext(ctx, ActorMetadataKey); len(values) > 0 && values[0] != "" {
+		return usecase.WithActor(ctx, values[0])
+	}
+	return ctx
+}
+
+func unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(withActor(ctx), req)
+	if err != nil {
+		return nil, toStatus(info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+func streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, &actorStream{ServerStream: stream, ctx: withActor(stream.Context())})
+	if err != nil {
+		return toStatus(info.FullMethod, err)
+	}
+	return nil
+}
+
+type actorStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorStream) Context() context.Context {
+	return s.ctx
+}
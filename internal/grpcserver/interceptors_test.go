@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	"avito-test-task/internal/usecase"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason api.ErrorCode
+	}{
+		{
+			name:       "not found",
+			err:        domain.ErrPRNotFound,
+			wantCode:   codes.NotFound,
+			wantReason: api.NOTFOUND,
+		},
+		{
+			name:       "wrapped domain error",
+			err:        fmt.Errorf("merge pr_1: %w", domain.ErrPRMerged),
+			wantCode:   codes.FailedPrecondition,
+			wantReason: api.PRMERGED,
+		},
+		{
+			name:       "already exists",
+			err:        domain.ErrTeamExists,
+			wantCode:   codes.AlreadyExists,
+			wantReason: api.TEAMEXISTS,
+		},
+		{
+			name:       "invalid reviewers count",
+			err:        domain.ErrInvalidReviewersCount,
+			wantCode:   codes.InvalidArgument,
+			wantReason: api.INVALIDREVIEWERSCOUNT,
+		},
+		{
+			name:     "lagged subscriber",
+			err:      events.ErrSubscriberLagged,
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "context deadline",
+			err:      fmt.Errorf("find user: %w", context.DeadlineExceeded),
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "unknown error",
+			err:      errors.New(`pq: relation "users" does not exist`),
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(toStatus("/review.v1.ReviewService/Test", tt.err))
+			if st.Code() != tt.wantCode {
+				t.Errorf("code = %s, want %s", st.Code(), tt.wantCode)
+			}
+			if got := errorReason(st); got != tt.wantReason {
+				t.Errorf("reason = %q, want %q", got, tt.wantReason)
+			}
+			if tt.wantCode == codes.Internal && st.Message() != internalMessage {
+				t.Errorf("message = %q, internal details must not leak", st.Message())
+			}
+		})
+	}
+}
+
+func TestWithActor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorMetadataKey, "u5"))
+	if got := usecase.ActorFromContext(withActor(ctx)); got != "u5" {
+		t.Errorf("actor = %q, want u5", got)
+	}
+	if got := usecase.ActorFromContext(withActor(context.Background())); got != usecase.SystemActor {
+		t.Errorf("actor = %q, want %q", got, usecase.SystemActor)
+	}
+}
+
+func errorReason(st *status.Status) api.ErrorCode {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return api.ErrorCode(info.Reason)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,204 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"avito-test-task/internal/api/reviewv1"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	"avito-test-task/internal/usecase"
+)
+
+// ReviewService реализует reviewv1.ReviewServiceServer поверх тех же usecase, что и HTTP API
+type ReviewService struct {
+	reviewv1.UnimplementedReviewServiceServer
+
+	teamUC *usecase.TeamUseCase
+	userUC *usecase.UserUseCase
+	prUC   *usecase.PRUseCase
+	events *events.Broker
+}
+
+func NewReviewService(team *usecase.TeamUseCase, user *usecase.UserUseCase, pr *usecase.PRUseCase, broker *events.Broker) *ReviewService {
+	return &ReviewService{
+		teamUC: team,
+		userUC: user,
+		prUC:   pr,
+		events: broker,
+	}
+}
+
+// NewServer регистрирует ReviewService, health и reflection. Ошибки usecase переводятся в статусы gRPC перехватчиками
+func NewServer(service *ReviewService, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptor),
+	)
+	server := grpc.NewServer(opts...)
+	reviewv1.RegisterReviewServiceServer(server, service)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(reviewv1.ReviewService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+	return server
+}
+
+func (s *ReviewService) AddTeam(ctx context.Context, req *reviewv1.AddTeamRequest) (*reviewv1.AddTeamResponse, error) {
+	team, err := s.teamUC.CreateTeam(ctx, convertTeamToDomain(req.GetTeam()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.AddTeamResponse{
+		Team: convertDomainTeam(team),
+	}, nil
+}
+
+func (s *ReviewService) GetTeam(ctx context.Context, req *reviewv1.GetTeamRequest) (*reviewv1.Team, error) {
+	team, err := s.teamUC.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDomainTeam(team), nil
+}
+
+func (s *ReviewService) SetTeamReviewersCount(ctx context.Context, req *reviewv1.SetTeamReviewersCountRequest) (*reviewv1.Team, error) {
+	team, err := s.teamUC.SetReviewersCount(ctx, req.GetTeamName(), int(req.GetReviewersCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDomainTeam(team), nil
+}
+
+func (s *ReviewService) SetUserIsActive(ctx context.Context, req *reviewv1.SetUserIsActiveRequest) (*reviewv1.SetUserIsActiveResponse, error) {
+	user, err := s.userUC.SetUserActivity(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.SetUserIsActiveResponse{
+		User: convertDomainUser(user),
+	}, nil
+}
+
+func (s *ReviewService) GetUserReviews(ctx context.Context, req *reviewv1.GetUserReviewsRequest) (*reviewv1.GetUserReviewsResponse, error) {
+	response := &reviewv1.GetUserReviewsResponse{UserId: req.GetUserId()}
+
+	prs, err := s.prUC.GetPRsByReviewer(ctx, req.GetUserId(), domain.ReviewListFilter{})
+	if err != nil {
+		// как и HTTP API, для неизвестного пользователя возвращается пустой список
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return response, nil
+		}
+		return nil, err
+	}
+
+	for _, pr := range prs {
+		response.PullRequests = append(response.PullRequests, &reviewv1.PullRequestShort{
+			PullRequestId:   pr.ID,
+			PullRequestName: pr.Title,
+			AuthorId:        pr.AuthorID,
+			Status:          convertPRStatus(pr.Status),
+		})
+	}
+	return response, nil
+}
+
+func (s *ReviewService) CreatePullRequest(ctx context.Context, req *reviewv1.CreatePullRequestRequest) (*reviewv1.PullRequestResponse, error) {
+	var opts []usecase.CreatePROption
+	if req.ReviewersCount != nil {
+		opts = append(opts, usecase.WithReviewersCount(int(req.GetReviewersCount())))
+	}
+
+	pr, err := s.prUC.CreatePR(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.PullRequestResponse{Pr: convertDomainPR(pr)}, nil
+}
+
+func (s *ReviewService) MergePullRequest(ctx context.Context, req *reviewv1.MergePullRequestRequest) (*reviewv1.PullRequestResponse, error) {
+	pr, err := s.prUC.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.PullRequestResponse{Pr: convertDomainPR(pr)}, nil
+}
+
+func (s *ReviewService) ReassignReviewer(ctx context.Context, req *reviewv1.ReassignReviewerRequest) (*reviewv1.ReassignReviewerResponse, error) {
+	newReviewerID, err := s.prUC.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.prUC.GetPR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.ReassignReviewerResponse{
+		Pr:         convertDomainPR(pr),
+		ReplacedBy: newReviewerID,
+	}, nil
+}
+
+func (s *ReviewService) TopUpReviewers(ctx context.Context, req *reviewv1.TopUpReviewersRequest) (*reviewv1.TopUpReviewersResponse, error) {
+	pr, added, err := s.prUC.TopUpReviewers(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.TopUpReviewersResponse{
+		Pr:    convertDomainPR(pr),
+		Added: added,
+	}, nil
+}
+
+func (s *ReviewService) AddReviewer(ctx context.Context, req *reviewv1.ChangeReviewerRequest) (*reviewv1.PullRequestResponse, error) {
+	pr, err := s.prUC.AddReviewer(ctx, req.GetPullRequestId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.PullRequestResponse{Pr: convertDomainPR(pr)}, nil
+}
+
+func (s *ReviewService) RemoveReviewer(ctx context.Context, req *reviewv1.ChangeReviewerRequest) (*reviewv1.PullRequestResponse, error) {
+	pr, err := s.prUC.RemoveReviewer(ctx, req.GetPullRequestId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewv1.PullRequestResponse{Pr: convertDomainPR(pr)}, nil
+}
+
+// WatchEvents отдаёт тот же поток, что GET /events/stream; отстающий клиент переподключается с last_event_id
+func (s *ReviewService) WatchEvents(req *reviewv1.WatchEventsRequest, stream grpc.ServerStreamingServer[reviewv1.ReviewEvent]) error {
+	filter := domain.EventFilter{UserID: req.GetUserId(), TeamName: req.GetTeamName()}
+	return s.events.Stream(stream.Context(), filter, req.LastEventId, &streamSink{stream: stream})
+}
+
+// streamSink пересылает события в gRPC-поток. Heartbeat не нужен: соединение поддерживают keepalive HTTP/2
+type streamSink struct {
+	stream grpc.ServerStreamingServer[reviewv1.ReviewEvent]
+}
+
+func (s *streamSink) Send(event *domain.ReviewEvent) error {
+	return s.stream.Send(convertDomainEvent(event))
+}
+
+func (s *streamSink) Heartbeat() error {
+	return nil
+}
@@ -0,0 +1,184 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/api/reviewv1"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	pullrequest "avito-test-task/internal/repository/pull_request"
+	"avito-test-task/internal/repository/team"
+	"avito-test-task/internal/repository/user"
+	"avito-test-task/internal/usecase"
+)
+
+// staticLoader отдаёт события из памяти
+type staticLoader []*domain.ReviewEvent
+
+func (l staticLoader) LastEventID(ctx context.Context) (int64, error) {
+	return l[len(l)-1].ID, nil
+}
+
+func (l staticLoader) EventsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.ReviewEvent, int64, error) {
+	var result []*domain.ReviewEvent
+	next := afterID
+	for _, event := range l {
+		if event.ID > afterID && len(result) < limit {
+			result = append(result, event)
+			next = event.ID
+		}
+	}
+	return result, next, nil
+}
+
+// dial поднимает сервер на bufconn. Репозитории без БД: тесты проверяют только пути, которые до неё не доходят
+func dial(t *testing.T, broker *events.Broker) *grpc.ClientConn {
+	t.Helper()
+
+	service := NewReviewService(
+		usecase.NewTeamUseCase(team.TeamRepository{}, user.UserRepository{}),
+		usecase.NewUserUseCase(user.UserRepository{}),
+		usecase.NewPRUseCase(pullrequest.PRRepository{}, user.UserRepository{}, team.TeamRepository{}),
+		broker,
+	)
+	server := NewServer(service)
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestHealth(t *testing.T) {
+	client := healthpb.NewHealthClient(dial(t, nil))
+
+	for _, service := range []string{"", reviewv1.ReviewService_ServiceDesc.ServiceName} {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q) error = %v", service, err)
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("Check(%q) = %s, want SERVING", service, resp.GetStatus())
+		}
+	}
+}
+
+func TestReflection(t *testing.T) {
+	client := reflectionpb.NewServerReflectionClient(dial(t, nil))
+
+	stream, err := client.ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("ServerReflectionInfo() error = %v", err)
+	}
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+
+	var found bool
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		if service.GetName() == "review.v1.ReviewService" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("review.v1.ReviewService is not listed: %v", resp.GetListServicesResponse().GetService())
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	client := reviewv1.NewReviewServiceClient(dial(t, nil))
+
+	_, err := client.AddTeam(context.Background(), &reviewv1.AddTeamRequest{
+		Team: &reviewv1.Team{Members: []*reviewv1.TeamMember{{UserId: "u 1", Username: "Alice"}}},
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("AddTeam() code = %s, want InvalidArgument", st.Code())
+	}
+	if got := errorReason(st); got != api.VALIDATIONERROR {
+		t.Errorf("reason = %q, want %q", got, api.VALIDATIONERROR)
+	}
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	if len(fields) != 2 || fields[0] != "team_name" || fields[1] != "members[0].user_id" {
+		t.Errorf("field violations = %v, want team_name and members[0].user_id", fields)
+	}
+
+	_, err = client.CreatePullRequest(context.Background(), &reviewv1.CreatePullRequestRequest{
+		PullRequestName: "Search",
+		AuthorId:        "u1",
+	})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("CreatePullRequest() code = %s, want InvalidArgument", code)
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	loader := staticLoader{
+		{ID: 7, Type: domain.EventPRCreated, PullRequestID: "pr_1", AuthorID: "u1",
+			TeamName: "backend", Reviewers: []string{"u2"}, Actor: "u1", CreatedAt: createdAt},
+		{ID: 8, Type: domain.EventReviewerReassigned, PullRequestID: "pr_1", AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u3", OldReviewerID: "u2", Reviewers: []string{"u3"}, Actor: "u5", CreatedAt: createdAt},
+	}
+	client := reviewv1.NewReviewServiceClient(dial(t, events.NewBroker(loader)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	lastEventID := int64(6)
+	stream, err := client.WatchEvents(ctx, &reviewv1.WatchEventsRequest{UserId: "u3", LastEventId: &lastEventID})
+	if err != nil {
+		t.Fatalf("WatchEvents() error = %v", err)
+	}
+
+	// первое событие не касается u3 и отфильтровано
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if event.GetId() != 8 || event.GetType() != reviewv1.ReviewEventType_REVIEW_EVENT_TYPE_REVIEWER_REASSIGNED {
+		t.Errorf("event = %d %s, want 8 REVIEWER_REASSIGNED", event.GetId(), event.GetType())
+	}
+	if event.GetOldReviewerId() != "u2" || event.GetReviewerId() != "u3" || !event.GetCreatedAt().AsTime().Equal(createdAt) {
+		t.Errorf("event = %v, want reassignment u2 -> u3 at %s", event, createdAt)
+	}
+}
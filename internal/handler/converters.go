@@ -2,6 +2,7 @@ package handler
 
 import (
 	"avito-test-task/internal/api"
+	"avito-test-task/internal/assignment"
 	"avito-test-task/internal/domain"
 )
 
@@ -9,32 +10,117 @@ func (h *ServerHandler) convertAPITeamToDomain(apiTeam api.Team) *domain.Team {
 	team := &domain.Team{
 		Name: apiTeam.TeamName,
 	}
+	if apiTeam.ReviewersCount != nil {
+		team.ReviewersCount = *apiTeam.ReviewersCount
+	}
+	team.ReviewSLAHours = valueOrZero(apiTeam.ReviewSlaHours)
+	team.ReassignAfterHours = valueOrZero(apiTeam.ReassignAfterHours)
+	team.ParentName = valueOrZero(apiTeam.ParentName)
+	team.ReviewRotation = valueOrZero(apiTeam.ReviewRotation)
 
 	for _, member := range apiTeam.Members {
 		team.Members = append(team.Members, domain.TeamMember{
 			UserID:   member.UserId,
 			Username: member.Username,
 			IsActive: member.IsActive,
+			Role:     h.convertAPIRoleToDomain(member.Role),
 		})
 	}
 
 	return team
 }
 
+func (h *ServerHandler) convertAPIRoleToDomain(role *api.MemberRole) domain.MemberRole {
+	if role == nil {
+		return ""
+	}
+	return domain.MemberRole(*role)
+}
+
 func (h *ServerHandler) convertDomainTeamToAPI(team *domain.Team) *api.Team {
-	var members []api.TeamMember
+	members := []api.TeamMember{}
 	for _, user := range team.Members {
+		role := api.MemberRole(user.Role)
 		members = append(members, api.TeamMember{
 			UserId:   user.UserID,
 			Username: user.Username,
 			IsActive: user.IsActive,
+			Role:     &role,
+			Capacity: &user.Capacity,
 		})
 	}
 
-	return &api.Team{
+	apiTeam := &api.Team{
 		TeamName: team.Name,
 		Members:  members,
 	}
+	if team.ReviewersCount != 0 {
+		reviewersCount := team.ReviewersCount
+		apiTeam.ReviewersCount = &reviewersCount
+	}
+	apiTeam.ReviewSlaHours = nilIfZero(team.ReviewSLAHours)
+	apiTeam.ReassignAfterHours = nilIfZero(team.ReassignAfterHours)
+	apiTeam.ParentName = nilIfZero(team.ParentName)
+	reviewRotation := team.ReviewRotation
+	apiTeam.ReviewRotation = &reviewRotation
+
+	return apiTeam
+}
+
+func (h *ServerHandler) convertDomainTeamTreeToAPI(tree *domain.TeamTree) api.TeamTree {
+	children := make([]api.TeamTree, 0, len(tree.Children))
+	for _, child := range tree.Children {
+		children = append(children, h.convertDomainTeamTreeToAPI(child))
+	}
+	return api.TeamTree{
+		Team:     *h.convertDomainTeamToAPI(tree.Team),
+		Children: children,
+	}
+}
+
+func (h *ServerHandler) convertDomainTeamStatsToAPI(stats []domain.TeamStats) []api.TeamStats {
+	result := make([]api.TeamStats, 0, len(stats))
+	for _, s := range stats {
+		repositories := make([]api.RepositoryStats, 0, len(s.Repositories))
+		for _, r := range s.Repositories {
+			repositories = append(repositories, api.RepositoryStats{
+				Repository:  r.Repository,
+				OpenPrs:     r.OpenPRs,
+				MergedPrs:   r.MergedPRs,
+				OpenReviews: r.OpenReviews,
+			})
+		}
+		result = append(result, api.TeamStats{
+			TeamName:       s.TeamName,
+			Depth:          s.Depth,
+			Teams:          s.Teams,
+			Members:        s.Members,
+			ActiveMembers:  s.ActiveMembers,
+			ActiveCapacity: s.ActiveCapacity,
+			OpenPrs:        s.OpenPRs,
+			MergedPrs:      s.MergedPRs,
+			OpenReviews:    s.OpenReviews,
+			Repositories:   repositories,
+		})
+	}
+	return result
+}
+
+func (h *ServerHandler) convertDomainReviewMatrixToAPI(matrix *domain.ReviewMatrix) api.ReviewMatrix {
+	pairs := make([]api.ReviewPair, 0, len(matrix.Pairs))
+	for _, p := range matrix.Pairs {
+		pairs = append(pairs, api.ReviewPair{
+			AuthorId:       p.AuthorID,
+			ReviewerId:     p.ReviewerID,
+			Reviews:        p.Reviews,
+			LastReviewedAt: p.LastReviewedAt,
+		})
+	}
+	return api.ReviewMatrix{
+		TeamName: matrix.TeamName,
+		Members:  matrix.Members,
+		Pairs:    pairs,
+	}
 }
 
 func (h *ServerHandler) convertDomainPRToAPI(pr *domain.PullRequest) *api.PullRequest {
@@ -44,17 +130,202 @@ func (h *ServerHandler) convertDomainPRToAPI(pr *domain.PullRequest) *api.PullRe
 		AuthorId:          pr.AuthorID,
 		Status:            api.PullRequestStatus(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		RequiredReviewers: pr.RequiredReviewers,
+		Priority:          nilIfZero(api.PRPriority(pr.Priority)),
+		Labels:            nilIfEmpty(pr.Labels),
+		Metadata:          h.convertDomainMetadataToAPI(pr.Metadata),
+		RequiredTags:      nilIfEmpty(pr.RequiredTags),
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
 }
 
+func (h *ServerHandler) convertAPIMetadataToDomain(metadata api.PRMetadata) domain.PRMetadata {
+	return domain.PRMetadata{
+		Repository:   valueOrZero(metadata.Repository),
+		SourceBranch: valueOrZero(metadata.SourceBranch),
+		TargetBranch: valueOrZero(metadata.TargetBranch),
+		URL:          valueOrZero(metadata.Url),
+		LinesChanged: valueOrZero(metadata.LinesChanged),
+	}
+}
+
+// convertDomainMetadataToAPI опускает метаданные, если ни одно поле не задано
+func (h *ServerHandler) convertDomainMetadataToAPI(metadata domain.PRMetadata) *api.PRMetadata {
+	if metadata == (domain.PRMetadata{}) {
+		return nil
+	}
+	return &api.PRMetadata{
+		Repository:   nilIfZero(metadata.Repository),
+		SourceBranch: nilIfZero(metadata.SourceBranch),
+		TargetBranch: nilIfZero(metadata.TargetBranch),
+		Url:          nilIfZero(metadata.URL),
+		LinesChanged: nilIfZero(metadata.LinesChanged),
+	}
+}
+
 func (h *ServerHandler) convertDomainUserToAPI(user *domain.User) *api.User {
-	return &api.User{
-		UserId:   user.ID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+	apiUser := &api.User{
+		UserId:        user.ID,
+		Username:      user.Username,
+		TeamName:      user.TeamName,
+		IsActive:      user.IsActive,
+		Capacity:      user.Capacity,
+		Tags:          nilIfEmpty(user.Tags),
+		Notifications: h.convertDomainNotificationsToAPI(user.Notifications),
+		LastSeenAt:    user.LastSeenAt,
+	}
+	if len(user.Teams) > 0 {
+		teams := make([]api.TeamMembership, 0, len(user.Teams))
+		for _, m := range user.Teams {
+			teams = append(teams, api.TeamMembership{
+				TeamName:  m.TeamName,
+				Role:      api.MemberRole(m.Role),
+				IsActive:  m.IsActive,
+				IsPrimary: m.IsPrimary,
+			})
+		}
+		apiUser.Teams = &teams
+	}
+	return apiUser
+}
+
+func (h *ServerHandler) convertDomainNotificationsToAPI(settings domain.NotificationSettings) *api.NotificationSettings {
+	result := &api.NotificationSettings{
+		MuteEmail: &settings.MuteEmail,
+		MuteChat:  &settings.MuteChat,
+	}
+	if settings.Email != "" {
+		result.Email = &settings.Email
+	}
+	if settings.ChatHandle != "" {
+		result.ChatHandle = &settings.ChatHandle
+	}
+	if settings.Locale != "" {
+		locale := api.NotificationSettingsLocale(settings.Locale)
+		result.Locale = &locale
 	}
+	return result
+}
 
+// convertAPINotificationsToDomain: отсутствующее поле сбрасывает настройку, запрос заменяет настройки целиком
+func (h *ServerHandler) convertAPINotificationsToDomain(settings api.NotificationSettings) domain.NotificationSettings {
+	var result domain.NotificationSettings
+	if settings.Email != nil {
+		result.Email = *settings.Email
+	}
+	if settings.ChatHandle != nil {
+		result.ChatHandle = *settings.ChatHandle
+	}
+	if settings.Locale != nil {
+		result.Locale = domain.Locale(*settings.Locale)
+	}
+	if settings.MuteEmail != nil {
+		result.MuteEmail = *settings.MuteEmail
+	}
+	if settings.MuteChat != nil {
+		result.MuteChat = *settings.MuteChat
+	}
+	return result
+}
+
+func (h *ServerHandler) convertDomainAuditEntriesToAPI(entries []*domain.AuditEntry) []api.AuditEntry {
+	result := make([]api.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		apiEntry := api.AuditEntry{
+			Id:         entry.ID,
+			EntityType: api.AuditEntityType(entry.EntityType),
+			EntityId:   entry.EntityID,
+			Action:     api.AuditAction(entry.Action),
+			Actor:      entry.Actor,
+			Reason:     string(entry.Reason),
+			CreatedAt:  entry.CreatedAt,
+		}
+		if entry.OldValue != nil {
+			apiEntry.OldValue = &entry.OldValue
+		}
+		if entry.NewValue != nil {
+			apiEntry.NewValue = &entry.NewValue
+		}
+		result = append(result, apiEntry)
+	}
+
+	return result
+}
+
+func (h *ServerHandler) convertAPIAuditParamsToDomain(params api.GetAuditParams) domain.AuditFilter {
+	filter := domain.AuditFilter{
+		From: params.From,
+		To:   params.To,
+	}
+	if params.EntityType != nil {
+		filter.EntityType = domain.AuditEntityType(*params.EntityType)
+	}
+	if params.EntityId != nil {
+		filter.EntityID = *params.EntityId
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.Action != nil {
+		filter.Action = domain.AuditAction(*params.Action)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	return filter
+}
+
+func (h *ServerHandler) convertPolicyStatusToAPI(status assignment.PolicyStatus) api.AssignmentPolicyStatus {
+	active := status.Active
+	result := api.AssignmentPolicyStatus{
+		Policy: api.AssignmentPolicy{
+			Version:        active.Version,
+			ReviewersCount: active.ReviewersCount,
+			AllowPartial:   active.AllowPartial,
+			Mode:           api.AssignmentPolicyMode(active.Mode),
+			Fallback:       active.Fallback,
+			Roles: api.RoleRules{
+				RequireSenior:   active.Roles.RequireSenior,
+				NoSoleTrainee:   active.Roles.NoSoleTrainee,
+				LeadsAsFallback: active.Roles.LeadsAsFallback,
+			},
+		},
+		Source:   active.Source,
+		LoadedAt: active.LoadedAt,
+	}
+	if status.LastError != nil {
+		message := status.LastError.Error()
+		result.LastReloadError = &message
+		result.LastReloadErrorAt = &status.LastErrorAt
+	}
+	return result
+}
+
+// valueOrZero разворачивает необязательное число, где отсутствие значит «по умолчанию»
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+func nilIfZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+func nilIfEmpty[T any](v []T) *[]T {
+	if len(v) == 0 {
+		return nil
+	}
+	return &v
 }
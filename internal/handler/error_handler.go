@@ -1,82 +1,136 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"log"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
 	"avito-test-task/internal/api"
 	"avito-test-task/internal/domain"
-	"log"
 )
 
-func buildError(code api.ErrorResponseErrorCode, message string) struct {
-	Code    api.ErrorResponseErrorCode `json:"code"`
-	Message string                     `json:"message"`
-} {
-	return struct {
-		Code    api.ErrorResponseErrorCode `json:"code"`
-		Message string                     `json:"message"`
-	}{
-		Code:    code,
-		Message: message,
+const (
+	ProblemContentType = "application/problem+json"
+
+	problemTypePrefix = "urn:problem-type:"
+	internalMessage   = "internal server error"
+)
+
+// errorMapping связывает доменную ошибку с HTTP-статусом и кодом из каталога openapi.yml
+type errorMapping struct {
+	target error
+	status int
+	code   api.ErrorCode
+}
+
+// errorCatalog проверяется по порядку через errors.Is, поэтому обёрнутые ошибки тоже распознаются
+var errorCatalog = []errorMapping{
+	{domain.ErrValidation, http.StatusBadRequest, api.VALIDATIONERROR},
+	{domain.ErrTeamExists, http.StatusBadRequest, api.TEAMEXISTS},
+	{domain.ErrTeamNotFound, http.StatusNotFound, api.NOTFOUND},
+	{domain.ErrUserNotFound, http.StatusNotFound, api.NOTFOUND},
+	{domain.ErrPRNotFound, http.StatusNotFound, api.NOTFOUND},
+	{domain.ErrPRExists, http.StatusConflict, api.PREXISTS},
+	{domain.ErrPRMerged, http.StatusConflict, api.PRMERGED},
+	{domain.ErrReviewerNotAssigned, http.StatusConflict, api.NOTASSIGNED},
+	{domain.ErrNoCandidates, http.StatusConflict, api.NOCANDIDATE},
+	{domain.ErrReviewersLimit, http.StatusConflict, api.REVIEWERSLIMIT},
+	{domain.ErrReviewerAlreadyAssigned, http.StatusConflict, api.ALREADYASSIGNED},
+	{domain.ErrAuthorAsReviewer, http.StatusConflict, api.AUTHORCANNOTREVIEW},
+	{domain.ErrUserInactive, http.StatusConflict, api.USERINACTIVE},
+	{domain.ErrNotTeamMember, http.StatusConflict, api.NOTTEAMMEMBER},
+	{domain.ErrPrimaryTeam, http.StatusConflict, api.PRIMARYTEAM},
+	{domain.ErrTeamCycle, http.StatusConflict, api.TEAMCYCLE},
+	{domain.ErrReviewerRules, http.StatusConflict, api.REVIEWERRULES},
+	{domain.ErrInvalidReviewersCount, http.StatusUnprocessableEntity, api.INVALIDREVIEWERSCOUNT},
+}
+
+// apiError - ошибка, переведённая в термины API
+type apiError struct {
+	status  int
+	code    api.ErrorCode
+	message string
+	fields  []domain.FieldError
+}
+
+// translateError находит ошибку в каталоге; неизвестные ошибки становятся 500 без раскрытия текста
+func translateError(err error) apiError {
+	for _, m := range errorCatalog {
+		if errors.Is(err, m.target) {
+			apiErr := apiError{status: m.status, code: m.code, message: m.target.Error()}
+
+			var validationErr *domain.ValidationError
+			if errors.As(err, &validationErr) {
+				apiErr.fields = validationErr.Fields
+			}
+			return apiErr
+		}
 	}
+	return apiError{status: http.StatusInternalServerError, code: api.INTERNALERROR, message: internalMessage}
 }
 
-func (h *ServerHandler) handleTeamError(err error) (api.PostTeamAddResponseObject, error) {
-	switch err {
-	case domain.ErrTeamExists:
-		return api.PostTeamAdd400JSONResponse{
-			Error: buildError(api.TEAMEXISTS, "Team creation failed"),
-		}, nil
-	default:
-		log.Printf("Internal team error: %v", err)
-		return api.PostTeamAdd400JSONResponse{
-			Error: buildError(api.ErrorResponseErrorCode(err.Error()), "team_name already exists"),
-		}, nil
+// ResponseErrorHandler пишет ответ для ошибки, которую вернул обработчик
+func ResponseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr := translateError(err)
+	if apiErr.status == http.StatusInternalServerError {
+		slog.Error("Internal error", "method", r.Method, "path", r.URL.Path, "error", err)
 	}
+	writeError(w, r, apiErr)
+}
+
+// RequestErrorHandler пишет ответ для ошибки разбора тела или параметров запроса
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, apiError{status: http.StatusBadRequest, code: api.BADREQUEST, message: err.Error()})
 }
 
-func (h *ServerHandler) handlePRError(err error) (api.PostPullRequestCreateResponseObject, error) {
-	switch err {
-	case domain.ErrUserNotFound:
-		return api.PostPullRequestCreate404JSONResponse{
-			Error: buildError(api.NOTFOUND, "Author not found"),
-		}, nil
-	case domain.ErrPRExists:
-		return api.PostPullRequestCreate409JSONResponse{
-			Error: buildError(api.PREXISTS, "PR id already exists"),
-		}, nil
-	case domain.ErrNoCandidates:
-		return api.PostPullRequestCreate409JSONResponse{
-			Error: buildError(api.NOCANDIDATE, "No candidates to PR"),
-		}, nil
-	default:
-		log.Printf("Internal PR creation error: %v", err)
-		return api.PostPullRequestCreate404JSONResponse{
-			Error: buildError(api.ErrorResponseErrorCode(err.Error()), "Author/team not found"),
-		}, nil
+// writeError отдаёт problem+json, если клиент запросил его в Accept, иначе ErrorResponse
+func writeError(w http.ResponseWriter, r *http.Request, apiErr apiError) {
+	var fields *[]api.FieldError
+	if len(apiErr.fields) > 0 {
+		converted := make([]api.FieldError, 0, len(apiErr.fields))
+		for _, f := range apiErr.fields {
+			converted = append(converted, api.FieldError{Field: f.Field, Message: f.Message})
+		}
+		fields = &converted
+	}
+
+	var body any
+	if acceptsProblem(r) {
+		w.Header().Set("Content-Type", ProblemContentType)
+		body = api.Problem{
+			Type:          problemTypePrefix + string(apiErr.code),
+			Title:         http.StatusText(apiErr.status),
+			Status:        apiErr.status,
+			Detail:        &apiErr.message,
+			Instance:      &r.URL.Path,
+			Code:          apiErr.code,
+			InvalidParams: fields,
+		}
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		var resp api.ErrorResponse
+		resp.Error.Code = apiErr.code
+		resp.Error.Message = apiErr.message
+		resp.Error.Fields = fields
+		body = resp
+	}
+
+	w.WriteHeader(apiErr.status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("Failed to write error response: %v", err)
 	}
 }
 
-func (h *ServerHandler) handlePRReassignError(err error) (api.PostPullRequestReassignResponseObject, error) {
-	switch err {
-	case domain.ErrPRNotFound:
-		return api.PostPullRequestReassign404JSONResponse{
-			Error: buildError(api.NOTFOUND, "PR not found"),
-		}, nil
-	case domain.ErrPRMerged:
-		return api.PostPullRequestReassign409JSONResponse{
-			Error: buildError(api.PRMERGED, "cannot reassign on merged PR"),
-		}, nil
-	case domain.ErrReviewerNotAssigned:
-		return api.PostPullRequestReassign409JSONResponse{
-			Error: buildError(api.NOTASSIGNED, "Reviewer is not assigned to this PR"),
-		}, nil
-	case domain.ErrNoCandidates:
-		return api.PostPullRequestReassign409JSONResponse{
-			Error: buildError(api.NOCANDIDATE, "No active replacement candidate in team"),
-		}, nil
-	default:
-		log.Printf("Internal PR reassign error: %v", err)
-		return api.PostPullRequestReassign404JSONResponse{
-			Error: buildError(api.ErrorResponseErrorCode(err.Error()), "PR not found"),
-		}, nil
+func acceptsProblem(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == ProblemContentType {
+			return true
+		}
 	}
+	return false
 }
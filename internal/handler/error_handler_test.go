@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/domain"
+)
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   api.ErrorCode
+	}{
+		{
+			name:       "not found",
+			err:        domain.ErrPRNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   api.NOTFOUND,
+		},
+		{
+			name:       "wrapped domain error",
+			err:        fmt.Errorf("merge pr_1: %w", domain.ErrPRMerged),
+			wantStatus: http.StatusConflict,
+			wantCode:   api.PRMERGED,
+		},
+		{
+			name:       "validation error",
+			err:        domain.ErrInvalidReviewersCount,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   api.INVALIDREVIEWERSCOUNT,
+		},
+		{
+			name:       "team exists keeps contract status",
+			err:        domain.ErrTeamExists,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.TEAMEXISTS,
+		},
+		{
+			name:       "primary team membership",
+			err:        domain.ErrPrimaryTeam,
+			wantStatus: http.StatusConflict,
+			wantCode:   api.PRIMARYTEAM,
+		},
+		{
+			name:       "unknown error",
+			err:        errors.New(`pq: relation "users" does not exist`),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   api.INTERNALERROR,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateError(tt.err)
+			if got.status != tt.wantStatus || got.code != tt.wantCode {
+				t.Errorf("translateError() = %d %s, want %d %s", got.status, got.code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestResponseErrorHandler(t *testing.T) {
+	rawErr := errors.New(`pq: connection refused`)
+
+	t.Run("legacy error response by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", nil)
+		rec := httptest.NewRecorder()
+
+		ResponseErrorHandler(rec, req, domain.ErrPRNotFound)
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %s, want application/json", ct)
+		}
+
+		var resp api.ErrorResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if resp.Error.Code != api.NOTFOUND {
+			t.Errorf("Code = %s, want %s", resp.Error.Code, api.NOTFOUND)
+		}
+	})
+
+	t.Run("problem json when requested", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", nil)
+		req.Header.Set("Accept", "application/json;q=0.5, application/problem+json")
+		rec := httptest.NewRecorder()
+
+		ResponseErrorHandler(rec, req, fmt.Errorf("reassign: %w", domain.ErrNoCandidates))
+
+		if rec.Code != http.StatusConflict {
+			t.Errorf("Status = %d, want %d", rec.Code, http.StatusConflict)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+			t.Errorf("Content-Type = %s, want %s", ct, ProblemContentType)
+		}
+
+		var problem api.Problem
+		if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+			t.Fatalf("Failed to decode problem: %v", err)
+		}
+		if problem.Status != http.StatusConflict || problem.Code != api.NOCANDIDATE {
+			t.Errorf("Problem = %d %s, want %d %s", problem.Status, problem.Code, http.StatusConflict, api.NOCANDIDATE)
+		}
+		if problem.Type != "urn:problem-type:NO_CANDIDATE" {
+			t.Errorf("Type = %s", problem.Type)
+		}
+		if problem.Instance == nil || *problem.Instance != "/pullRequest/merge" {
+			t.Errorf("Instance = %v, want /pullRequest/merge", problem.Instance)
+		}
+	})
+
+	t.Run("internal error does not leak details", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+		rec := httptest.NewRecorder()
+
+		ResponseErrorHandler(rec, req, rawErr)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Status = %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+		if strings.Contains(rec.Body.String(), "pq:") {
+			t.Errorf("Response leaks internal error: %s", rec.Body.String())
+		}
+	})
+}
+
+func TestRequestErrorHandler(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/team/add", nil)
+	rec := httptest.NewRecorder()
+
+	RequestErrorHandler(rec, req, errors.New("can't decode JSON body: unexpected EOF"))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	var resp api.ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.Error.Code != api.BADREQUEST {
+		t.Errorf("Code = %s, want %s", resp.Error.Code, api.BADREQUEST)
+	}
+}
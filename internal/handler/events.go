@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+)
+
+// sseRetry - через сколько EventSource переподключается после разрыва
+const sseRetry = 3 * time.Second
+
+// eventStreamResponse пишет поток SSE. Сгенерированный ответ копирует тело целиком и не сбрасывает буфер,
+// поэтому поток реализует VisitGetEventsStreamResponse сам
+type eventStreamResponse struct {
+	ctx         context.Context
+	broker      *events.Broker
+	filter      domain.EventFilter
+	lastEventID *int64
+}
+
+func (r eventStreamResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	// поток живёт дольше WriteTimeout сервера
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sink := &sseSink{w: w, rc: rc}
+	if err := sink.write("retry: %d\n\n", sseRetry.Milliseconds()); err != nil {
+		return nil
+	}
+
+	// заголовки уже отправлены, поэтому ошибку можно только записать в лог
+	err := r.broker.Stream(r.ctx, r.filter, r.lastEventID, sink)
+	if err != nil && !errors.Is(err, events.ErrSubscriberLagged) && r.ctx.Err() == nil {
+		log.Printf("Event stream closed: %v", err)
+	}
+	return nil
+}
+
+type sseSink struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (s *sseSink) Send(event *domain.ReviewEvent) error {
+	data, err := json.Marshal(convertDomainEventToAPI(event))
+	if err != nil {
+		return err
+	}
+	return s.write("id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
+
+func (s *sseSink) Heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+func (s *sseSink) write(format string, args ...any) error {
+	if _, err := fmt.Fprintf(s.w, format, args...); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func convertDomainEventToAPI(event *domain.ReviewEvent) api.ReviewEvent {
+	result := api.ReviewEvent{
+		Id:              event.ID,
+		Type:            api.ReviewEventType(event.Type),
+		PullRequestId:   event.PullRequestID,
+		PullRequestName: event.Title,
+		AuthorId:        event.AuthorID,
+		TeamName:        event.TeamName,
+		Reviewers:       event.Reviewers,
+		Actor:           event.Actor,
+		CreatedAt:       event.CreatedAt,
+	}
+	if result.Reviewers == nil {
+		result.Reviewers = []string{}
+	}
+	if event.ReviewerID != "" {
+		result.ReviewerId = &event.ReviewerID
+	}
+	if event.OldReviewerID != "" {
+		result.OldReviewerId = &event.OldReviewerID
+	}
+	return result
+}
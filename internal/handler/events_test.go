@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+)
+
+type staticLoader []*domain.ReviewEvent
+
+func (l staticLoader) LastEventID(ctx context.Context) (int64, error) {
+	return l[len(l)-1].ID, nil
+}
+
+func (l staticLoader) EventsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.ReviewEvent, int64, error) {
+	var result []*domain.ReviewEvent
+	next := afterID
+	for _, event := range l {
+		if event.ID > afterID && len(result) < limit {
+			result = append(result, event)
+			next = event.ID
+		}
+	}
+	return result, next, nil
+}
+
+func TestEventStreamResponse(t *testing.T) {
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	loader := staticLoader{
+		{ID: 7, Type: domain.EventPRCreated, PullRequestID: "pr_1", Title: "Search", AuthorID: "u1",
+			TeamName: "backend", Reviewers: []string{"u2"}, Actor: "u1", CreatedAt: createdAt},
+		{ID: 8, Type: domain.EventReviewerReassigned, PullRequestID: "pr_1", Title: "Search", AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u3", OldReviewerID: "u2", Reviewers: []string{"u3"}, Actor: "u5", CreatedAt: createdAt},
+	}
+	broker := events.NewBroker(loader)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastEventID := int64(6)
+		response := eventStreamResponse{
+			ctx:         r.Context(),
+			broker:      broker,
+			filter:      domain.EventFilter{UserID: "u3"},
+			lastEventID: &lastEventID,
+		}
+		if err := response.VisitGetEventsStreamResponse(w); err != nil {
+			t.Errorf("VisitGetEventsStreamResponse() error = %v", err)
+		}
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	// первое событие не касается u3 и отфильтровано, второе приходит после retry
+	want := []string{
+		"retry: 3000",
+		"",
+		"id: 8",
+		"event: reviewer_reassigned",
+		`data: {"actor":"u5","author_id":"u1","created_at":"2025-10-24T12:00:00Z","id":8,"old_reviewer_id":"u2",` +
+			`"pull_request_id":"pr_1","pull_request_name":"Search","reviewer_id":"u3","reviewers":["u3"],` +
+			`"team_name":"backend","type":"reviewer_reassigned"}`,
+		"",
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	for i, line := range want {
+		if !scanner.Scan() {
+			t.Fatalf("stream ended before line %d: %v", i, scanner.Err())
+		}
+		if got := scanner.Text(); got != line {
+			t.Errorf("line %d = %q, want %q", i, got, line)
+		}
+	}
+}
+
+func TestConvertDomainEventToAPI_EmptyReviewers(t *testing.T) {
+	event := convertDomainEventToAPI(&domain.ReviewEvent{ID: 1, Type: domain.EventPRMerged})
+	if event.Reviewers == nil || len(event.Reviewers) != 0 {
+		t.Errorf("Reviewers = %v, want empty list", event.Reviewers)
+	}
+	if event.ReviewerId != nil || event.OldReviewerId != nil {
+		t.Error("Reviewer fields should be omitted when empty")
+	}
+	if event.Type != "pr_merged" {
+		t.Errorf("Type = %q, want pr_merged", event.Type)
+	}
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"net/http"
+
+	"avito-test-task/internal/usecase"
+)
+
+// ActorHeader содержит идентификатор инициатора запроса для журнала изменений
+const ActorHeader = "X-Actor-Id"
+
+func ActorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if actor := r.Header.Get(ActorHeader); actor != "" {
+			r = r.WithContext(usecase.WithActor(r.Context(), actor))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/ratelimit"
+)
+
+// APIKeyHeader идентифицирует клиента для квот; без него квота считается по IP
+const APIKeyHeader = "X-API-Key"
+
+// RateLimitMiddleware ограничивает частоту запросов клиента по token bucket с лимитами из rules.
+// Собственную квоту получают только ключи из apiKeys: заголовок не аутентифицирован, и случайный ключ
+// на каждый запрос иначе обходил бы квоту IP. Если лимитер недоступен, запрос пропускается:
+// отказ хранилища квот не должен ронять сервис
+func RateLimitMiddleware(limiter ratelimit.Limiter, rules ratelimit.Rules, apiKeys []string) api.MiddlewareFunc {
+	known := make(map[string]struct{}, len(apiKeys))
+	for _, key := range apiKeys {
+		known[key] = struct{}{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit, scope := rules.For(r.URL.Path)
+
+			result, err := limiter.Allow(r.Context(), clientKey(r, known)+"|"+scope, limit)
+			if err != nil {
+				slog.Warn("Rate limiter failed, request is not limited", "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := w.Header()
+			header.Set("X-RateLimit-Limit", strconv.Itoa(limit.Burst))
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			header.Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset.Seconds())))
+
+			if !result.Allowed {
+				header.Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter.Seconds())))
+				writeError(w, r, apiError{
+					status:  http.StatusTooManyRequests,
+					code:    api.RATELIMITED,
+					message: "rate limit exceeded",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientKey не хранит API-ключ в открытом виде: в общей таблице лимитов лежит только его хеш.
+// Ключ не из known не учитывается, такой запрос считается по IP
+func clientKey(r *http.Request, known map[string]struct{}) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		if _, ok := known[key]; ok {
+			sum := sha256.Sum256([]byte(key))
+			return "key:" + hex.EncodeToString(sum[:16])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+func ceilSeconds(seconds float64) int {
+	return int(math.Ceil(seconds))
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/ratelimit"
+)
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules := ratelimit.Rules{
+		Default: ratelimit.Limit{Rate: 100, Burst: 100},
+		Routes:  map[string]ratelimit.Limit{"/pullRequest/create": {Rate: 0.5, Burst: 1}},
+	}
+	h := RateLimitMiddleware(ratelimit.NewMemoryLimiter(func() time.Time { return start }), rules, []string{"ci-token"})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	send := func(path, apiKey, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send("/pullRequest/create", "", "10.0.0.1:1234")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("First request status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if rec.Header().Get("X-RateLimit-Limit") != "1" || rec.Header().Get("X-RateLimit-Remaining") != "0" {
+		t.Errorf("Unexpected rate limit headers: %v", rec.Header())
+	}
+
+	rec = send("/pullRequest/create", "", "10.0.0.1:5678")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Second request status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %s, want 2", got)
+	}
+
+	if rec := send("/team/add", "", "10.0.0.1:1234"); rec.Code != http.StatusNoContent {
+		t.Errorf("Other route should use default limit, status = %d", rec.Code)
+	}
+	if rec := send("/pullRequest/create", "", "10.0.0.2:1234"); rec.Code != http.StatusNoContent {
+		t.Errorf("Other IP should have own quota, status = %d", rec.Code)
+	}
+	if rec := send("/pullRequest/create", "ci-token", "10.0.0.1:1234"); rec.Code != http.StatusNoContent {
+		t.Errorf("API key should have own quota, status = %d", rec.Code)
+	}
+	if rec := send("/pullRequest/create", "ci-token", "10.0.0.3:1234"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("API key quota should not depend on IP, status = %d", rec.Code)
+	}
+	// неизвестный ключ квоту IP не обходит
+	if rec := send("/pullRequest/create", "random-key", "10.0.0.1:1234"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("Unknown API key should use the IP quota, status = %d", rec.Code)
+	}
+}
+
+func TestRateLimitMiddleware_FailOpen(t *testing.T) {
+	h := RateLimitMiddleware(failingLimiter{}, ratelimit.Rules{Default: ratelimit.Limit{Rate: 1, Burst: 1}}, nil)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Status = %d, want %d when limiter is unavailable", rec.Code, http.StatusNoContent)
+	}
+}
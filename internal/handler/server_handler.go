@@ -2,30 +2,29 @@ package handler
 
 import (
 	"context"
-	"log"
+	"errors"
 
 	"avito-test-task/internal/api"
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
 	"avito-test-task/internal/usecase"
 )
 
-const (
-	// There aren't enough any kinds of errors in openapi specification
-	// In that cases UnexpectedError was returned
-	UnexpectedError = "Unexpected Error"
-)
-
 type ServerHandler struct {
-	teamUC *usecase.TeamUseCase
-	userUC *usecase.UserUseCase
-	prUC   *usecase.PRUseCase
+	teamUC  *usecase.TeamUseCase
+	userUC  *usecase.UserUseCase
+	prUC    *usecase.PRUseCase
+	auditUC *usecase.AuditUseCase
+	events  *events.Broker
 }
 
-func NewServerHandler(team *usecase.TeamUseCase, user *usecase.UserUseCase, pr *usecase.PRUseCase) *ServerHandler {
+func NewServerHandler(team *usecase.TeamUseCase, user *usecase.UserUseCase, pr *usecase.PRUseCase, audit *usecase.AuditUseCase, broker *events.Broker) *ServerHandler {
 	return &ServerHandler{
-		teamUC: team,
-		userUC: user,
-		prUC:   pr,
+		teamUC:  team,
+		userUC:  user,
+		prUC:    pr,
+		auditUC: audit,
+		events:  broker,
 	}
 }
 
@@ -35,7 +34,7 @@ func (h *ServerHandler) PostTeamAdd(ctx context.Context, request api.PostTeamAdd
 
 	team, err := h.teamUC.CreateTeam(ctx, domainTeam)
 	if err != nil {
-		return h.handleTeamError(err)
+		return nil, err
 	}
 
 	return api.PostTeamAdd201JSONResponse{
@@ -46,20 +45,116 @@ func (h *ServerHandler) PostTeamAdd(ctx context.Context, request api.PostTeamAdd
 func (h *ServerHandler) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestObject) (api.GetTeamGetResponseObject, error) {
 	team, err := h.teamUC.GetTeam(ctx, request.Params.TeamName)
 	if err != nil {
-		return api.GetTeamGet404JSONResponse{
-			Error: buildError(api.NOTFOUND, "Team not found"),
-		}, nil
+		return nil, err
 	}
 
 	return api.GetTeamGet200JSONResponse(*h.convertDomainTeamToAPI(team)), nil
 }
 
+func (h *ServerHandler) PostTeamSetReviewersCount(ctx context.Context, request api.PostTeamSetReviewersCountRequestObject) (api.PostTeamSetReviewersCountResponseObject, error) {
+	team, err := h.teamUC.SetReviewersCount(ctx, request.Body.TeamName, request.Body.ReviewersCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamSetReviewersCount200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
+func (h *ServerHandler) PostTeamSetReviewSLA(ctx context.Context, request api.PostTeamSetReviewSLARequestObject) (api.PostTeamSetReviewSLAResponseObject, error) {
+	team, err := h.teamUC.SetReviewSLA(ctx, request.Body.TeamName, valueOrZero(request.Body.ReviewSlaHours), valueOrZero(request.Body.ReassignAfterHours))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamSetReviewSLA200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
+func (h *ServerHandler) PostTeamSetReviewRotation(ctx context.Context, request api.PostTeamSetReviewRotationRequestObject) (api.PostTeamSetReviewRotationResponseObject, error) {
+	team, err := h.teamUC.SetReviewRotation(ctx, request.Body.TeamName, request.Body.ReviewRotation)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamSetReviewRotation200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
+func (h *ServerHandler) GetTeamReviewMatrix(ctx context.Context, request api.GetTeamReviewMatrixRequestObject) (api.GetTeamReviewMatrixResponseObject, error) {
+	matrix, err := h.prUC.GetReviewMatrix(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetTeamReviewMatrix200JSONResponse(h.convertDomainReviewMatrixToAPI(matrix)), nil
+}
+
+func (h *ServerHandler) PostTeamMove(ctx context.Context, request api.PostTeamMoveRequestObject) (api.PostTeamMoveResponseObject, error) {
+	team, err := h.teamUC.MoveTeam(ctx, request.Body.TeamName, valueOrZero(request.Body.ParentName))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamMove200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
+func (h *ServerHandler) GetTeamSubtree(ctx context.Context, request api.GetTeamSubtreeRequestObject) (api.GetTeamSubtreeResponseObject, error) {
+	tree, err := h.teamUC.GetSubtree(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetTeamSubtree200JSONResponse(h.convertDomainTeamTreeToAPI(tree)), nil
+}
+
+func (h *ServerHandler) GetTeamSubtreeStats(ctx context.Context, request api.GetTeamSubtreeStatsRequestObject) (api.GetTeamSubtreeStatsResponseObject, error) {
+	stats, err := h.teamUC.GetSubtreeStats(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetTeamSubtreeStats200JSONResponse{
+		Stats: h.convertDomainTeamStatsToAPI(stats),
+	}, nil
+}
+
+func (h *ServerHandler) PostTeamSetMember(ctx context.Context, request api.PostTeamSetMemberRequestObject) (api.PostTeamSetMemberResponseObject, error) {
+	isActive := true
+	if request.Body.IsActive != nil {
+		isActive = *request.Body.IsActive
+	}
+
+	team, err := h.teamUC.SetMember(ctx, request.Body.TeamName, request.Body.UserId, h.convertAPIRoleToDomain(request.Body.Role), isActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamSetMember200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
+func (h *ServerHandler) PostTeamRemoveMember(ctx context.Context, request api.PostTeamRemoveMemberRequestObject) (api.PostTeamRemoveMemberResponseObject, error) {
+	team, err := h.teamUC.RemoveMember(ctx, request.Body.TeamName, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamRemoveMember200JSONResponse{
+		Team: h.convertDomainTeamToAPI(team),
+	}, nil
+}
+
 func (h *ServerHandler) PostUsersSetIsActive(ctx context.Context, request api.PostUsersSetIsActiveRequestObject) (api.PostUsersSetIsActiveResponseObject, error) {
 	user, err := h.userUC.SetUserActivity(ctx, request.Body.UserId, request.Body.IsActive)
 	if err != nil {
-		return api.PostUsersSetIsActive404JSONResponse{
-			Error: buildError(api.NOTFOUND, "User not found"),
-		}, nil
+		return nil, err
 	}
 
 	return api.PostUsersSetIsActive200JSONResponse{
@@ -67,29 +162,110 @@ func (h *ServerHandler) PostUsersSetIsActive(ctx context.Context, request api.Po
 	}, nil
 }
 
+func (h *ServerHandler) PostUsersSetNotifications(ctx context.Context, request api.PostUsersSetNotificationsRequestObject) (api.PostUsersSetNotificationsResponseObject, error) {
+	user, err := h.userUC.SetNotifications(ctx, request.Body.UserId, h.convertAPINotificationsToDomain(request.Body.Notifications))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetNotifications200JSONResponse{
+		User: h.convertDomainUserToAPI(user),
+	}, nil
+}
+
+func (h *ServerHandler) PostUsersSetCapacity(ctx context.Context, request api.PostUsersSetCapacityRequestObject) (api.PostUsersSetCapacityResponseObject, error) {
+	user, err := h.userUC.SetCapacity(ctx, request.Body.UserId, request.Body.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetCapacity200JSONResponse{
+		User: h.convertDomainUserToAPI(user),
+	}, nil
+}
+
+func (h *ServerHandler) PostUsersHeartbeat(ctx context.Context, request api.PostUsersHeartbeatRequestObject) (api.PostUsersHeartbeatResponseObject, error) {
+	user, err := h.userUC.Heartbeat(ctx, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersHeartbeat200JSONResponse{
+		User: h.convertDomainUserToAPI(user),
+	}, nil
+}
+
+func (h *ServerHandler) PostUsersSetTags(ctx context.Context, request api.PostUsersSetTagsRequestObject) (api.PostUsersSetTagsResponseObject, error) {
+	user, err := h.userUC.SetTags(ctx, request.Body.UserId, request.Body.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetTags200JSONResponse{
+		User: h.convertDomainUserToAPI(user),
+	}, nil
+}
+
+func (h *ServerHandler) GetUsersSearchByTag(ctx context.Context, request api.GetUsersSearchByTagRequestObject) (api.GetUsersSearchByTagResponseObject, error) {
+	users, err := h.teamUC.SearchUsersByTag(ctx, request.Params.Tag, valueOrZero(request.Params.TeamName))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, *h.convertDomainUserToAPI(user))
+	}
+	return api.GetUsersSearchByTag200JSONResponse{Users: result}, nil
+}
+
+func (h *ServerHandler) PostUsersSetPrimaryTeam(ctx context.Context, request api.PostUsersSetPrimaryTeamRequestObject) (api.PostUsersSetPrimaryTeamResponseObject, error) {
+	user, err := h.teamUC.SetPrimaryTeam(ctx, request.Body.UserId, request.Body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetPrimaryTeam200JSONResponse{
+		User: h.convertDomainUserToAPI(user),
+	}, nil
+}
+
 func (h *ServerHandler) PostPullRequestCreate(ctx context.Context, request api.PostPullRequestCreateRequestObject) (api.PostPullRequestCreateResponseObject, error) {
-	pr, err := h.prUC.CreatePR(ctx, request.Body.PullRequestId, request.Body.PullRequestName, request.Body.AuthorId)
+	var opts []usecase.CreatePROption
+	if request.Body.ReviewersCount != nil {
+		opts = append(opts, usecase.WithReviewersCount(*request.Body.ReviewersCount))
+	}
+	if request.Body.TeamName != nil {
+		opts = append(opts, usecase.WithTeam(*request.Body.TeamName))
+	}
+	if request.Body.RequiredTags != nil {
+		opts = append(opts, usecase.WithRequiredTags(*request.Body.RequiredTags...))
+	}
+	if request.Body.Priority != nil {
+		opts = append(opts, usecase.WithPriority(domain.PRPriority(*request.Body.Priority)))
+	}
+	if request.Body.Labels != nil {
+		opts = append(opts, usecase.WithLabels(*request.Body.Labels...))
+	}
+	if request.Body.Metadata != nil {
+		opts = append(opts, usecase.WithMetadata(h.convertAPIMetadataToDomain(*request.Body.Metadata)))
+	}
+
+	pr, err := h.prUC.CreatePR(ctx, request.Body.PullRequestId, request.Body.PullRequestName, request.Body.AuthorId, opts...)
 	if err != nil {
-		return h.handlePRError(err)
+		return nil, err
 	}
 
 	return api.PostPullRequestCreate201JSONResponse{
-		Pr: h.convertDomainPRToAPI(pr),
+		Pr:            h.convertDomainPRToAPI(pr),
+		UncoveredTags: nilIfEmpty(pr.UncoveredTags),
 	}, nil
 }
 
 func (h *ServerHandler) PostPullRequestMerge(ctx context.Context, request api.PostPullRequestMergeRequestObject) (api.PostPullRequestMergeResponseObject, error) {
 	pr, err := h.prUC.MergePR(ctx, request.Body.PullRequestId)
 	if err != nil {
-		if err == domain.ErrPRNotFound {
-			return api.PostPullRequestMerge404JSONResponse{
-				Error: buildError(api.NOTFOUND, "PR not found"),
-			}, nil
-		}
-		log.Printf("Internal error merging PR: %v", err)
-		return api.PostPullRequestMerge404JSONResponse{
-			Error: buildError(api.NOTFOUND, "Unexpected error in merging"),
-		}, err
+		return nil, err
 	}
 
 	return api.PostPullRequestMerge200JSONResponse{
@@ -97,28 +273,47 @@ func (h *ServerHandler) PostPullRequestMerge(ctx context.Context, request api.Po
 	}, nil
 }
 
+func (h *ServerHandler) PostPullRequestSetPriority(ctx context.Context, request api.PostPullRequestSetPriorityRequestObject) (api.PostPullRequestSetPriorityResponseObject, error) {
+	pr, err := h.prUC.SetPriority(ctx, request.Body.PullRequestId, domain.PRPriority(request.Body.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostPullRequestSetPriority200JSONResponse{
+		Pr: *h.convertDomainPRToAPI(pr),
+	}, nil
+}
+
+func (h *ServerHandler) PostPullRequestUpdate(ctx context.Context, request api.PostPullRequestUpdateRequestObject) (api.PostPullRequestUpdateResponseObject, error) {
+	update := domain.PRUpdate{Labels: request.Body.Labels}
+	if request.Body.Metadata != nil {
+		metadata := h.convertAPIMetadataToDomain(*request.Body.Metadata)
+		update.Metadata = &metadata
+	}
+
+	pr, err := h.prUC.UpdatePR(ctx, request.Body.PullRequestId, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostPullRequestUpdate200JSONResponse{
+		Pr: *h.convertDomainPRToAPI(pr),
+	}, nil
+}
+
 func (h *ServerHandler) PostPullRequestReassign(ctx context.Context, request api.PostPullRequestReassignRequestObject) (api.PostPullRequestReassignResponseObject, error) {
 	newReviewerID, err := h.prUC.ReassignReviewer(
 		ctx,
 		request.Body.PullRequestId,
 		request.Body.OldUserId,
 	)
-
 	if err != nil {
-		return h.handlePRReassignError(err)
+		return nil, err
 	}
 
 	pr, err := h.prUC.GetPR(ctx, request.Body.PullRequestId)
 	if err != nil {
-		if err == domain.ErrPRNotFound {
-			return api.PostPullRequestReassign404JSONResponse{
-				Error: buildError(api.NOTFOUND, "PR not found"),
-			}, nil
-		}
-		log.Printf("Internal error getting PR: %v", err)
-		return api.PostPullRequestReassign404JSONResponse{
-			Error: buildError(UnexpectedError, "Unexpected error in reassigning"),
-		}, err
+		return nil, err
 	}
 
 	return api.PostPullRequestReassign200JSONResponse{
@@ -127,20 +322,59 @@ func (h *ServerHandler) PostPullRequestReassign(ctx context.Context, request api
 	}, nil
 }
 
+func (h *ServerHandler) PostPullRequestTopUp(ctx context.Context, request api.PostPullRequestTopUpRequestObject) (api.PostPullRequestTopUpResponseObject, error) {
+	pr, added, err := h.prUC.TopUpReviewers(ctx, request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostPullRequestTopUp200JSONResponse{
+		Pr:    *h.convertDomainPRToAPI(pr),
+		Added: added,
+	}, nil
+}
+
+func (h *ServerHandler) PostPullRequestAddReviewer(ctx context.Context, request api.PostPullRequestAddReviewerRequestObject) (api.PostPullRequestAddReviewerResponseObject, error) {
+	pr, err := h.prUC.AddReviewer(ctx, request.Body.PullRequestId, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostPullRequestAddReviewer200JSONResponse{
+		Pr: *h.convertDomainPRToAPI(pr),
+	}, nil
+}
+
+func (h *ServerHandler) PostPullRequestRemoveReviewer(ctx context.Context, request api.PostPullRequestRemoveReviewerRequestObject) (api.PostPullRequestRemoveReviewerResponseObject, error) {
+	pr, err := h.prUC.RemoveReviewer(ctx, request.Body.PullRequestId, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostPullRequestRemoveReviewer200JSONResponse{
+		Pr: *h.convertDomainPRToAPI(pr),
+	}, nil
+}
+
 func (h *ServerHandler) GetUsersGetReview(ctx context.Context, request api.GetUsersGetReviewRequestObject) (api.GetUsersGetReviewResponseObject, error) {
-	prs, err := h.prUC.GetPRsByReviewer(ctx, request.Params.UserId)
+	filter := domain.ReviewListFilter{
+		ByPriority: valueOrZero(request.Params.Sort) == api.ReviewSortByPriority,
+	}
+	for _, priority := range valueOrZero(request.Params.Priority) {
+		filter.Priorities = append(filter.Priorities, domain.PRPriority(priority))
+	}
+	filter.Labels = valueOrZero(request.Params.Label)
+	filter.Repository = valueOrZero(request.Params.Repository)
+
+	prs, err := h.prUC.GetPRsByReviewer(ctx, request.Params.UserId, filter)
 	if err != nil {
-		if err == domain.ErrUserNotFound {
+		if errors.Is(err, domain.ErrUserNotFound) {
 			return api.GetUsersGetReview200JSONResponse{
 				UserId:       request.Params.UserId,
 				PullRequests: []api.PullRequestShort{},
 			}, nil
 		}
-		log.Printf("Internal error getting user reviews: %v", err)
-		return api.GetUsersGetReview200JSONResponse{
-			UserId:       request.Params.UserId,
-			PullRequests: []api.PullRequestShort{},
-		}, nil
+		return nil, err
 	}
 
 	var apiPRs []api.PullRequestShort
@@ -150,6 +384,9 @@ func (h *ServerHandler) GetUsersGetReview(ctx context.Context, request api.GetUs
 			PullRequestName: pr.Title,
 			AuthorId:        pr.AuthorID,
 			Status:          api.PullRequestShortStatus(pr.Status),
+			Priority:        nilIfZero(api.PRPriority(pr.Priority)),
+			Labels:          nilIfEmpty(pr.Labels),
+			Metadata:        h.convertDomainMetadataToAPI(pr.Metadata),
 		})
 	}
 
@@ -158,3 +395,47 @@ func (h *ServerHandler) GetUsersGetReview(ctx context.Context, request api.GetUs
 		PullRequests: apiPRs,
 	}, nil
 }
+
+func (h *ServerHandler) GetPullRequestHistory(ctx context.Context, request api.GetPullRequestHistoryRequestObject) (api.GetPullRequestHistoryResponseObject, error) {
+	entries, err := h.auditUC.GetPRHistory(ctx, request.Params.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetPullRequestHistory200JSONResponse{
+		PullRequestId: request.Params.PullRequestId,
+		History:       h.convertDomainAuditEntriesToAPI(entries),
+	}, nil
+}
+
+func (h *ServerHandler) GetAudit(ctx context.Context, request api.GetAuditRequestObject) (api.GetAuditResponseObject, error) {
+	entries, err := h.auditUC.FindEntries(ctx, h.convertAPIAuditParamsToDomain(request.Params))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAudit200JSONResponse{
+		Entries: h.convertDomainAuditEntriesToAPI(entries),
+	}, nil
+}
+
+func (h *ServerHandler) GetAdminAssignmentPolicy(ctx context.Context, request api.GetAdminAssignmentPolicyRequestObject) (api.GetAdminAssignmentPolicyResponseObject, error) {
+	return api.GetAdminAssignmentPolicy200JSONResponse(h.convertPolicyStatusToAPI(h.prUC.PolicyStatus())), nil
+}
+
+func (h *ServerHandler) GetEventsStream(ctx context.Context, request api.GetEventsStreamRequestObject) (api.GetEventsStreamResponseObject, error) {
+	var filter domain.EventFilter
+	if request.Params.UserId != nil {
+		filter.UserID = *request.Params.UserId
+	}
+	if request.Params.TeamName != nil {
+		filter.TeamName = *request.Params.TeamName
+	}
+
+	return eventStreamResponse{
+		ctx:         ctx,
+		broker:      h.events,
+		filter:      filter,
+		lastEventID: request.Params.LastEventID,
+	}, nil
+}
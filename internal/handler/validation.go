@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"avito-test-task/internal/api"
+	"avito-test-task/internal/domain"
+)
+
+// OpenAPIValidationMiddleware проверяет тело и параметры запроса по api/openapi.yml до вызова обработчика
+func OpenAPIValidationMiddleware(swagger *openapi3.T) (api.MiddlewareFunc, error) {
+	// servers из спецификации не должны влиять на поиск маршрута: сервис может стоять за любым хостом
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				// неизвестный маршрут или метод отдаём на откуп роутеру
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			})
+			if err != nil {
+				writeError(w, r, requestValidationError(err))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// requestValidationError превращает ошибки kin-openapi в ответ с ошибками отдельных полей
+func requestValidationError(err error) apiError {
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		return apiError{status: http.StatusBadRequest, code: api.BADREQUEST, message: parseErr.Error()}
+	}
+
+	fields := collectFieldErrors(err, nil)
+	if len(fields) == 0 {
+		fields = []domain.FieldError{{Field: "body", Message: err.Error()}}
+	}
+	return translateError(&domain.ValidationError{Fields: fields})
+}
+
+// collectFieldErrors обходит дерево ошибок по типам: errors.As здесь не подходит,
+// потому что RequestError разворачивается во вложенный MultiError и уровни смешиваются
+func collectFieldErrors(err error, fields []domain.FieldError) []domain.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			fields = collectFieldErrors(inner, fields)
+		}
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			return append(fields, domain.FieldError{Field: e.Parameter.Name, Message: fieldMessage(e)})
+		}
+		if schemaErrs, ok := e.Err.(openapi3.MultiError); ok {
+			for _, inner := range schemaErrs {
+				fields = appendSchemaError(fields, inner)
+			}
+			return fields
+		}
+		fields = appendSchemaError(fields, e.Err)
+	}
+	return fields
+}
+
+func appendSchemaError(fields []domain.FieldError, err error) []domain.FieldError {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return append(fields, domain.FieldError{Field: "body", Message: err.Error()})
+	}
+
+	field := formatPointer(schemaErr.JSONPointer())
+	if field == "" {
+		field = "body"
+	}
+	return append(fields, domain.FieldError{Field: field, Message: schemaErr.Reason})
+}
+
+func fieldMessage(reqErr *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if reqErr.Reason != "" {
+		return reqErr.Reason
+	}
+	return reqErr.Err.Error()
+}
+
+// formatPointer записывает JSON pointer так же, как domain-валидаторы: members[1].user_id
+func formatPointer(pointer []string) string {
+	var b strings.Builder
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
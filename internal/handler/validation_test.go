@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito-test-task/internal/api"
+)
+
+func newValidatedHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+	middleware, err := OpenAPIValidationMiddleware(swagger)
+	if err != nil {
+		t.Fatalf("OpenAPIValidationMiddleware() error = %v", err)
+	}
+
+	return middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func TestOpenAPIValidationMiddleware(t *testing.T) {
+	h := newValidatedHandler(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantCode   api.ErrorCode
+		wantFields []string
+	}{
+		{
+			name:       "valid create request passes",
+			method:     http.MethodPost,
+			target:     "/pullRequest/create",
+			body:       `{"pull_request_id": "pr-1", "pull_request_name": "Add search", "author_id": "u1"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "empty pull_request_id",
+			method:     http.MethodPost,
+			target:     "/pullRequest/create",
+			body:       `{"pull_request_id": "", "pull_request_name": "Add search", "author_id": "u1"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"pull_request_id"},
+		},
+		{
+			name:       "blank title and id with spaces",
+			method:     http.MethodPost,
+			target:     "/pullRequest/create",
+			body:       `{"pull_request_id": "pr 1", "pull_request_name": "   ", "author_id": "u1"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"pull_request_id", "pull_request_name"},
+		},
+		{
+			name:       "missing required field",
+			method:     http.MethodPost,
+			target:     "/pullRequest/merge",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"pull_request_id"},
+		},
+		{
+			name:       "blank username in team member",
+			method:     http.MethodPost,
+			target:     "/team/add",
+			body:       `{"team_name": "backend", "members": [{"user_id": "u1", "username": "", "is_active": true}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"members[0].username"},
+		},
+		{
+			name:       "review sla out of range",
+			method:     http.MethodPost,
+			target:     "/team/setReviewSLA",
+			body:       `{"team_name": "backend", "review_sla_hours": 0}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"review_sla_hours"},
+		},
+		{
+			name:       "unknown notification locale",
+			method:     http.MethodPost,
+			target:     "/users/setNotifications",
+			body:       `{"user_id": "u1", "notifications": {"email": "u1@example.com", "locale": "de"}}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"notifications.locale"},
+		},
+		{
+			name:       "missing query parameter",
+			method:     http.MethodGet,
+			target:     "/team/get",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.VALIDATIONERROR,
+			wantFields: []string{"team_name"},
+		},
+		{
+			name:       "malformed json",
+			method:     http.MethodPost,
+			target:     "/pullRequest/merge",
+			body:       `{"pull_request_id": `,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   api.BADREQUEST,
+		},
+		{
+			name:       "unknown route is left to router",
+			method:     http.MethodGet,
+			target:     "/unknown",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Status = %d, want %d, body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantCode == "" {
+				return
+			}
+
+			var resp api.ErrorResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if resp.Error.Code != tt.wantCode {
+				t.Errorf("Code = %s, want %s", resp.Error.Code, tt.wantCode)
+			}
+
+			var got []string
+			if resp.Error.Fields != nil {
+				for _, f := range *resp.Error.Fields {
+					got = append(got, f.Field)
+				}
+			}
+			for _, want := range tt.wantFields {
+				found := false
+				for _, field := range got {
+					if field == want {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Field %s not reported, got %v", want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// EmailConfig - параметры SMTP-сервера. Username пустой - без аутентификации
+type EmailConfig struct {
+	// Addr - host:port SMTP-сервера
+	Addr     string
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// EmailNotifier отправляет письма через SMTP. STARTTLS используется, если сервер его предлагает
+type EmailNotifier struct {
+	cfg      EmailConfig
+	renderer *Renderer
+	now      func() time.Time
+}
+
+func NewEmailNotifier(cfg EmailConfig, renderer *Renderer) *EmailNotifier {
+	return &EmailNotifier{cfg: cfg, renderer: renderer, now: time.Now}
+}
+
+func (e *EmailNotifier) Notify(ctx context.Context, n Notification) error {
+	if !n.Recipient.Notifications.EmailEnabled() {
+		return nil
+	}
+
+	msg, err := e.renderer.Render(n)
+	if err != nil {
+		return err
+	}
+
+	to := n.Recipient.Notifications.Email
+	data, err := e.buildMessage(to, msg)
+	if err != nil {
+		return err
+	}
+	if err := e.send(ctx, to, data); err != nil {
+		return fmt.Errorf("send email to %s: %w", n.Recipient.ID, err)
+	}
+	return nil
+}
+
+func (e *EmailNotifier) buildMessage(to string, msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", e.now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	if _, err := body.Write([]byte(msg.Body)); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// send не использует smtp.SendMail, чтобы соединение ограничивалось контекстом и Timeout
+func (e *EmailNotifier) send(ctx context.Context, to string, data []byte) error {
+	host, _, err := net.SplitHostPort(e.cfg.Addr)
+	if err != nil {
+		return err
+	}
+
+	if e.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.cfg.Timeout)
+		defer cancel()
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", e.cfg.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if e.cfg.Username != "" {
+		// PlainAuth сам откажет в отправке пароля без TLS, кроме localhost
+		if err := client.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(e.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
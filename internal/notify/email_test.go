@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// smtpMessage - письмо, принятое тестовым SMTP-сервером
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer поднимает минимальный SMTP-сервер без TLS и аутентификации, которого хватает net/smtp
+func startSMTPServer(t *testing.T) (addr string, messages <-chan smtpMessage) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan smtpMessage, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, received)
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func serveSMTP(conn net.Conn, received chan<- smtpMessage) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	var msg smtpMessage
+	tp.PrintfLine("220 localhost ESMTP test")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			msg.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			msg.to = append(msg.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.data = string(data)
+			received <- msg
+			msg = smtpMessage{}
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return
+		default:
+			tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func TestEmailNotifier(t *testing.T) {
+	addr, messages := startSMTPServer(t)
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	notifier := NewEmailNotifier(EmailConfig{Addr: addr, From: "review@example.com", Timeout: 5 * time.Second}, renderer)
+
+	n := testNotification(KindReviewerAssigned, domain.NotificationSettings{Email: "bob@example.com"})
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	var got smtpMessage
+	select {
+	case got = <-messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP server did not receive the message")
+	}
+	if got.from != "review@example.com" || len(got.to) != 1 || got.to[0] != "bob@example.com" {
+		t.Errorf("Envelope = %s -> %v", got.from, got.to)
+	}
+
+	parsed, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(got.data)))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("Failed to decode subject: %v", err)
+	}
+	if subject != "Вас назначили ревьювером PR pr-1" {
+		t.Errorf("Subject = %q", subject)
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	if err != nil {
+		t.Fatalf("Failed to decode body: %v", err)
+	}
+	if !strings.Contains(string(body), "alice ждёт вашего ревью PR pr-1 «Add search»") {
+		t.Errorf("Body = %q", body)
+	}
+}
+
+func TestEmailNotifier_SkipsDisabledChannel(t *testing.T) {
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	// адрес никуда не ведёт: письмо не должно даже отправляться
+	notifier := NewEmailNotifier(EmailConfig{Addr: "127.0.0.1:1", From: "review@example.com"}, renderer)
+
+	for _, settings := range []domain.NotificationSettings{
+		{},
+		{Email: "bob@example.com", MuteEmail: true},
+	} {
+		if err := notifier.Notify(context.Background(), testNotification(KindPRMerged, settings)); err != nil {
+			t.Errorf("Notify(%+v) error = %v, want skip", settings, err)
+		}
+	}
+}
+
+func TestEmailNotifier_ServerUnavailable(t *testing.T) {
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	notifier := NewEmailNotifier(EmailConfig{Addr: addr, From: "review@example.com", Timeout: time.Second}, renderer)
+	n := testNotification(KindPRMerged, domain.NotificationSettings{Email: "bob@example.com"})
+	if err := notifier.Notify(context.Background(), n); err == nil {
+		t.Error("Notify() error = nil, want connection error")
+	}
+}
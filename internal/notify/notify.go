@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// Kind - тип уведомления
+type Kind string
+
+const (
+	// KindReviewerAssigned - получателя назначили ревьювером PR
+	KindReviewerAssigned Kind = "reviewer_assigned"
+	// KindReviewerReassigned - получателя заменили другим ревьювером
+	KindReviewerReassigned Kind = "reviewer_reassigned"
+	// KindPRMerged - PR, где получатель автор или ревьювер, смёржен
+	KindPRMerged Kind = "pr_merged"
+	// KindReviewReminder - получатель не сделал ревью за время SLA команды
+	KindReviewReminder Kind = "review_reminder"
+)
+
+// Kinds - все типы уведомлений; для каждого и каждого языка есть шаблон
+var Kinds = []Kind{KindReviewerAssigned, KindReviewerReassigned, KindPRMerged, KindReviewReminder}
+
+// Notification - одно уведомление одному получателю
+type Notification struct {
+	Kind Kind
+	// Recipient - получатель; адреса, язык и отключённые каналы берутся из его настроек
+	Recipient       domain.User
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	AuthorName      string
+	TeamName        string
+	// ReviewerID - ревьювер, о котором уведомление; при назначении и напоминании совпадает с получателем
+	ReviewerID string
+	// NewReviewerID и NewReviewerName заполнены только для KindReviewerReassigned
+	NewReviewerID   string
+	NewReviewerName string
+	// WaitingSince заполнен только для KindReviewReminder
+	WaitingSince time.Time
+}
+
+// Notifier доставляет уведомления; ошибка означает, что уведомление не доставлено и его стоит повторить.
+// Каналы, которые получатель отключил или для которых у него нет адреса, пропускаются без ошибки
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Multi рассылает уведомление во все каналы; ошибка одного канала не мешает остальным
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogNotifier пишет уведомления в лог сервера; используется, пока не настроен ни один канал
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, n Notification) error {
+	log.Printf("Notification %s for %s: PR %s %q by %s", n.Kind, n.Recipient.ID, n.PullRequestID, n.PullRequestName, n.AuthorID)
+	return nil
+}
@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// deliveryTimeout ограничивает доставку одного уведомления из очереди
+const deliveryTimeout = 30 * time.Second
+
+// Queue доставляет уведомления в фоне, чтобы медленный SMTP или чат не задерживали запросы API.
+// Уведомления, не поместившиеся в очередь или не доставленные, теряются с записью в лог
+type Queue struct {
+	next  Notifier
+	items chan Notification
+}
+
+func NewQueue(next Notifier, size int) *Queue {
+	return &Queue{
+		next:  next,
+		items: make(chan Notification, size),
+	}
+}
+
+// Notify ставит уведомление в очередь и не ждёт доставки
+func (q *Queue) Notify(_ context.Context, n Notification) error {
+	select {
+	case q.items <- n:
+	default:
+		log.Printf("Notification queue is full, dropping %s for %s on PR %s", n.Kind, n.Recipient.ID, n.PullRequestID)
+	}
+	return nil
+}
+
+// Run доставляет уведомления по одному до отмены ctx
+func (q *Queue) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-q.items:
+			deliverCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+			if err := q.next.Notify(deliverCtx, n); err != nil {
+				log.Printf("Failed to deliver %s for %s on PR %s: %v", n.Kind, n.Recipient.ID, n.PullRequestID, err)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+type funcNotifier func(context.Context, Notification) error
+
+func (f funcNotifier) Notify(ctx context.Context, n Notification) error {
+	return f(ctx, n)
+}
+
+func TestMulti(t *testing.T) {
+	var delivered []string
+	ok := funcNotifier(func(_ context.Context, n Notification) error {
+		delivered = append(delivered, n.Recipient.ID)
+		return nil
+	})
+	failing := funcNotifier(func(context.Context, Notification) error { return errors.New("smtp unavailable") })
+
+	err := Multi{failing, ok}.Notify(context.Background(), testNotification(KindPRMerged, domain.NotificationSettings{}))
+	if err == nil {
+		t.Error("Notify() error = nil, want error of failing channel")
+	}
+	if len(delivered) != 1 {
+		t.Errorf("Delivered = %v, failing channel should not stop the others", delivered)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	delivered := make(chan Notification, 1)
+	release := make(chan struct{})
+	queue := NewQueue(funcNotifier(func(_ context.Context, n Notification) error {
+		<-release
+		delivered <- n
+		return nil
+	}), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go queue.Run(ctx)
+
+	n := testNotification(KindReviewerAssigned, domain.NotificationSettings{})
+	// первое уведомление забирает воркер, второе ждёт в очереди, третье отбрасывается
+	for i := 0; i < 3; i++ {
+		if err := queue.Notify(context.Background(), n); err != nil {
+			t.Fatalf("Notify() error = %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(release)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-delivered:
+		case <-time.After(time.Second):
+			t.Fatalf("Delivered %d notifications, want 2", i)
+		}
+	}
+	select {
+	case <-delivered:
+		t.Error("Notification beyond queue size should be dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,93 @@
+package notify
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Message - уведомление, подставленное в шаблон на языке получателя
+type Message struct {
+	Subject string
+	// Body - текст письма
+	Body string
+	// Chat - короткое сообщение для чата с упоминанием получателя
+	Chat string
+}
+
+// Renderer подставляет уведомления в шаблоны templates/<язык>/<тип>.tmpl.
+// Каждый шаблон определяет subject, body и chat
+type Renderer struct {
+	defaultLocale domain.Locale
+	templates     map[domain.Locale]map[Kind]*template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	// mention упоминает пользователя в чате, без ChatHandle - по имени
+	"mention": func(u domain.User) string {
+		if u.Notifications.ChatHandle != "" {
+			return u.Notifications.ChatHandle
+		}
+		return u.Username
+	},
+	"datetime": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	},
+}
+
+// NewRenderer разбирает шаблоны всех типов на всех языках; defaultLocale - язык получателей без своего
+func NewRenderer(defaultLocale domain.Locale) (*Renderer, error) {
+	r := &Renderer{
+		defaultLocale: defaultLocale,
+		templates:     make(map[domain.Locale]map[Kind]*template.Template, len(domain.Locales)),
+	}
+	for _, locale := range domain.Locales {
+		r.templates[locale] = make(map[Kind]*template.Template, len(Kinds))
+		for _, kind := range Kinds {
+			path := fmt.Sprintf("templates/%s/%s.tmpl", locale, kind)
+			tmpl, err := template.New(string(kind)).Funcs(templateFuncs).Option("missingkey=error").ParseFS(templateFS, path)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range []string{"subject", "body", "chat"} {
+				if tmpl.Lookup(name) == nil {
+					return nil, fmt.Errorf("%s: template %q is not defined", path, name)
+				}
+			}
+			r.templates[locale][kind] = tmpl
+		}
+	}
+	if _, ok := r.templates[defaultLocale]; !ok {
+		return nil, fmt.Errorf("unsupported default locale %q", defaultLocale)
+	}
+	return r, nil
+}
+
+// Render подставляет уведомление в шаблон на языке получателя
+func (r *Renderer) Render(n Notification) (Message, error) {
+	locale := n.Recipient.Notifications.Locale
+	if locale == "" {
+		locale = r.defaultLocale
+	}
+	tmpl, ok := r.templates[locale][n.Kind]
+	if !ok {
+		return Message{}, fmt.Errorf("no %s template for notification %q", locale, n.Kind)
+	}
+
+	var msg Message
+	for name, dst := range map[string]*string{"subject": &msg.Subject, "body": &msg.Body, "chat": &msg.Chat} {
+		var sb strings.Builder
+		if err := tmpl.ExecuteTemplate(&sb, name, n); err != nil {
+			return Message{}, err
+		}
+		*dst = strings.TrimSpace(sb.String())
+	}
+	return msg, nil
+}
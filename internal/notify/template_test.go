@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+func testNotification(kind Kind, settings domain.NotificationSettings) Notification {
+	return Notification{
+		Kind:            kind,
+		Recipient:       domain.User{ID: "u2", Username: "bob", Notifications: settings},
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		AuthorName:      "alice",
+		TeamName:        "backend",
+		ReviewerID:      "u2",
+		NewReviewerID:   "u3",
+		NewReviewerName: "carol",
+		WaitingSince:    time.Date(2025, 11, 3, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		n           Notification
+		wantSubject string
+		wantBody    string
+		wantChat    string
+	}{
+		{
+			name:        "default locale",
+			n:           testNotification(KindReviewerAssigned, domain.NotificationSettings{}),
+			wantSubject: "Вас назначили ревьювером PR pr-1",
+			wantBody:    "alice ждёт вашего ревью PR pr-1 «Add search»",
+			wantChat:    "bob, вас назначили ревьювером",
+		},
+		{
+			name:        "recipient locale and chat handle",
+			n:           testNotification(KindReviewerReassigned, domain.NotificationSettings{Locale: domain.LocaleEN, ChatHandle: "<@U024BE7LH>"}),
+			wantSubject: "Review of PR pr-1 was handed over",
+			wantBody:    "carol will take over the review",
+			wantChat:    "<@U024BE7LH>, review of PR pr-1",
+		},
+		{
+			name:        "reminder shows waiting time",
+			n:           testNotification(KindReviewReminder, domain.NotificationSettings{Locale: domain.LocaleEN}),
+			wantSubject: "Reminder: PR pr-1 is waiting for your review",
+			wantBody:    "since 2025-11-03 09:30 UTC",
+			wantChat:    "since 2025-11-03 09:30 UTC",
+		},
+		{
+			name:        "merged",
+			n:           testNotification(KindPRMerged, domain.NotificationSettings{}),
+			wantSubject: "PR pr-1 смёржен",
+			wantBody:    "ревью больше не требуется",
+			wantChat:    "PR pr-1 «Add search» смёржен",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := renderer.Render(tt.n)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if msg.Subject != tt.wantSubject {
+				t.Errorf("Subject = %q, want %q", msg.Subject, tt.wantSubject)
+			}
+			if !strings.Contains(msg.Body, tt.wantBody) {
+				t.Errorf("Body = %q, want it to contain %q", msg.Body, tt.wantBody)
+			}
+			if !strings.HasPrefix(msg.Body, "Здравствуйте, bob!") && !strings.HasPrefix(msg.Body, "Hi bob,") {
+				t.Errorf("Body = %q, want greeting without leading blank lines", msg.Body)
+			}
+			if !strings.Contains(msg.Chat, tt.wantChat) {
+				t.Errorf("Chat = %q, want it to contain %q", msg.Chat, tt.wantChat)
+			}
+		})
+	}
+}
+
+func TestRenderer_Errors(t *testing.T) {
+	if _, err := NewRenderer("de"); err == nil {
+		t.Error("NewRenderer() should reject unsupported default locale")
+	}
+
+	renderer, err := NewRenderer(domain.LocaleEN)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	if _, err := renderer.Render(testNotification("unknown", domain.NotificationSettings{})); err == nil {
+		t.Error("Render() should fail for unknown kind")
+	}
+}
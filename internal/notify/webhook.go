@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxErrorBody - сколько байт ответа чата включать в текст ошибки
+const maxErrorBody = 512
+
+// WebhookNotifier пишет в чат через входящий webhook. Тело {"text": ...} понимают и Slack, и Mattermost
+type WebhookNotifier struct {
+	url      string
+	client   *http.Client
+	renderer *Renderer
+}
+
+func NewWebhookNotifier(webhookURL string, timeout time.Duration, renderer *Renderer) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:      webhookURL,
+		client:   &http.Client{Timeout: timeout},
+		renderer: renderer,
+	}
+}
+
+type webhookPayload struct {
+	Text string `json:"text"`
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, n Notification) error {
+	if !n.Recipient.Notifications.ChatEnabled() {
+		return nil
+	}
+
+	msg, err := w.renderer.Render(n)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(webhookPayload{Text: msg.Chat})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		// url.Error содержит адрес webhook, а в нём секретный токен
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("chat webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("chat webhook: status %d: %s", resp.StatusCode, bytes.TrimSpace(snippet))
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var payloads []webhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Request = %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+		var payload webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode payload: %v", err)
+		}
+		payloads = append(payloads, payload)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	notifier := NewWebhookNotifier(server.URL+"/hooks/secret-token", time.Second, renderer)
+
+	n := testNotification(KindReviewerAssigned, domain.NotificationSettings{ChatHandle: "@bob", Locale: domain.LocaleEN})
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	muted := testNotification(KindReviewerAssigned, domain.NotificationSettings{ChatHandle: "@bob", MuteChat: true})
+	if err := notifier.Notify(context.Background(), muted); err != nil {
+		t.Fatalf("Notify() for muted chat error = %v", err)
+	}
+
+	if len(payloads) != 1 {
+		t.Fatalf("Webhook calls = %d, want 1 (muted chat is skipped)", len(payloads))
+	}
+	if want := `@bob, you were assigned to review PR pr-1 "Add search" by alice`; payloads[0].Text != want {
+		t.Errorf("Text = %q, want %q", payloads[0].Text, want)
+	}
+}
+
+func TestWebhookNotifier_Errors(t *testing.T) {
+	renderer, err := NewRenderer(domain.LocaleRU)
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	n := testNotification(KindPRMerged, domain.NotificationSettings{})
+
+	t.Run("error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid_token", http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL, time.Second, renderer).Notify(context.Background(), n)
+		if err == nil || !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "invalid_token") {
+			t.Errorf("Notify() error = %v, want status and body", err)
+		}
+	})
+
+	t.Run("connection error hides token", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL + "/hooks/secret-token"
+		server.Close()
+
+		err := NewWebhookNotifier(url, time.Second, renderer).Notify(context.Background(), n)
+		if err == nil || strings.Contains(err.Error(), "secret-token") {
+			t.Errorf("Notify() error = %v, want error without webhook url", err)
+		}
+	})
+}
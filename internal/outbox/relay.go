@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+const (
+	// minBackoff и maxBackoff - пауза перед повторной доставкой, растёт вдвое с каждой неудачей
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+	// purgeInterval - как часто удалять доставленные события старше срока хранения
+	purgeInterval = time.Hour
+)
+
+// Sink принимает события из outbox. Ошибка означает, что событие не доставлено: оно будет отправлено снова,
+// а следующие события того же агрегата подождут
+type Sink interface {
+	Deliver(ctx context.Context, event domain.DomainEvent) error
+}
+
+// SinkFunc позволяет использовать функцию как Sink
+type SinkFunc func(ctx context.Context, event domain.DomainEvent) error
+
+func (f SinkFunc) Deliver(ctx context.Context, event domain.DomainEvent) error {
+	return f(ctx, event)
+}
+
+// LogSink только пишет события в лог
+type LogSink struct{}
+
+func (LogSink) Deliver(_ context.Context, event domain.DomainEvent) error {
+	log.Printf("Outbox event %d %s %s/%s: %s", event.ID, event.Type, event.AggregateType, event.AggregateID, event.Payload)
+	return nil
+}
+
+// Store - таблица outbox
+type Store interface {
+	FetchPending(ctx context.Context, now time.Time, limit int) ([]*domain.DomainEvent, error)
+	MarkDelivered(ctx context.Context, id int64, at time.Time) error
+	MarkFailed(ctx context.Context, id int64, nextAttempt time.Time, reason string) error
+	DeleteDelivered(ctx context.Context, before time.Time) (int64, error)
+}
+
+type Settings struct {
+	// BatchSize - сколько событий читается за проход
+	BatchSize int
+	// Retention - сколько хранить доставленные события, 0 - не удалять
+	Retention time.Duration
+}
+
+// Stats - итог одного прохода
+type Stats struct {
+	Delivered int
+	Failed    int
+	Purged    int64
+}
+
+// Relay переносит события из outbox в Sink не реже одного раза. Порядок внутри агрегата сохраняется,
+// пока проход выполняет одна реплика: запускать Relay нужно под общей блокировкой
+type Relay struct {
+	store     Store
+	sink      Sink
+	settings  Settings
+	lastPurge time.Time
+}
+
+func NewRelay(store Store, sink Sink, settings Settings) *Relay {
+	return &Relay{store: store, sink: sink, settings: settings}
+}
+
+// RelayOnce доставляет одну порцию событий. Ошибка хранилища прерывает проход,
+// чтобы недоставленное событие не обогнали следующие события его агрегата
+func (r *Relay) RelayOnce(ctx context.Context, now time.Time) (Stats, error) {
+	var stats Stats
+
+	events, err := r.store.FetchPending(ctx, now, r.settings.BatchSize)
+	if err != nil {
+		return stats, err
+	}
+
+	// агрегаты, событие которых в этом проходе не доставлено
+	blocked := make(map[string]bool)
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		key := string(event.AggregateType) + "/" + event.AggregateID
+		if blocked[key] {
+			continue
+		}
+
+		if err := r.sink.Deliver(ctx, *event); err != nil {
+			blocked[key] = true
+			stats.Failed++
+			log.Printf("Failed to deliver outbox event %d (%s %s), attempt %d: %v", event.ID, event.Type, key, event.Attempts+1, err)
+			if err := r.store.MarkFailed(ctx, event.ID, now.Add(backoff(event.Attempts)), err.Error()); err != nil {
+				return stats, err
+			}
+			continue
+		}
+
+		if err := r.store.MarkDelivered(ctx, event.ID, now); err != nil {
+			return stats, err
+		}
+		stats.Delivered++
+	}
+
+	if r.settings.Retention > 0 && now.Sub(r.lastPurge) >= purgeInterval {
+		purged, err := r.store.DeleteDelivered(ctx, now.Add(-r.settings.Retention))
+		if err != nil {
+			return stats, err
+		}
+		r.lastPurge = now
+		stats.Purged = purged
+	}
+
+	return stats, nil
+}
+
+// backoff возвращает паузу перед следующей попыткой после attempts прошлых неудач
+func backoff(attempts int) time.Duration {
+	delay := minBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,221 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+type storedEvent struct {
+	event       domain.DomainEvent
+	nextAttempt time.Time
+	deliveredAt *time.Time
+	lastError   string
+}
+
+// memoryStore повторяет выборку OutboxRepository.FetchPending
+type memoryStore struct {
+	events    []*storedEvent
+	purged    []time.Time
+	markErr   error
+	fetchSize int
+}
+
+func (s *memoryStore) add(aggregateID string, eventType domain.DomainEventType) {
+	s.events = append(s.events, &storedEvent{event: domain.DomainEvent{
+		ID:            int64(len(s.events) + 1),
+		Type:          eventType,
+		AggregateType: domain.AuditEntityPullRequest,
+		AggregateID:   aggregateID,
+	}})
+}
+
+func (s *memoryStore) FetchPending(_ context.Context, now time.Time, limit int) ([]*domain.DomainEvent, error) {
+	s.fetchSize = limit
+	delayed := make(map[string]bool)
+	var result []*domain.DomainEvent
+	for _, e := range s.events {
+		if e.deliveredAt != nil {
+			continue
+		}
+		if delayed[e.event.AggregateID] {
+			continue
+		}
+		if e.nextAttempt.After(now) {
+			delayed[e.event.AggregateID] = true
+			continue
+		}
+		event := e.event
+		result = append(result, &event)
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) MarkDelivered(_ context.Context, id int64, at time.Time) error {
+	if s.markErr != nil {
+		return s.markErr
+	}
+	s.events[id-1].deliveredAt = &at
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(_ context.Context, id int64, nextAttempt time.Time, reason string) error {
+	e := s.events[id-1]
+	e.event.Attempts++
+	e.nextAttempt = nextAttempt
+	e.lastError = reason
+	return nil
+}
+
+func (s *memoryStore) DeleteDelivered(_ context.Context, before time.Time) (int64, error) {
+	s.purged = append(s.purged, before)
+	return 0, nil
+}
+
+// recordingSink отказывает в доставке событий из fail
+type recordingSink struct {
+	delivered []int64
+	fail      map[int64]bool
+}
+
+func (s *recordingSink) Deliver(_ context.Context, event domain.DomainEvent) error {
+	if s.fail[event.ID] {
+		return errors.New("broker unavailable")
+	}
+	s.delivered = append(s.delivered, event.ID)
+	return nil
+}
+
+func TestRelay_DeliversInOrder(t *testing.T) {
+	store := &memoryStore{}
+	store.add("pr_1", domain.DomainEventPRCreated)
+	store.add("pr_2", domain.DomainEventPRCreated)
+	store.add("pr_1", domain.DomainEventPRMerged)
+	sink := &recordingSink{}
+
+	relay := NewRelay(store, sink, Settings{BatchSize: 50})
+	stats, err := relay.RelayOnce(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("RelayOnce() error = %v", err)
+	}
+	if stats != (Stats{Delivered: 3}) {
+		t.Errorf("Stats = %+v, want 3 delivered", stats)
+	}
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(sink.delivered, want) {
+		t.Errorf("Delivered = %v, want %v", sink.delivered, want)
+	}
+	if store.fetchSize != 50 {
+		t.Errorf("Batch size = %d, want 50", store.fetchSize)
+	}
+
+	stats, err = relay.RelayOnce(context.Background(), time.Now())
+	if err != nil || stats != (Stats{}) {
+		t.Errorf("Second RelayOnce() = %+v, %v, want nothing to deliver", stats, err)
+	}
+}
+
+func TestRelay_FailureBlocksAggregate(t *testing.T) {
+	store := &memoryStore{}
+	store.add("pr_1", domain.DomainEventPRCreated)
+	store.add("pr_2", domain.DomainEventPRCreated)
+	store.add("pr_1", domain.DomainEventReviewerReplaced)
+	store.add("pr_1", domain.DomainEventPRMerged)
+	sink := &recordingSink{fail: map[int64]bool{1: true}}
+
+	relay := NewRelay(store, sink, Settings{BatchSize: 100})
+	now := time.Now()
+
+	stats, err := relay.RelayOnce(context.Background(), now)
+	if err != nil {
+		t.Fatalf("RelayOnce() error = %v", err)
+	}
+	if stats != (Stats{Delivered: 1, Failed: 1}) {
+		t.Errorf("Stats = %+v, want 1 delivered and 1 failed", stats)
+	}
+	if want := []int64{2}; !reflect.DeepEqual(sink.delivered, want) {
+		t.Errorf("Delivered = %v, want %v: later pr_1 events must wait", sink.delivered, want)
+	}
+	failed := store.events[0]
+	if failed.event.Attempts != 1 || failed.lastError != "broker unavailable" || !failed.nextAttempt.Equal(now.Add(minBackoff)) {
+		t.Errorf("Failed event = %+v", failed)
+	}
+
+	// до повторной попытки pr_1 не доставляется
+	if _, err := relay.RelayOnce(context.Background(), now.Add(minBackoff/2)); err != nil {
+		t.Fatalf("RelayOnce() error = %v", err)
+	}
+	if len(sink.delivered) != 1 {
+		t.Errorf("Delivered before backoff = %v", sink.delivered)
+	}
+
+	sink.fail = nil
+	stats, err = relay.RelayOnce(context.Background(), now.Add(minBackoff))
+	if err != nil {
+		t.Fatalf("RelayOnce() error = %v", err)
+	}
+	if stats.Delivered != 3 {
+		t.Errorf("Stats = %+v, want 3 delivered after retry", stats)
+	}
+	if want := []int64{2, 1, 3, 4}; !reflect.DeepEqual(sink.delivered, want) {
+		t.Errorf("Delivered = %v, want %v", sink.delivered, want)
+	}
+}
+
+func TestRelay_StoreErrorStopsPass(t *testing.T) {
+	store := &memoryStore{markErr: errors.New("connection reset")}
+	store.add("pr_1", domain.DomainEventPRCreated)
+	store.add("pr_1", domain.DomainEventPRMerged)
+	sink := &recordingSink{}
+
+	_, err := NewRelay(store, sink, Settings{BatchSize: 100}).RelayOnce(context.Background(), time.Now())
+	if err == nil {
+		t.Fatal("RelayOnce() error = nil, want store error")
+	}
+	// событие уже ушло, но не отмечено: его доставят повторно, а следующее ждёт
+	if want := []int64{1}; !reflect.DeepEqual(sink.delivered, want) {
+		t.Errorf("Delivered = %v, want %v", sink.delivered, want)
+	}
+}
+
+func TestRelay_PurgesDeliveredEvents(t *testing.T) {
+	store := &memoryStore{}
+	relay := NewRelay(store, &recordingSink{}, Settings{BatchSize: 10, Retention: 24 * time.Hour})
+	now := time.Now()
+
+	for _, at := range []time.Time{now, now.Add(time.Minute), now.Add(purgeInterval)} {
+		if _, err := relay.RelayOnce(context.Background(), at); err != nil {
+			t.Fatalf("RelayOnce() error = %v", err)
+		}
+	}
+
+	want := []time.Time{now.Add(-24 * time.Hour), now.Add(purgeInterval - 24*time.Hour)}
+	if !reflect.DeepEqual(store.purged, want) {
+		t.Errorf("Purged before = %v, want %v", store.purged, want)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{5, 32 * time.Second},
+		{8, 256 * time.Second},
+		{9, maxBackoff},
+		{100, maxBackoff},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package publish
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaConfig struct {
+	Brokers []string
+	Timeout time.Duration
+}
+
+// KafkaPublisher пишет сообщения в Kafka; партиция выбирается хэшем ключа, поэтому события одного PR идут по порядку
+type KafkaPublisher struct {
+	writer  *kafka.Writer
+	timeout time.Duration
+}
+
+func NewKafkaPublisher(cfg KafkaConfig) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(cfg.Brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// relay отправляет события по одному и ждёт подтверждения: копить пакет незачем
+			BatchSize:    1,
+			MaxAttempts:  3,
+			WriteTimeout: cfg.Timeout,
+		},
+		timeout: cfg.Timeout,
+	}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, msg Message) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	return p.writer.WriteMessages(ctx, kafkaMessage(msg))
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+func kafkaMessage(msg Message) kafka.Message {
+	keys := make([]string, 0, len(msg.Headers))
+	for key := range msg.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	headers := make([]kafka.Header, 0, len(keys))
+	for _, key := range keys {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(msg.Headers[key])})
+	}
+
+	return kafka.Message{
+		Topic:   msg.Topic,
+		Key:     []byte(msg.Key),
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,23 @@
+package publish
+
+import "testing"
+
+func TestKafkaMessage(t *testing.T) {
+	msg := testMessage(t, 0)
+
+	got := kafkaMessage(msg)
+	if got.Topic != "review.events" || string(got.Key) != "pr-1001" || string(got.Value) != string(msg.Value) {
+		t.Errorf("kafkaMessage() = topic %q, key %q", got.Topic, got.Key)
+	}
+	if len(got.Headers) != len(msg.Headers) {
+		t.Fatalf("Headers count = %d, want %d", len(got.Headers), len(msg.Headers))
+	}
+	for i, header := range got.Headers {
+		if i > 0 && got.Headers[i-1].Key >= header.Key {
+			t.Errorf("Headers are not sorted: %q before %q", got.Headers[i-1].Key, header.Key)
+		}
+		if string(header.Value) != msg.Headers[header.Key] {
+			t.Errorf("Header %s = %q, want %q", header.Key, header.Value, msg.Headers[header.Key])
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type NATSConfig struct {
+	URL string
+	// Partitions - число партиций топика: сообщение уходит в subject <topic>.<партиция>, партиция вычисляется по ключу
+	Partitions int
+	// JetStream - ждать подтверждения записи в поток JetStream; без него достаточно того, что сервер принял сообщение
+	JetStream bool
+	Timeout   time.Duration
+}
+
+// NATSPublisher публикует сообщения в NATS. Поток JetStream должен покрывать subject'ы <topic>.*
+type NATSPublisher struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+	cfg  NATSConfig
+}
+
+func NewNATSPublisher(cfg NATSConfig) (*NATSPublisher, error) {
+	conn, err := nats.Connect(cfg.URL,
+		nats.Name("review-service"),
+		nats.Timeout(cfg.Timeout),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	p := &NATSPublisher{conn: conn, cfg: cfg}
+	if cfg.JetStream {
+		if p.js, err = jetstream.New(conn); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	m := nats.NewMsg(Subject(msg.Topic, msg.Key, p.cfg.Partitions))
+	m.Data = msg.Value
+	for key, value := range msg.Headers {
+		m.Header.Set(key, value)
+	}
+
+	if p.js != nil {
+		// JetStream отбрасывает повтор с тем же id в пределах окна дедупликации потока
+		m.Header.Set(jetstream.MsgIDHeader, msg.Headers[HeaderEventID])
+		_, err := p.js.PublishMsg(ctx, m)
+		return err
+	}
+
+	if err := p.conn.PublishMsg(m); err != nil {
+		return err
+	}
+	// без JetStream подтверждения нет: flush хотя бы гарантирует, что сервер получил сообщение
+	return p.conn.FlushWithContext(ctx)
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// Subject возвращает subject партиции для ключа: id PR не годится в subject, в нём бывают точки и пробелы
+func Subject(topic, key string, partitions int) string {
+	if partitions <= 1 {
+		return topic + ".0"
+	}
+	return fmt.Sprintf("%s.%d", topic, Partition(key, partitions))
+}
+
+// Partition - номер партиции ключа, FNV-1a по модулю числа партиций
+func Partition(key string, partitions int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(partitions))
+}
@@ -0,0 +1,156 @@
+package publish
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func startNATSServer(t *testing.T, withJetStream bool) *server.Server {
+	t.Helper()
+	opts := &server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		NoLog:     true,
+		NoSigs:    true,
+		JetStream: withJetStream,
+	}
+	if withJetStream {
+		opts.StoreDir = t.TempDir()
+	}
+
+	srv, err := server.NewServer(opts)
+	if err != nil {
+		t.Fatalf("Failed to create NATS server: %v", err)
+	}
+	go srv.Start()
+	if !srv.ReadyForConnections(5 * time.Second) {
+		t.Fatal("NATS server is not ready")
+	}
+	t.Cleanup(srv.Shutdown)
+	return srv
+}
+
+func newTestNATSPublisher(t *testing.T, srv *server.Server, withJetStream bool) *NATSPublisher {
+	t.Helper()
+	publisher, err := NewNATSPublisher(NATSConfig{URL: srv.ClientURL(), Partitions: 4, JetStream: withJetStream, Timeout: 2 * time.Second})
+	if err != nil {
+		t.Fatalf("NewNATSPublisher() error = %v", err)
+	}
+	t.Cleanup(func() { publisher.Close() })
+	return publisher
+}
+
+func testMessage(t *testing.T, index int) Message {
+	t.Helper()
+	msg, err := Encode(sampleEvents(t)[index], Topics{Default: "review.events"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	return msg
+}
+
+func TestNATSPublisher_Core(t *testing.T) {
+	srv := startNATSServer(t, false)
+	publisher := newTestNATSPublisher(t, srv, false)
+
+	conn, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatalf("Failed to connect subscriber: %v", err)
+	}
+	defer conn.Close()
+	sub, err := conn.SubscribeSync("review.events.*")
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	conn.Flush()
+
+	ctx := context.Background()
+	// три события одного PR должны прийти в одну партицию и по порядку
+	for i := 0; i < 3; i++ {
+		if err := publisher.Publish(ctx, testMessage(t, i)); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	wantSubject := Subject("review.events", "pr-1001", 4)
+	for i, wantType := range []string{"PRCreated", "ReviewerReplaced", "PRMerged"} {
+		got, err := sub.NextMsg(2 * time.Second)
+		if err != nil {
+			t.Fatalf("NextMsg(%d) error = %v", i, err)
+		}
+		if got.Subject != wantSubject {
+			t.Errorf("Subject = %q, want %q", got.Subject, wantSubject)
+		}
+		if got.Header.Get(HeaderEventType) != wantType {
+			t.Errorf("Message %d type = %q, want %q", i, got.Header.Get(HeaderEventType), wantType)
+		}
+	}
+}
+
+func TestNATSPublisher_JetStream(t *testing.T) {
+	srv := startNATSServer(t, true)
+	publisher := newTestNATSPublisher(t, srv, true)
+	ctx := context.Background()
+
+	msg := testMessage(t, 0)
+	if err := publisher.Publish(ctx, msg); err == nil {
+		t.Fatal("Publish() without a stream should fail: nobody acknowledged the message")
+	}
+
+	stream, err := publisher.js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:       "REVIEW_EVENTS",
+		Subjects:   []string{"review.events.*"},
+		Duplicates: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("CreateStream() error = %v", err)
+	}
+
+	// повторная доставка того же события из outbox не создаёт второе сообщение
+	for i := 0; i < 2; i++ {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	if err := publisher.Publish(ctx, testMessage(t, 3)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatalf("Stream info error = %v", err)
+	}
+	if info.State.Msgs != 2 {
+		t.Errorf("Stream has %d messages, want 2 after deduplication", info.State.Msgs)
+	}
+
+	stored, err := stream.GetLastMsgForSubject(ctx, Subject("review.events", "u2", 4))
+	if err != nil {
+		t.Fatalf("GetLastMsgForSubject() error = %v", err)
+	}
+	if stored.Header.Get(HeaderEventType) != "UserActivityChanged" || stored.Header.Get(HeaderSchemaVersion) != "1" {
+		t.Errorf("Stored headers = %v", stored.Header)
+	}
+}
+
+func TestSubject(t *testing.T) {
+	if got := Subject("review.events", "pr 1.2", 1); got != "review.events.0" {
+		t.Errorf("Subject() with one partition = %q", got)
+	}
+	seen := make(map[string]bool)
+	for _, key := range []string{"pr-1", "pr-2", "pr-3", "pr-4", "pr-5", "pr-6", "pr-7", "pr-8"} {
+		subject := Subject("review.events", key, 4)
+		if subject != Subject("review.events", key, 4) {
+			t.Errorf("Subject() for %s is not stable", key)
+		}
+		seen[subject] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("Keys are not spread over partitions: %v", seen)
+	}
+}
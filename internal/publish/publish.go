@@ -0,0 +1,127 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// SchemaVersions - текущая версия схемы каждого типа события, схемы лежат в api/events/<type>.v<N>.json.
+// Новое необязательное поле версию не меняет; удаление или смена смысла поля - новая версия и новый файл схемы
+var SchemaVersions = map[domain.DomainEventType]int{
+	domain.DomainEventPRCreated:           1,
+	domain.DomainEventReviewerReplaced:    1,
+	domain.DomainEventPRMerged:            1,
+	domain.DomainEventUserActivityChanged: 1,
+	domain.DomainEventTeamCreated:         1,
+}
+
+// Заголовки сообщения, одинаковые для всех шин
+const (
+	HeaderEventID       = "event-id"
+	HeaderEventType     = "event-type"
+	HeaderSchemaVersion = "schema-version"
+	HeaderContentType   = "content-type"
+)
+
+// Message - событие, готовое к отправке в шину
+type Message struct {
+	Topic string
+	// Key - ключ партиционирования: id PR для событий PR, id агрегата для остальных.
+	// Сообщения с одним ключом попадают в одну партицию и читаются по порядку
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Publisher отправляет сообщение в шину. Publish возвращает nil только после того, как шина приняла сообщение
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// Noop отбрасывает сообщения; используется, пока шина не настроена
+type Noop struct{}
+
+func (Noop) Publish(context.Context, Message) error { return nil }
+func (Noop) Close() error                           { return nil }
+
+// Envelope - JSON сообщения; data - полезная нагрузка события в версии schema_version
+type Envelope struct {
+	ID            int64                  `json:"id"`
+	Type          domain.DomainEventType `json:"type"`
+	SchemaVersion int                    `json:"schema_version"`
+	AggregateType domain.AuditEntityType `json:"aggregate_type"`
+	AggregateID   string                 `json:"aggregate_id"`
+	OccurredAt    time.Time              `json:"occurred_at"`
+	Data          json.RawMessage        `json:"data"`
+}
+
+// Topics выбирает топик по типу события
+type Topics struct {
+	// Default - топик для событий без своего топика
+	Default string
+	ByType  map[domain.DomainEventType]string
+}
+
+func (t Topics) For(eventType domain.DomainEventType) string {
+	if topic, ok := t.ByType[eventType]; ok {
+		return topic
+	}
+	return t.Default
+}
+
+// Encode упаковывает событие outbox в сообщение шины
+func Encode(event domain.DomainEvent, topics Topics) (Message, error) {
+	version, ok := SchemaVersions[event.Type]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown event type %q", event.Type)
+	}
+
+	value, err := json.Marshal(Envelope{
+		ID:            event.ID,
+		Type:          event.Type,
+		SchemaVersion: version,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.CreatedAt.UTC(),
+		Data:          event.Payload,
+	})
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		Topic: topics.For(event.Type),
+		Key:   event.AggregateID,
+		Value: value,
+		Headers: map[string]string{
+			HeaderEventID:       strconv.FormatInt(event.ID, 10),
+			HeaderEventType:     string(event.Type),
+			HeaderSchemaVersion: strconv.Itoa(version),
+			HeaderContentType:   "application/json",
+		},
+	}, nil
+}
+
+// Sink публикует события outbox через Publisher
+type Sink struct {
+	publisher Publisher
+	topics    Topics
+}
+
+func NewSink(publisher Publisher, topics Topics) *Sink {
+	return &Sink{publisher: publisher, topics: topics}
+}
+
+func (s *Sink) Deliver(ctx context.Context, event domain.DomainEvent) error {
+	msg, err := Encode(event, s.topics)
+	if err != nil {
+		return err
+	}
+	return s.publisher.Publish(ctx, msg)
+}
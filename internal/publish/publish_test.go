@@ -0,0 +1,171 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"avito-test-task/internal/domain"
+)
+
+// sampleEvents - по событию каждого типа в том виде, в каком их пишут репозитории
+func sampleEvents(t *testing.T) []domain.DomainEvent {
+	t.Helper()
+	createdAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	payloads := []struct {
+		eventType     domain.DomainEventType
+		aggregateType domain.AuditEntityType
+		aggregateID   string
+		payload       any
+	}{
+		{domain.DomainEventPRCreated, domain.AuditEntityPullRequest, "pr-1001", domain.PRCreatedPayload{
+			PullRequestID: "pr-1001", Title: "Add search", AuthorID: "u1", Reviewers: []string{}, RequiredReviewers: 2, CreatedAt: createdAt,
+		}},
+		{domain.DomainEventReviewerReplaced, domain.AuditEntityPullRequest, "pr-1001", domain.ReviewerReplacedPayload{
+			PullRequestID: "pr-1001", OldReviewerID: "u2", NewReviewerID: "u3",
+		}},
+		{domain.DomainEventPRMerged, domain.AuditEntityPullRequest, "pr-1001", domain.PRMergedPayload{
+			PullRequestID: "pr-1001", MergedAt: createdAt.Add(time.Hour),
+		}},
+		{domain.DomainEventUserActivityChanged, domain.AuditEntityUser, "u2", domain.UserActivityChangedPayload{
+			UserID: "u2", IsActive: false,
+		}},
+		{domain.DomainEventTeamCreated, domain.AuditEntityTeam, "payments", domain.TeamCreatedPayload{
+			TeamName: "payments", ReviewersCount: 2,
+		}},
+	}
+
+	events := make([]domain.DomainEvent, 0, len(payloads))
+	for i, p := range payloads {
+		data, err := json.Marshal(p.payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal payload: %v", err)
+		}
+		events = append(events, domain.DomainEvent{
+			ID:            int64(i + 1),
+			Type:          p.eventType,
+			AggregateType: p.aggregateType,
+			AggregateID:   p.aggregateID,
+			Payload:       data,
+			CreatedAt:     createdAt,
+		})
+	}
+	return events
+}
+
+func loadSchema(t *testing.T, eventType domain.DomainEventType, version int) *openapi3.Schema {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "api", "events", fmt.Sprintf("%s.v%d.json", eventType, version)))
+	if err != nil {
+		t.Fatalf("Schema for %s v%d is missing: %v", eventType, version, err)
+	}
+	var schema openapi3.Schema
+	if err := schema.UnmarshalJSON(data); err != nil {
+		t.Fatalf("Failed to parse schema for %s: %v", eventType, err)
+	}
+	return &schema
+}
+
+func TestEncode_MatchesSchemas(t *testing.T) {
+	events := sampleEvents(t)
+	if len(events) != len(SchemaVersions) {
+		t.Fatalf("Sample events cover %d types, SchemaVersions has %d", len(events), len(SchemaVersions))
+	}
+
+	for _, event := range events {
+		t.Run(string(event.Type), func(t *testing.T) {
+			msg, err := Encode(event, Topics{Default: "review.events"})
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			var envelope any
+			if err := json.Unmarshal(msg.Value, &envelope); err != nil {
+				t.Fatalf("Message is not JSON: %v", err)
+			}
+			schema := loadSchema(t, event.Type, SchemaVersions[event.Type])
+			if err := schema.VisitJSON(envelope); err != nil {
+				t.Errorf("Envelope does not match schema: %v\n%s", err, msg.Value)
+			}
+		})
+	}
+}
+
+func TestEncode_Message(t *testing.T) {
+	event := sampleEvents(t)[2]
+	topics := Topics{
+		Default: "review.events",
+		ByType:  map[domain.DomainEventType]string{domain.DomainEventPRMerged: "review.merged"},
+	}
+
+	msg, err := Encode(event, topics)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if msg.Topic != "review.merged" || msg.Key != "pr-1001" {
+		t.Errorf("Topic = %q, Key = %q", msg.Topic, msg.Key)
+	}
+	wantHeaders := map[string]string{
+		HeaderEventID:       "3",
+		HeaderEventType:     "PRMerged",
+		HeaderSchemaVersion: "1",
+		HeaderContentType:   "application/json",
+	}
+	for key, want := range wantHeaders {
+		if got := msg.Headers[key]; got != want {
+			t.Errorf("Header %s = %q, want %q", key, got, want)
+		}
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(msg.Value, &envelope); err != nil {
+		t.Fatalf("Failed to decode envelope: %v", err)
+	}
+	if envelope.ID != 3 || envelope.SchemaVersion != 1 || !envelope.OccurredAt.Equal(event.CreatedAt) {
+		t.Errorf("Envelope = %+v", envelope)
+	}
+
+	if _, err := Encode(domain.DomainEvent{Type: "Unknown"}, topics); err == nil {
+		t.Error("Encode() of unknown event type should fail")
+	}
+}
+
+type recordingPublisher struct {
+	messages []Message
+	err      error
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, msg Message) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error { return nil }
+
+func TestSink_Deliver(t *testing.T) {
+	publisher := &recordingPublisher{}
+	sink := NewSink(publisher, Topics{Default: "review.events"})
+
+	event := sampleEvents(t)[0]
+	if err := sink.Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if len(publisher.messages) != 1 || publisher.messages[0].Topic != "review.events" {
+		t.Errorf("Published = %+v", publisher.messages)
+	}
+
+	publisher.err = errors.New("broker down")
+	if err := sink.Deliver(context.Background(), event); !errors.Is(err, publisher.err) {
+		t.Errorf("Deliver() error = %v, want publisher error", err)
+	}
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"avito-test-task/internal/ratelimit"
+)
+
+// Limiter хранит bucket в таблице rate_limit_buckets, поэтому квота общая для всех реплик.
+// Время берётся из базы, чтобы расхождение часов реплик не влияло на пополнение
+type Limiter struct {
+	db *sql.DB
+}
+
+func NewLimiter(db *sql.DB) *Limiter {
+	return &Limiter{db: db}
+}
+
+func (l *Limiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+        VALUES ($1, $2, clock_timestamp())
+        ON CONFLICT (key) DO NOTHING
+    `, key, limit.Burst)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	var (
+		tokens  float64
+		updated time.Time
+		now     time.Time
+	)
+	// NOW() - время начала транзакции, после ожидания FOR UPDATE оно может быть раньше updated_at,
+	// записанного конкурентом; время не идёт назад, иначе пополнение выдало бы лишние токены
+	err = tx.QueryRowContext(ctx, `
+        SELECT tokens, updated_at, GREATEST(updated_at, clock_timestamp())
+        FROM rate_limit_buckets
+        WHERE key = $1
+        FOR UPDATE
+    `, key).Scan(&tokens, &updated, &now)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	tokens, result := ratelimit.Take(tokens, now.Sub(updated), limit)
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1",
+		key, tokens, now,
+	)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ratelimit.Result{}, err
+	}
+	return result, nil
+}
+
+// DeleteIdle удаляет bucket клиентов, которые не обращались к сервису с before
+func (l *Limiter) DeleteIdle(ctx context.Context, before time.Time) (int64, error) {
+	result, err := l.db.ExecContext(ctx, "DELETE FROM rate_limit_buckets WHERE updated_at < $1", before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"avito-test-task/internal/ratelimit"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+var testDB *sql.DB
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:15-alpine",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_DB":       "test_review_service",
+			"POSTGRES_USER":     "test_user",
+			"POSTGRES_PASSWORD": "test_password",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("database system is ready to accept connections"),
+			wait.ForListeningPort("5432/tcp"),
+		).WithStartupTimeout(30 * time.Second),
+	}
+
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start container: %s", err)
+	}
+	defer postgresContainer.Terminate(ctx)
+
+	host, err := postgresContainer.Host(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get host: %s", err)
+	}
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	if err != nil {
+		log.Fatalf("Failed to get port: %s", err)
+	}
+
+	connStr := fmt.Sprintf("host=%s port=%s user=test_user password=test_password dbname=test_review_service sslmode=disable",
+		host, port.Port())
+
+	var db *sql.DB
+	maxRetries := 5
+	for i := 0; i < maxRetries; i++ {
+		db, err = sql.Open("postgres", connStr)
+		if err != nil {
+			log.Printf("Failed to open database (attempt %d): %s", i+1, err)
+			time.Sleep(2 * time.Second)
+			continue
+		}
+
+		err = db.Ping()
+		if err != nil {
+			log.Printf("Failed to ping database (attempt %d): %s", i+1, err)
+			db.Close()
+			time.Sleep(2 * time.Second)
+			continue
+		}
+		break
+	}
+
+	if err != nil {
+		log.Fatalf("Failed to connect to database after %d attempts: %s", maxRetries, err)
+	}
+
+	testDB = db
+
+	if err := setupTestDB(testDB); err != nil {
+		log.Fatalf("Failed to setup test database: %s", err)
+	}
+
+	code := m.Run()
+
+	testDB.Close()
+	os.Exit(code)
+}
+
+func setupTestDB(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+		key VARCHAR(512) PRIMARY KEY,
+		tokens DOUBLE PRECISION NOT NULL,
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	return err
+}
+
+func cleanAndSetup(t *testing.T) {
+	t.Helper()
+	if _, err := testDB.Exec("TRUNCATE TABLE rate_limit_buckets"); err != nil {
+		t.Fatalf("Failed to cleanup DB: %v", err)
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	cleanAndSetup(t)
+	limiter := NewLimiter(testDB)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		result, err := limiter.Allow(ctx, "ip:10.0.0.1|*", limit)
+		if err != nil {
+			t.Fatalf("Allow() error = %v", err)
+		}
+		if !result.Allowed {
+			t.Fatalf("Request %d should be allowed within burst", i+1)
+		}
+	}
+
+	result, err := limiter.Allow(ctx, "ip:10.0.0.1|*", limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if result.Allowed {
+		t.Error("Request over burst should be rejected")
+	}
+	if result.RetryAfter <= 0 {
+		t.Errorf("RetryAfter should be positive, got %v", result.RetryAfter)
+	}
+
+	result, err = limiter.Allow(ctx, "ip:10.0.0.2|*", limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if !result.Allowed {
+		t.Error("Other client should have its own bucket")
+	}
+}
+
+func TestLimiter_SharedQuota(t *testing.T) {
+	cleanAndSetup(t)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 5}
+
+	// два лимитера с одной базой ведут себя как две реплики сервиса
+	replicas := []*Limiter{NewLimiter(testDB), NewLimiter(testDB)}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(limiter *Limiter) {
+			defer wg.Done()
+			result, err := limiter.Allow(ctx, "key:ci", limit)
+			if err != nil {
+				t.Errorf("Allow() error = %v", err)
+				return
+			}
+			if result.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}(replicas[i%len(replicas)])
+	}
+	wg.Wait()
+
+	if allowed != limit.Burst {
+		t.Errorf("Allowed %d requests across replicas, want %d", allowed, limit.Burst)
+	}
+}
+
+func TestLimiter_ClockDoesNotGoBack(t *testing.T) {
+	cleanAndSetup(t)
+	limiter := NewLimiter(testDB)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+
+	// конкурент зафиксировал bucket с временем позже начала нашей транзакции
+	testDB.Exec(`
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+		VALUES ('key:clock', 0, clock_timestamp() + INTERVAL '1 minute')
+	`)
+	var before time.Time
+	testDB.QueryRow("SELECT updated_at FROM rate_limit_buckets WHERE key = 'key:clock'").Scan(&before)
+
+	result, err := limiter.Allow(ctx, "key:clock", limit)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if result.Allowed {
+		t.Error("Empty bucket should not be refilled by a clock going back")
+	}
+
+	var after time.Time
+	testDB.QueryRow("SELECT updated_at FROM rate_limit_buckets WHERE key = 'key:clock'").Scan(&after)
+	if after.Before(before) {
+		t.Errorf("updated_at moved back from %v to %v", before, after)
+	}
+}
+
+func TestLimiter_DeleteIdle(t *testing.T) {
+	cleanAndSetup(t)
+	limiter := NewLimiter(testDB)
+	ctx := context.Background()
+
+	if _, err := limiter.Allow(ctx, "ip:10.0.0.1|*", ratelimit.Limit{Rate: 1, Burst: 1}); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	testDB.Exec("UPDATE rate_limit_buckets SET updated_at = NOW() - INTERVAL '2 hours'")
+	if _, err := limiter.Allow(ctx, "ip:10.0.0.2|*", ratelimit.Limit{Rate: 1, Burst: 1}); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+
+	deleted, err := limiter.DeleteIdle(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteIdle() error = %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Deleted %d buckets, want 1", deleted)
+	}
+}
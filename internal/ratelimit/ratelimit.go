@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultScope - область bucket для маршрутов без собственного лимита
+const DefaultScope = "*"
+
+// Limit - параметры token bucket: Rate токенов в секунду, в запасе не больше Burst
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result - решение лимитера по одному запросу
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter - через сколько появится следующий токен, если запрос отклонён
+	RetryAfter time.Duration
+	// Reset - через сколько bucket заполнится полностью
+	Reset time.Duration
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Rules - лимит по умолчанию и переопределения для отдельных маршрутов
+type Rules struct {
+	Default Limit
+	Routes  map[string]Limit
+}
+
+// For возвращает лимит маршрута и область bucket: у маршрута со своим лимитом отдельный bucket,
+// остальные маршруты клиента делят общий
+func (r Rules) For(route string) (Limit, string) {
+	if limit, ok := r.Routes[route]; ok {
+		return limit, route
+	}
+	return r.Default, DefaultScope
+}
+
+// Take списывает токен из bucket, в котором было tokens и прошло elapsed с последнего обновления
+func Take(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	burst := float64(limit.Burst)
+	if elapsed > 0 {
+		tokens = math.Min(burst, tokens+elapsed.Seconds()*limit.Rate)
+	}
+
+	var result Result
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = durationFor(1-tokens, limit.Rate)
+	}
+
+	result.Remaining = int(math.Floor(tokens))
+	result.Reset = durationFor(burst-tokens, limit.Rate)
+	return tokens, result
+}
+
+func durationFor(tokens, rate float64) time.Duration {
+	if tokens <= 0 || rate <= 0 {
+		return 0
+	}
+	return time.Duration(tokens / rate * float64(time.Second))
+}
+
+// Valid сообщает, что лимит пропускает хотя бы один запрос и пополняется
+func (l Limit) Valid() bool {
+	return l.Rate > 0 && l.Burst >= 1
+}
+
+// String записывает лимит в формате ParseLimit
+func (l Limit) String() string {
+	return strconv.FormatFloat(l.Rate, 'f', -1, 64) + ":" + strconv.Itoa(l.Burst)
+}
+
+func (l Limit) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Limit) UnmarshalText(text []byte) error {
+	parsed, err := ParseLimit(string(text))
+	if err != nil {
+		return err
+	}
+	*l = parsed
+	return nil
+}
+
+// ParseLimit разбирает лимит в формате "rate:burst", например "0.5:2" - один запрос в 2 секунды, не больше 2 подряд
+func ParseLimit(value string) (Limit, error) {
+	rateStr, burstStr, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok {
+		return Limit{}, fmt.Errorf("limit %q: expected rate:burst", value)
+	}
+
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate <= 0 {
+		return Limit{}, fmt.Errorf("limit %q: rate must be a positive number", value)
+	}
+	burst, err := strconv.Atoi(burstStr)
+	if err != nil || burst < 1 {
+		return Limit{}, fmt.Errorf("limit %q: burst must be a positive integer", value)
+	}
+
+	return Limit{Rate: rate, Burst: burst}, nil
+}
+
+// ParseRoutes разбирает переопределения в формате "/pullRequest/create=1:5,/team/add=0.2:2"
+func ParseRoutes(value string) (map[string]Limit, error) {
+	routes := make(map[string]Limit)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		route, limitStr, ok := strings.Cut(item, "=")
+		if !ok || !strings.HasPrefix(route, "/") {
+			return nil, fmt.Errorf("route limit %q: expected /path=rate:burst", item)
+		}
+		limit, err := ParseLimit(limitStr)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", route, err)
+		}
+		routes[route] = limit
+	}
+	return routes, nil
+}
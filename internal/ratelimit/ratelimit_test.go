@@ -0,0 +1,155 @@
+package ratelimit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := NewMemoryLimiter(clock.Now)
+	limit := Limit{Rate: 1, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		result, err := limiter.Allow(ctx, "client", limit)
+		if err != nil {
+			t.Fatalf("Allow() error = %v", err)
+		}
+		if !result.Allowed {
+			t.Fatalf("Request %d should be allowed within burst", i+1)
+		}
+		if result.Remaining != 2-i {
+			t.Errorf("Remaining = %d, want %d", result.Remaining, 2-i)
+		}
+	}
+
+	result, _ := limiter.Allow(ctx, "client", limit)
+	if result.Allowed {
+		t.Fatal("Request over burst should be rejected")
+	}
+	if result.RetryAfter != time.Second {
+		t.Errorf("RetryAfter = %v, want 1s", result.RetryAfter)
+	}
+	if result.Reset != 3*time.Second {
+		t.Errorf("Reset = %v, want 3s", result.Reset)
+	}
+
+	other, _ := limiter.Allow(ctx, "other_client", limit)
+	if !other.Allowed {
+		t.Error("Other client should have its own bucket")
+	}
+
+	clock.Advance(1500 * time.Millisecond)
+	result, _ = limiter.Allow(ctx, "client", limit)
+	if !result.Allowed {
+		t.Fatal("Request should be allowed after refill")
+	}
+	if result.Remaining != 0 {
+		t.Errorf("Remaining = %d, want 0", result.Remaining)
+	}
+
+	clock.Advance(time.Hour)
+	result, _ = limiter.Allow(ctx, "client", limit)
+	if !result.Allowed || result.Remaining != limit.Burst-1 {
+		t.Errorf("Bucket should refill up to burst, got %+v", result)
+	}
+}
+
+func TestMemoryLimiter_Sweep(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := NewMemoryLimiter(clock.Now)
+
+	limiter.Allow(ctx, "idle", Limit{Rate: 1, Burst: 2})
+	clock.Advance(2 * sweepInterval)
+	limiter.Allow(ctx, "active", Limit{Rate: 1, Burst: 2})
+
+	if _, ok := limiter.buckets["idle"]; ok {
+		t.Error("Refilled bucket of idle client should be swept")
+	}
+	if _, ok := limiter.buckets["active"]; !ok {
+		t.Error("Bucket of active client should be kept")
+	}
+}
+
+func TestRules_For(t *testing.T) {
+	rules := Rules{
+		Default: Limit{Rate: 10, Burst: 20},
+		Routes:  map[string]Limit{"/pullRequest/create": {Rate: 1, Burst: 5}},
+	}
+
+	limit, scope := rules.For("/pullRequest/create")
+	if limit != (Limit{Rate: 1, Burst: 5}) || scope != "/pullRequest/create" {
+		t.Errorf("For(create) = %+v %s", limit, scope)
+	}
+
+	limit, scope = rules.For("/team/get")
+	if limit != rules.Default || scope != DefaultScope {
+		t.Errorf("For(team/get) = %+v %s", limit, scope)
+	}
+}
+
+func TestParseRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]Limit
+		wantErr bool
+	}{
+		{
+			name:  "several routes",
+			value: "/pullRequest/create=1:5, /team/add=0.2:2",
+			want: map[string]Limit{
+				"/pullRequest/create": {Rate: 1, Burst: 5},
+				"/team/add":           {Rate: 0.2, Burst: 2},
+			},
+		},
+		{
+			name:  "empty value",
+			value: "",
+			want:  map[string]Limit{},
+		},
+		{
+			name:    "missing burst",
+			value:   "/team/add=5",
+			wantErr: true,
+		},
+		{
+			name:    "zero rate",
+			value:   "/team/add=0:5",
+			wantErr: true,
+		},
+		{
+			name:    "route without slash",
+			value:   "team/add=1:5",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRoutes(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRoutes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"avito-test-task/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+// lockClass - ключ транзакционной advisory-блокировки, под которой выдаются id журнала
+const lockClass = 0x61756474
+
+type AuditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Save записывает запись журнала отдельной транзакцией
+func (r *AuditRepository) Save(ctx context.Context, entry *domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := appendEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Append записывает записи журнала внутри транзакции изменения: откат изменения откатывает и журнал.
+// id выдаются под блокировкой до фиксации, поэтому записи становятся видны строго в порядке id и читатель
+// по курсору id (поток событий) не пропускает поздно зафиксированные. Блокировка держится до конца транзакции,
+// поэтому Append вызывается последним перед Commit
+func Append(ctx context.Context, tx *sql.Tx, entries ...domain.AuditEntry) error {
+	for i := range entries {
+		if err := appendEntry(ctx, tx, &entries[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendEntry(ctx context.Context, tx *sql.Tx, entry *domain.AuditEntry) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	oldValue, err := marshalValue(entry.OldValue)
+	if err != nil {
+		return err
+	}
+	newValue, err := marshalValue(entry.NewValue)
+	if err != nil {
+		return err
+	}
+
+	// повторный захват в той же транзакции не блокирует
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", lockClass); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO audit_log (entity_type, entity_id, action, actor, reason, old_value, new_value, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING id
+    `
+	return tx.QueryRowContext(ctx, query,
+		string(entry.EntityType),
+		entry.EntityID,
+		string(entry.Action),
+		entry.Actor,
+		string(entry.Reason),
+		oldValue,
+		newValue,
+		entry.CreatedAt,
+	).Scan(&entry.ID)
+}
+
+// Find возвращает записи журнала в хронологическом порядке
+func (r *AuditRepository) Find(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditEntry, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	addCondition := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.EntityType != "" {
+		addCondition("entity_type = $%d", string(filter.EntityType))
+	}
+	if filter.EntityID != "" {
+		addCondition("entity_id = $%d", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", string(filter.Action))
+	}
+	if filter.From != nil {
+		addCondition("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("created_at < $%d", *filter.To)
+	}
+
+	query := `
+        SELECT id, entity_type, entity_id, action, actor, reason, old_value, new_value, created_at
+        FROM audit_log
+    `
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at, id"
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanEntries(rows)
+}
+
+// FindAfterID возвращает до limit записей с id больше afterID и действиями из actions в порядке id
+func (r *AuditRepository) FindAfterID(ctx context.Context, afterID int64, actions []domain.AuditAction, limit int) ([]*domain.AuditEntry, error) {
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, string(action))
+	}
+
+	query := `
+        SELECT id, entity_type, entity_id, action, actor, reason, old_value, new_value, created_at
+        FROM audit_log
+        WHERE id > $1 AND action = ANY($2)
+        ORDER BY id
+        LIMIT $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, pq.Array(names), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanEntries(rows)
+}
+
+// LastID возвращает id последней записи журнала, 0 - журнал пуст
+func (r *AuditRepository) LastID(ctx context.Context) (int64, error) {
+	var id int64
+	err := r.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM audit_log").Scan(&id)
+	return id, err
+}
+
+func scanEntries(rows *sql.Rows) ([]*domain.AuditEntry, error) {
+	defer rows.Close()
+
+	var entries []*domain.AuditEntry
+	for rows.Next() {
+		var (
+			entry    domain.AuditEntry
+			oldValue []byte
+			newValue []byte
+		)
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.EntityType,
+			&entry.EntityID,
+			&entry.Action,
+			&entry.Actor,
+			&entry.Reason,
+			&oldValue,
+			&newValue,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if entry.OldValue, err = unmarshalValue(oldValue); err != nil {
+			return nil, err
+		}
+		if entry.NewValue, err = unmarshalValue(newValue); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// marshalValue возвращает nil для пустого значения, чтобы в колонку попал NULL
+func marshalValue(value map[string]any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func unmarshalValue(data []byte) (map[string]any, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var value map[string]any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
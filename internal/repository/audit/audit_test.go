@@ -0,0 +1,326 @@
+package audit
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// Run test: DB_HOST=localhost DB_PORT=5433 DB_USER=postgres DB_PASSWORD=password go test -v ./internal/repository/audit/...
+
+var testDB *sql.DB
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:15-alpine",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_DB":       "test_review_service",
+			"POSTGRES_USER":     "test_user",
+			"POSTGRES_PASSWORD": "test_password",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("database system is ready to accept connections"),
+			wait.ForListeningPort("5432/tcp"),
+		).WithStartupTimeout(30 * time.Second),
+	}
+
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start container: %s", err)
+	}
+	defer postgresContainer.Terminate(ctx)
+
+	host, err := postgresContainer.Host(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get host: %s", err)
+	}
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	if err != nil {
+		log.Fatalf("Failed to get port: %s", err)
+	}
+
+	connStr := fmt.Sprintf("host=%s port=%s user=test_user password=test_password dbname=test_review_service sslmode=disable",
+		host, port.Port())
+
+	var db *sql.DB
+	maxRetries := 5
+	for i := 0; i < maxRetries; i++ {
+		db, err = sql.Open("postgres", connStr)
+		if err != nil {
+			log.Printf("Failed to open database (attempt %d): %s", i+1, err)
+			time.Sleep(2 * time.Second)
+			continue
+		}
+
+		err = db.Ping()
+		if err != nil {
+			log.Printf("Failed to ping database (attempt %d): %s", i+1, err)
+			db.Close()
+			time.Sleep(2 * time.Second)
+			continue
+		}
+		break
+	}
+
+	if err != nil {
+		log.Fatalf("Failed to connect to database after %d attempts: %s", maxRetries, err)
+	}
+
+	testDB = db
+
+	if err := setupTestDB(testDB); err != nil {
+		log.Fatalf("Failed to setup test database: %s", err)
+	}
+
+	code := m.Run()
+
+	testDB.Close()
+	os.Exit(code)
+}
+
+func setupTestDB(db *sql.DB) error {
+	migrations := []string{
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id VARCHAR(255) NOT NULL,
+			action VARCHAR(50) NOT NULL,
+			actor VARCHAR(255) NOT NULL DEFAULT 'system',
+			reason VARCHAR(50) NOT NULL DEFAULT '',
+			old_value JSONB NULL,
+			new_value JSONB NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+	}
+
+	for _, migration := range migrations {
+		if _, err := db.Exec(migration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cleanupTestDB(db *sql.DB) error {
+	_, err := db.Exec(`TRUNCATE TABLE audit_log RESTART IDENTITY`)
+	return err
+}
+
+func TestAuditRepository_Save(t *testing.T) {
+	cleanupTestDB(testDB)
+	repo := NewAuditRepository(testDB)
+	ctx := context.Background()
+
+	entry := &domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   "pr_1",
+		Action:     domain.AuditActionReviewerReplaced,
+		Actor:      "lead_1",
+		Reason:     domain.AuditReasonReassign,
+		OldValue:   map[string]any{"reviewer_id": "user_2"},
+		NewValue:   map[string]any{"reviewer_id": "user_3"},
+	}
+
+	if err := repo.Save(ctx, entry); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if entry.ID == 0 {
+		t.Error("Save() should fill entry ID")
+	}
+	if entry.CreatedAt.IsZero() {
+		t.Error("Save() should fill CreatedAt")
+	}
+
+	entries, err := repo.Find(ctx, domain.AuditFilter{EntityID: "pr_1"})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+
+	got := entries[0]
+	if got.Actor != "lead_1" || got.Reason != domain.AuditReasonReassign {
+		t.Errorf("Unexpected actor/reason: %s/%s", got.Actor, got.Reason)
+	}
+	if got.OldValue["reviewer_id"] != "user_2" {
+		t.Errorf("OldValue reviewer_id = %v, want user_2", got.OldValue["reviewer_id"])
+	}
+	if got.NewValue["reviewer_id"] != "user_3" {
+		t.Errorf("NewValue reviewer_id = %v, want user_3", got.NewValue["reviewer_id"])
+	}
+}
+
+func TestAuditRepository_SaveWithoutValues(t *testing.T) {
+	cleanupTestDB(testDB)
+	repo := NewAuditRepository(testDB)
+	ctx := context.Background()
+
+	entry := &domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   "backend-team",
+		Action:     domain.AuditActionTeamCreated,
+		Actor:      "system",
+	}
+	if err := repo.Save(ctx, entry); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	entries, err := repo.Find(ctx, domain.AuditFilter{})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].OldValue != nil || entries[0].NewValue != nil {
+		t.Errorf("Expected nil values, got %v / %v", entries[0].OldValue, entries[0].NewValue)
+	}
+}
+
+func TestAuditRepository_Find(t *testing.T) {
+	cleanupTestDB(testDB)
+	repo := NewAuditRepository(testDB)
+	ctx := context.Background()
+
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	entries := []*domain.AuditEntry{
+		{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_1", Action: domain.AuditActionPRCreated, Actor: "user_1", CreatedAt: base},
+		{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_1", Action: domain.AuditActionReviewerAssigned, Actor: "user_1", Reason: domain.AuditReasonAutoAssign, CreatedAt: base},
+		{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_2", Action: domain.AuditActionPRCreated, Actor: "user_2", CreatedAt: base.Add(time.Hour)},
+		{EntityType: domain.AuditEntityUser, EntityID: "user_3", Action: domain.AuditActionUserActivityChanged, Actor: "admin", Reason: domain.AuditReasonDeactivation, CreatedAt: base.Add(2 * time.Hour)},
+		{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_1", Action: domain.AuditActionPRMerged, Actor: "user_1", CreatedAt: base.Add(3 * time.Hour)},
+	}
+	for _, entry := range entries {
+		if err := repo.Save(ctx, entry); err != nil {
+			t.Fatalf("Failed to setup audit entries: %v", err)
+		}
+	}
+
+	from := base.Add(time.Hour)
+	to := base.Add(3 * time.Hour)
+
+	tests := []struct {
+		name      string
+		filter    domain.AuditFilter
+		wantCount int
+		wantFirst domain.AuditAction
+	}{
+		{
+			name:      "all entries in chronological order",
+			filter:    domain.AuditFilter{},
+			wantCount: 5,
+			wantFirst: domain.AuditActionPRCreated,
+		},
+		{
+			name:      "history of one pull request",
+			filter:    domain.AuditFilter{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_1"},
+			wantCount: 3,
+			wantFirst: domain.AuditActionPRCreated,
+		},
+		{
+			name:      "filter by actor",
+			filter:    domain.AuditFilter{Actor: "admin"},
+			wantCount: 1,
+			wantFirst: domain.AuditActionUserActivityChanged,
+		},
+		{
+			name:      "filter by action",
+			filter:    domain.AuditFilter{Action: domain.AuditActionReviewerAssigned},
+			wantCount: 1,
+			wantFirst: domain.AuditActionReviewerAssigned,
+		},
+		{
+			name:      "filter by time range",
+			filter:    domain.AuditFilter{From: &from, To: &to},
+			wantCount: 2,
+			wantFirst: domain.AuditActionPRCreated,
+		},
+		{
+			name:      "limit and offset",
+			filter:    domain.AuditFilter{Limit: 2, Offset: 1},
+			wantCount: 2,
+			wantFirst: domain.AuditActionReviewerAssigned,
+		},
+		{
+			name:      "nothing matches",
+			filter:    domain.AuditFilter{EntityID: "unknown"},
+			wantCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.Find(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+
+			if len(got) != tt.wantCount {
+				t.Fatalf("Find() returned %d entries, want %d", len(got), tt.wantCount)
+			}
+			if tt.wantCount > 0 && got[0].Action != tt.wantFirst {
+				t.Errorf("First entry action = %s, want %s", got[0].Action, tt.wantFirst)
+			}
+		})
+	}
+}
+
+func TestAuditRepository_FindAfterID(t *testing.T) {
+	cleanupTestDB(testDB)
+	repo := NewAuditRepository(testDB)
+	ctx := context.Background()
+
+	if lastID, err := repo.LastID(ctx); err != nil || lastID != 0 {
+		t.Fatalf("LastID() on empty log = %d, %v, want 0", lastID, err)
+	}
+
+	actions := []domain.AuditAction{
+		domain.AuditActionPRCreated,
+		domain.AuditActionTeamCreated,
+		domain.AuditActionReviewerAssigned,
+		domain.AuditActionReviewerAssigned,
+		domain.AuditActionPRMerged,
+	}
+	for _, action := range actions {
+		if err := repo.Save(ctx, &domain.AuditEntry{
+			EntityType: domain.AuditEntityPullRequest,
+			EntityID:   "pr_1",
+			Action:     action,
+			Actor:      "system",
+		}); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	entries, err := repo.FindAfterID(ctx, 1, []domain.AuditAction{
+		domain.AuditActionReviewerAssigned,
+		domain.AuditActionPRMerged,
+	}, 2)
+	if err != nil {
+		t.Fatalf("FindAfterID() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != 3 || entries[1].ID != 4 {
+		t.Fatalf("FindAfterID() = %v, want entries 3 and 4", entries)
+	}
+
+	if lastID, err := repo.LastID(ctx); err != nil || lastID != 5 {
+		t.Errorf("LastID() = %d, %v, want 5", lastID, err)
+	}
+}
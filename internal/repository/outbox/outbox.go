@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+// lockClass - первый ключ транзакционной advisory-блокировки агрегата, второй - хэш агрегата
+const lockClass = 0x6f757478
+
+// Append записывает событие в outbox внутри транзакции изменения: откат изменения откатывает и событие.
+// Блокировка агрегата до конца транзакции выстраивает события одного агрегата по id в порядке фиксации
+func Append(ctx context.Context, tx *sql.Tx, eventType domain.DomainEventType, aggregateType domain.AuditEntityType, aggregateID string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"SELECT pg_advisory_xact_lock($1, hashtext($2))",
+		lockClass, string(aggregateType)+"/"+aggregateID,
+	); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO outbox (event_type, aggregate_type, aggregate_id, payload) VALUES ($1, $2, $3, $4)",
+		string(eventType), string(aggregateType), aggregateID, data,
+	)
+	return err
+}
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// FetchPending возвращает до limit недоставленных событий в порядке id.
+// Событие пропускается, пока более раннее событие его агрегата ждёт повторной попытки
+func (r *OutboxRepository) FetchPending(ctx context.Context, now time.Time, limit int) ([]*domain.DomainEvent, error) {
+	query := `
+        SELECT o.id, o.event_type, o.aggregate_type, o.aggregate_id, o.payload, o.created_at, o.attempts
+        FROM outbox o
+        WHERE o.delivered_at IS NULL
+          AND o.next_attempt_at <= $1
+          AND NOT EXISTS (
+              SELECT 1 FROM outbox earlier
+              WHERE earlier.delivered_at IS NULL
+                AND earlier.aggregate_type = o.aggregate_type
+                AND earlier.aggregate_id = o.aggregate_id
+                AND earlier.id < o.id
+                AND earlier.next_attempt_at > $1
+          )
+        ORDER BY o.id
+        LIMIT $2
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.DomainEvent
+	for rows.Next() {
+		var (
+			event   domain.DomainEvent
+			payload []byte
+		)
+		if err := rows.Scan(
+			&event.ID,
+			&event.Type,
+			&event.AggregateType,
+			&event.AggregateID,
+			&payload,
+			&event.CreatedAt,
+			&event.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		event.Payload = payload
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64, at time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE outbox SET delivered_at = $1, last_error = NULL WHERE id = $2",
+		at, id,
+	)
+	return err
+}
+
+// MarkFailed откладывает событие до nextAttempt и запоминает причину неудачи
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, nextAttempt time.Time, reason string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE outbox SET attempts = attempts + 1, next_attempt_at = $1, last_error = $2 WHERE id = $3",
+		nextAttempt, reason, id,
+	)
+	return err
+}
+
+// DeleteDelivered удаляет события, доставленные раньше before, и возвращает их число
+func (r *OutboxRepository) DeleteDelivered(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM outbox WHERE delivered_at < $1",
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,242 @@
+package outbox
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+var testDB *sql.DB
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:15-alpine",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_DB":       "test_review_service",
+			"POSTGRES_USER":     "test_user",
+			"POSTGRES_PASSWORD": "test_password",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("database system is ready to accept connections"),
+			wait.ForListeningPort("5432/tcp"),
+		).WithStartupTimeout(30 * time.Second),
+	}
+
+	postgresContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start container: %s", err)
+	}
+	defer postgresContainer.Terminate(ctx)
+
+	host, err := postgresContainer.Host(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get host: %s", err)
+	}
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	if err != nil {
+		log.Fatalf("Failed to get port: %s", err)
+	}
+
+	connStr := fmt.Sprintf("host=%s port=%s user=test_user password=test_password dbname=test_review_service sslmode=disable",
+		host, port.Port())
+
+	var db *sql.DB
+	maxRetries := 5
+	for i := 0; i < maxRetries; i++ {
+		db, err = sql.Open("postgres", connStr)
+		if err != nil {
+			log.Printf("Failed to open database (attempt %d): %s", i+1, err)
+			time.Sleep(2 * time.Second)
+			continue
+		}
+
+		err = db.Ping()
+		if err != nil {
+			log.Printf("Failed to ping database (attempt %d): %s", i+1, err)
+			db.Close()
+			time.Sleep(2 * time.Second)
+			continue
+		}
+		break
+	}
+
+	if err != nil {
+		log.Fatalf("Failed to connect to database after %d attempts: %s", maxRetries, err)
+	}
+
+	testDB = db
+
+	if err := setupTestDB(testDB); err != nil {
+		log.Fatalf("Failed to setup test database: %s", err)
+	}
+
+	code := m.Run()
+
+	testDB.Close()
+	os.Exit(code)
+}
+
+func setupTestDB(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS outbox (
+		id BIGSERIAL PRIMARY KEY,
+		event_type VARCHAR(50) NOT NULL,
+		aggregate_type VARCHAR(50) NOT NULL,
+		aggregate_id VARCHAR(255) NOT NULL,
+		payload JSONB NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		last_error TEXT NULL,
+		delivered_at TIMESTAMP WITH TIME ZONE NULL
+	)`)
+	return err
+}
+
+func cleanAndSetup(t *testing.T) {
+	t.Helper()
+	if _, err := testDB.Exec(`TRUNCATE TABLE outbox RESTART IDENTITY`); err != nil {
+		t.Fatalf("Failed to cleanup: %v", err)
+	}
+}
+
+func appendEvent(t *testing.T, aggregateID string, eventType domain.DomainEventType) {
+	t.Helper()
+	tx, err := testDB.Begin()
+	if err != nil {
+		t.Fatalf("Failed to begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	payload := domain.PRMergedPayload{PullRequestID: aggregateID}
+	if err := Append(context.Background(), tx, eventType, domain.AuditEntityPullRequest, aggregateID, payload); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+}
+
+func pendingIDs(t *testing.T, repo *OutboxRepository, now time.Time) []int64 {
+	t.Helper()
+	events, err := repo.FetchPending(context.Background(), now, 100)
+	if err != nil {
+		t.Fatalf("FetchPending() error = %v", err)
+	}
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func TestAppend_RolledBackWithChange(t *testing.T) {
+	cleanAndSetup(t)
+	ctx := context.Background()
+
+	tx, err := testDB.Begin()
+	if err != nil {
+		t.Fatalf("Failed to begin: %v", err)
+	}
+	if err := Append(ctx, tx, domain.DomainEventPRCreated, domain.AuditEntityPullRequest, "pr_1", map[string]string{}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	tx.Rollback()
+
+	if ids := pendingIDs(t, NewOutboxRepository(testDB), time.Now()); len(ids) != 0 {
+		t.Errorf("Rolled back event is pending: %v", ids)
+	}
+}
+
+func TestOutboxRepository_FetchPending(t *testing.T) {
+	repo := NewOutboxRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("events are returned in order with payload", func(t *testing.T) {
+		cleanAndSetup(t)
+		appendEvent(t, "pr_1", domain.DomainEventPRCreated)
+		appendEvent(t, "pr_2", domain.DomainEventPRCreated)
+		appendEvent(t, "pr_1", domain.DomainEventPRMerged)
+
+		events, err := repo.FetchPending(ctx, time.Now(), 100)
+		if err != nil {
+			t.Fatalf("FetchPending() error = %v", err)
+		}
+		if len(events) != 3 {
+			t.Fatalf("Expected 3 events, got %d", len(events))
+		}
+		last := events[2]
+		if last.ID != 3 || last.Type != domain.DomainEventPRMerged || last.AggregateType != domain.AuditEntityPullRequest || last.AggregateID != "pr_1" {
+			t.Errorf("Event = %+v", last)
+		}
+		var payload domain.PRMergedPayload
+		if err := json.Unmarshal(last.Payload, &payload); err != nil || payload.PullRequestID != "pr_1" {
+			t.Errorf("Payload = %s, error = %v", last.Payload, err)
+		}
+	})
+
+	t.Run("delayed event blocks later events of its aggregate", func(t *testing.T) {
+		cleanAndSetup(t)
+		appendEvent(t, "pr_1", domain.DomainEventPRCreated)
+		appendEvent(t, "pr_2", domain.DomainEventPRCreated)
+		appendEvent(t, "pr_1", domain.DomainEventPRMerged)
+
+		now := time.Now()
+		if err := repo.MarkFailed(ctx, 1, now.Add(time.Minute), "broker unavailable"); err != nil {
+			t.Fatalf("MarkFailed() error = %v", err)
+		}
+
+		if ids := pendingIDs(t, repo, now); len(ids) != 1 || ids[0] != 2 {
+			t.Errorf("Pending = %v, want [2]", ids)
+		}
+
+		events, err := repo.FetchPending(ctx, now.Add(2*time.Minute), 100)
+		if err != nil {
+			t.Fatalf("FetchPending() error = %v", err)
+		}
+		if len(events) != 3 || events[0].Attempts != 1 {
+			t.Errorf("After delay events = %+v, want all 3 with one failed attempt first", events)
+		}
+	})
+
+	t.Run("delivered events are skipped and purged", func(t *testing.T) {
+		cleanAndSetup(t)
+		appendEvent(t, "pr_1", domain.DomainEventPRCreated)
+		appendEvent(t, "pr_1", domain.DomainEventPRMerged)
+
+		deliveredAt := time.Now().Add(-time.Hour)
+		if err := repo.MarkDelivered(ctx, 1, deliveredAt); err != nil {
+			t.Fatalf("MarkDelivered() error = %v", err)
+		}
+		if ids := pendingIDs(t, repo, time.Now()); len(ids) != 1 || ids[0] != 2 {
+			t.Errorf("Pending = %v, want [2]", ids)
+		}
+
+		deleted, err := repo.DeleteDelivered(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("DeleteDelivered() error = %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("Deleted = %d, want 1", deleted)
+		}
+		if ids := pendingIDs(t, repo, time.Now()); len(ids) != 1 {
+			t.Errorf("Pending event must survive purge, got %v", ids)
+		}
+	})
+}
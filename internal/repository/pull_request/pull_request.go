@@ -8,6 +8,10 @@ import (
 	"time"
 
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository/audit"
+	"avito-test-task/internal/repository/outbox"
+
+	"github.com/lib/pq"
 )
 
 type PRRepository struct {
@@ -18,7 +22,7 @@ func NewPRRepository(db *sql.DB) *PRRepository {
 	return &PRRepository{db: db}
 }
 
-func (r *PRRepository) SavePR(ctx context.Context, pr *domain.PullRequest) error {
+func (r *PRRepository) SavePR(ctx context.Context, pr *domain.PullRequest, audits ...domain.AuditEntry) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		log.Printf("Error starting transaction: %v", err)
@@ -43,24 +47,56 @@ func (r *PRRepository) SavePR(ctx context.Context, pr *domain.PullRequest) error
 		return errors.New("Uncorrect status of pull request")
 	}
 
+	if pr.RequiredReviewers == 0 {
+		pr.RequiredReviewers = domain.DefaultReviewersCount
+	}
+	if pr.Priority == "" {
+		pr.Priority = domain.PRPriorityNormal
+	}
+
 	query := `
-        INSERT INTO pull_requests (id, title, author_id, status, created_at, merged_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO pull_requests (id, title, author_id, status, created_at, merged_at, required_reviewers, team_id, required_tags, priority,
+            labels, repository, source_branch, target_branch, url, lines_changed)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
         ON CONFLICT (id) DO UPDATE SET
             title = EXCLUDED.title,
             status = EXCLUDED.status,
-            merged_at = EXCLUDED.merged_at
+            merged_at = EXCLUDED.merged_at,
+            required_reviewers = EXCLUDED.required_reviewers,
+            team_id = EXCLUDED.team_id,
+            required_tags = EXCLUDED.required_tags,
+            priority = EXCLUDED.priority,
+            labels = EXCLUDED.labels,
+            repository = EXCLUDED.repository,
+            source_branch = EXCLUDED.source_branch,
+            target_branch = EXCLUDED.target_branch,
+            url = EXCLUDED.url,
+            lines_changed = EXCLUDED.lines_changed
+        RETURNING (xmax = 0)
     `
 
 	log.Printf("Executing PR query: %s", query)
-	_, err = tx.ExecContext(ctx, query,
+	// xmax = 0 только у новой строки: PRCreated пишется один раз, повторное сохранение его не дублирует
+	var inserted bool
+	err = tx.QueryRowContext(ctx, query,
 		pr.ID,
 		pr.Title,
 		pr.AuthorID,
 		string(pr.Status),
 		pr.CreatedAt,
 		pr.MergedAt,
-	)
+		pr.RequiredReviewers,
+		sql.NullInt32{Int32: int32(pr.TeamID), Valid: pr.TeamID != 0},
+		// nil превратился бы в NULL, а колонка NOT NULL
+		pq.Array(append([]string{}, pr.RequiredTags...)),
+		string(pr.Priority),
+		pq.Array(append([]string{}, pr.Labels...)),
+		pr.Metadata.Repository,
+		pr.Metadata.SourceBranch,
+		pr.Metadata.TargetBranch,
+		pr.Metadata.URL,
+		pr.Metadata.LinesChanged,
+	).Scan(&inserted)
 	if err != nil {
 		log.Printf("Error saving PR: %v", err)
 		return err
@@ -79,6 +115,29 @@ func (r *PRRepository) SavePR(ctx context.Context, pr *domain.PullRequest) error
 		}
 	}
 
+	if inserted {
+		reviewers := pr.AssignedReviewers
+		if reviewers == nil {
+			// схема события требует массив, а не null
+			reviewers = []string{}
+		}
+		err = outbox.Append(ctx, tx, domain.DomainEventPRCreated, domain.AuditEntityPullRequest, pr.ID, domain.PRCreatedPayload{
+			PullRequestID:     pr.ID,
+			Title:             pr.Title,
+			AuthorID:          pr.AuthorID,
+			Reviewers:         reviewers,
+			RequiredReviewers: pr.RequiredReviewers,
+			CreatedAt:         *pr.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return err
 	}
@@ -89,11 +148,13 @@ func (r *PRRepository) SavePR(ctx context.Context, pr *domain.PullRequest) error
 
 func (r *PRRepository) FindByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	var pr domain.PullRequest
+	var teamID sql.NullInt32
 
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, title, author_id, status, created_at, merged_at FROM pull_requests WHERE id = $1",
+		"SELECT id, title, author_id, status, created_at, merged_at, required_reviewers, team_id, required_tags, priority, "+prDetails+" FROM pull_requests pr WHERE id = $1",
 		prID,
-	).Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+	).Scan(append([]any{&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.RequiredReviewers, &teamID, pq.Array(&pr.RequiredTags), &pr.Priority},
+		detailsDest(&pr)...)...)
 
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrPRNotFound
@@ -101,6 +162,7 @@ func (r *PRRepository) FindByID(ctx context.Context, prID string) (*domain.PullR
 	if err != nil {
 		return nil, err
 	}
+	pr.TeamID = int(teamID.Int32)
 
 	rows, err := r.db.QueryContext(ctx,
 		"SELECT reviewer_id FROM pr_reviewers WHERE pr_id = $1",
@@ -122,13 +184,32 @@ func (r *PRRepository) FindByID(ctx context.Context, prID string) (*domain.PullR
 	return &pr, nil
 }
 
-func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status domain.PRStatus, mergedAt *time.Time) error {
+// UpdateStatus меняет статус PR; переход в MERGED записывает PRMerged в outbox той же транзакцией
+func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status domain.PRStatus, mergedAt *time.Time, audits ...domain.AuditEntry) error {
 	var utcTime time.Time
 	if mergedAt != nil {
 		utcTime = (*mergedAt).UTC()
 	}
 
-	result, err := r.db.ExecContext(ctx,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previous domain.PRStatus
+	err = tx.QueryRowContext(ctx,
+		"SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE",
+		prID,
+	).Scan(&previous)
+	if err == sql.ErrNoRows {
+		return domain.ErrPRNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
 		"UPDATE pull_requests SET status = $1, merged_at = $2 WHERE id = $3",
 		string(status), &utcTime, prID,
 	)
@@ -136,19 +217,24 @@ func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status dom
 		return err
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return err
+	if status == domain.PRStatusMerged && previous != domain.PRStatusMerged {
+		err = outbox.Append(ctx, tx, domain.DomainEventPRMerged, domain.AuditEntityPullRequest, prID, domain.PRMergedPayload{
+			PullRequestID: prID,
+			MergedAt:      utcTime,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	if rows == 0 {
-		return domain.ErrPRNotFound
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
-func (r *PRRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+func (r *PRRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, audits ...domain.AuditEntry) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -180,19 +266,340 @@ func (r *PRRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID,
 		return err
 	}
 
+	err = outbox.Append(ctx, tx, domain.DomainEventReviewerReplaced, domain.AuditEntityPullRequest, prID, domain.ReviewerReplacedPayload{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddReviewers добавляет ревьюверов к PR одной транзакцией
+func (r *PRRepository) AddReviewers(ctx context.Context, prID string, reviewerIDs []string, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, reviewerID := range reviewerIDs {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			prID, reviewerID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddReviewer назначает одного ревьювера, проверяя повтор и лимит required_reviewers под блокировкой
+// строки PR, поэтому параллельные назначения не превышают лимит
+func (r *PRRepository) AddReviewer(ctx context.Context, prID, reviewerID string, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var status domain.PRStatus
+	var required int
+	err = tx.QueryRowContext(ctx,
+		"SELECT status, required_reviewers FROM pull_requests WHERE id = $1 FOR UPDATE",
+		prID,
+	).Scan(&status, &required)
+	if err == sql.ErrNoRows {
+		return domain.ErrPRNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if status == domain.PRStatusMerged {
+		return domain.ErrPRMerged
+	}
+
+	var assigned int
+	var alreadyAssigned bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT COUNT(*), COALESCE(BOOL_OR(reviewer_id = $2), false) FROM pr_reviewers WHERE pr_id = $1",
+		prID, reviewerID,
+	).Scan(&assigned, &alreadyAssigned)
+	if err != nil {
+		return err
+	}
+	switch {
+	case alreadyAssigned:
+		return domain.ErrReviewerAlreadyAssigned
+	case assigned >= required:
+		return domain.ErrReviewersLimit
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ($1, $2)",
+		prID, reviewerID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdatePriority меняет приоритет PR
+func (r *PRRepository) UpdatePriority(ctx context.Context, prID string, priority domain.PRPriority, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE pull_requests SET priority = $2 WHERE id = $1",
+		prID, string(priority),
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPRNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateDetails заменяет заданные в update метки и метаданные PR. Чтение и запись идут под блокировкой строки,
+// поэтому параллельные изменения разных полей не затирают друг друга. В entry подставляются прежние и новые
+// значения изменённых полей; если ничего не изменилось, PR и журнал не трогаются
+func (r *PRRepository) UpdateDetails(ctx context.Context, prID string, update domain.PRUpdate, entry domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before := &domain.PullRequest{ID: prID}
+	err = tx.QueryRowContext(ctx,
+		"SELECT "+prDetails+" FROM pull_requests pr WHERE id = $1 FOR UPDATE",
+		prID,
+	).Scan(detailsDest(before)...)
+	if err == sql.ErrNoRows {
+		return domain.ErrPRNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	entry.OldValue, entry.NewValue = update.Changes(before)
+	if len(entry.NewValue) == 0 {
+		return nil
+	}
+
+	labels, metadata := before.Labels, before.Metadata
+	if update.Labels != nil {
+		labels = *update.Labels
+	}
+	if update.Metadata != nil {
+		metadata = *update.Metadata
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE pull_requests
+        SET labels = $2, repository = $3, source_branch = $4, target_branch = $5, url = $6, lines_changed = $7
+        WHERE id = $1
+    `,
+		prID,
+		pq.Array(append([]string{}, labels...)),
+		metadata.Repository,
+		metadata.SourceBranch,
+		metadata.TargetBranch,
+		metadata.URL,
+		metadata.LinesChanged,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PRRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM pr_reviewers WHERE pr_id = $1 AND reviewer_id = $2",
+		prID, reviewerID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrReviewerNotAssigned
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
-func (r *PRRepository) FindByReviewerID(ctx context.Context, reviewerID string) ([]*domain.PullRequest, error) {
+// CountOpenReviews возвращает число открытых PR, где назначен каждый из userIDs; пользователей без ревью в ответе нет
+func (r *PRRepository) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	query := `
+	SELECT rev.reviewer_id, COUNT(*)
+	    FROM pr_reviewers rev
+	    JOIN pull_requests pr ON pr.id = rev.pr_id
+	    WHERE pr.status = $1 AND rev.reviewer_id = ANY($2)
+	    GROUP BY rev.reviewer_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.PRStatusOpen, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, err
+		}
+		counts[userID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// LastReviewedAuthor возвращает, когда каждый из reviewerIDs последний раз назначался на PR автора;
+// тех, кто автора ещё не ревьюил, в ответе нет
+func (r *PRRepository) LastReviewedAuthor(ctx context.Context, authorID string, reviewerIDs []string) (map[string]time.Time, error) {
+	query := `
+	SELECT rev.reviewer_id, MAX(COALESCE(rev.assigned_at, pr.created_at))
+	    FROM pr_reviewers rev
+	    JOIN pull_requests pr ON pr.id = rev.pr_id
+	    WHERE pr.author_id = $1 AND rev.reviewer_id = ANY($2)
+	    GROUP BY rev.reviewer_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, authorID, pq.Array(reviewerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	last := make(map[string]time.Time, len(reviewerIDs))
+	for rows.Next() {
+		var reviewerID string
+		var at sql.NullTime
+		if err := rows.Scan(&reviewerID, &at); err != nil {
+			return nil, err
+		}
+		if at.Valid {
+			last[reviewerID] = at.Time
+		}
+	}
+
+	return last, rows.Err()
+}
+
+// FindReviewPairs считает назначения ревьюверов по парам автор-ревьювер в PR команды teamID
+// (для PR без команды - основной команды автора), пары упорядочены по автору и ревьюверу
+func (r *PRRepository) FindReviewPairs(ctx context.Context, teamID int) ([]domain.ReviewPair, error) {
 	query := `
-	SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at
+	SELECT pr.author_id, rev.reviewer_id, COUNT(*), MAX(COALESCE(rev.assigned_at, pr.created_at))
+	    FROM pr_reviewers rev
+	    JOIN pull_requests pr ON pr.id = rev.pr_id
+	    JOIN users a ON a.id = pr.author_id
+	    WHERE COALESCE(pr.team_id, a.team_id) = $1
+	    GROUP BY pr.author_id, rev.reviewer_id
+	    ORDER BY pr.author_id, rev.reviewer_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pairs := []domain.ReviewPair{}
+	for rows.Next() {
+		var pair domain.ReviewPair
+		var at sql.NullTime
+		if err := rows.Scan(&pair.AuthorID, &pair.ReviewerID, &pair.Reviews, &at); err != nil {
+			return nil, err
+		}
+		pair.LastReviewedAt = at.Time
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, rows.Err()
+}
+
+// FindByReviewerID возвращает PR, где назначен reviewerID, с приоритетами из filter в заданном им порядке
+func (r *PRRepository) FindByReviewerID(ctx context.Context, reviewerID string, filter domain.ReviewListFilter) ([]*domain.PullRequest, error) {
+	orderBy := "pr.id"
+	if filter.ByPriority {
+		orderBy = priorityRank + " DESC, pr.id"
+	}
+	priorities := make([]string, 0, len(filter.Priorities))
+	for _, p := range filter.Priorities {
+		priorities = append(priorities, string(p))
+	}
+
+	query := `
+	SELECT pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.required_reviewers, pr.priority, ` + prDetails + `
 	    FROM pull_requests pr
 	    JOIN pr_reviewers rev ON pr.id = rev.pr_id
 	    WHERE rev.reviewer_id = $1
-	    ORDER BY pr.id
-	`
+	      AND (cardinality($2::text[]) = 0 OR pr.priority = ANY($2))
+	      AND pr.labels @> $3::text[]
+	      AND ($4::text = '' OR pr.repository = $4)
+	    ORDER BY ` + orderBy
 
-	rows, err := r.db.QueryContext(ctx, query, reviewerID)
+	rows, err := r.db.QueryContext(ctx, query, reviewerID, pq.Array(priorities), pq.Array(append([]string{}, filter.Labels...)), filter.Repository)
 	if err != nil {
 		return nil, err
 	}
@@ -201,14 +608,16 @@ func (r *PRRepository) FindByReviewerID(ctx context.Context, reviewerID string)
 	var prs []*domain.PullRequest
 	for rows.Next() {
 		var pr domain.PullRequest
-		if err := rows.Scan(
+		if err := rows.Scan(append([]any{
 			&pr.ID,
 			&pr.Title,
 			&pr.AuthorID,
 			&pr.Status,
 			&pr.CreatedAt,
 			&pr.MergedAt,
-		); err != nil {
+			&pr.RequiredReviewers,
+			&pr.Priority,
+		}, detailsDest(&pr)...)...); err != nil {
 			return nil, err
 		}
 
@@ -235,3 +644,181 @@ func (r *PRRepository) FindByReviewerID(ctx context.Context, reviewerID string)
 
 	return prs, rows.Err()
 }
+
+// FindOpenByReviewerIDs возвращает открытые PR, где назначен каждый из reviewerIDs, двумя запросами на всех:
+// PR и их ревьюверы. Пользователей без открытых ревью в ответе нет
+func (r *PRRepository) FindOpenByReviewerIDs(ctx context.Context, reviewerIDs []string) (map[string][]*domain.PullRequest, error) {
+	query := `
+	SELECT rev.reviewer_id, pr.id, pr.title, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.required_reviewers, pr.priority, ` + prDetails + `
+	    FROM pr_reviewers rev
+	    JOIN pull_requests pr ON pr.id = rev.pr_id
+	    WHERE pr.status = $1 AND rev.reviewer_id = ANY($2)
+	    ORDER BY rev.reviewer_id, pr.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.PRStatusOpen, pq.Array(reviewerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string][]*domain.PullRequest)
+	// PR с несколькими ревьюверами из списка разделяется между ними
+	prs := make(map[string]*domain.PullRequest)
+	for rows.Next() {
+		var reviewerID string
+		var pr domain.PullRequest
+		if err := rows.Scan(append([]any{
+			&reviewerID,
+			&pr.ID,
+			&pr.Title,
+			&pr.AuthorID,
+			&pr.Status,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.RequiredReviewers,
+			&pr.Priority,
+		}, detailsDest(&pr)...)...); err != nil {
+			return nil, err
+		}
+
+		shared, ok := prs[pr.ID]
+		if !ok {
+			shared = &pr
+			prs[pr.ID] = shared
+		}
+		result[reviewerID] = append(result[reviewerID], shared)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadReviewers(ctx, prs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindStaleReviews возвращает назначения на открытые PR, которые к моменту now ждут дольше меньшего из порогов
+// команды PR (для PR без команды - основной команды автора); для команд без своих порогов используются defaultSLA и defaultReassignAfter
+func (r *PRRepository) FindStaleReviews(ctx context.Context, now time.Time, defaultSLA, defaultReassignAfter time.Duration) ([]*domain.StaleReview, error) {
+	query := `
+	SELECT pr.id, pr.title, pr.author_id, t.name, rev.reviewer_id, u.username,
+	       COALESCE(rev.assigned_at, pr.created_at) AS waiting_since, rev.reminded_at,
+	       t.review_sla_hours, t.reassign_after_hours
+	    FROM pr_reviewers rev
+	    JOIN pull_requests pr ON pr.id = rev.pr_id
+	    JOIN users a ON a.id = pr.author_id
+	    JOIN teams t ON t.id = COALESCE(pr.team_id, a.team_id)
+	    JOIN users u ON u.id = rev.reviewer_id
+	    WHERE pr.status = $1
+	      AND COALESCE(rev.assigned_at, pr.created_at) <= $2::timestamptz - make_interval(secs => LEAST(
+	          COALESCE(t.review_sla_hours * 3600, $3::double precision),
+	          COALESCE(t.reassign_after_hours * 3600, $4::double precision)))
+	    ORDER BY waiting_since, pr.id, rev.reviewer_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		domain.PRStatusOpen, now, defaultSLA.Seconds(), defaultReassignAfter.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []*domain.StaleReview
+	for rows.Next() {
+		var review domain.StaleReview
+		var slaHours, reassignAfterHours sql.NullInt32
+		if err := rows.Scan(
+			&review.PullRequestID,
+			&review.Title,
+			&review.AuthorID,
+			&review.TeamName,
+			&review.ReviewerID,
+			&review.ReviewerName,
+			&review.WaitingSince,
+			&review.RemindedAt,
+			&slaHours,
+			&reassignAfterHours,
+		); err != nil {
+			return nil, err
+		}
+		review.ReviewSLAHours = int(slaHours.Int32)
+		review.ReassignAfterHours = int(reassignAfterHours.Int32)
+		reviews = append(reviews, &review)
+	}
+
+	return reviews, rows.Err()
+}
+
+// MarkReminded отмечает, что ревьюверу отправлено напоминание по PR
+func (r *PRRepository) MarkReminded(ctx context.Context, prID, reviewerID string, at time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE pr_reviewers SET reminded_at = $1 WHERE pr_id = $2 AND reviewer_id = $3",
+		at, prID, reviewerID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrReviewerNotAssigned
+	}
+
+	return nil
+}
+
+// loadReviewers заполняет AssignedReviewers у всех prs одним запросом
+func (r *PRRepository) loadReviewers(ctx context.Context, prs map[string]*domain.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(prs))
+	for id := range prs {
+		ids = append(ids, id)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT pr_id, reviewer_id FROM pr_reviewers WHERE pr_id = ANY($1) ORDER BY pr_id, reviewer_id",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID, reviewerID string
+		if err := rows.Scan(&prID, &reviewerID); err != nil {
+			return err
+		}
+		pr := prs[prID]
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+	}
+
+	return rows.Err()
+}
+
+// priorityRank - место приоритета PR в domain.PRPriorities: чем срочнее, тем больше
+const priorityRank = `CASE pr.priority WHEN 'low' THEN 0 WHEN 'normal' THEN 1 WHEN 'high' THEN 2 WHEN 'hotfix' THEN 3 END`
+
+// prDetails - метки и метаданные PR, читаются в detailsDest
+const prDetails = `pr.labels, pr.repository, pr.source_branch, pr.target_branch, pr.url, pr.lines_changed`
+
+func detailsDest(pr *domain.PullRequest) []any {
+	return []any{
+		pq.Array(&pr.Labels),
+		&pr.Metadata.Repository,
+		&pr.Metadata.SourceBranch,
+		&pr.Metadata.TargetBranch,
+		&pr.Metadata.URL,
+		&pr.Metadata.LinesChanged,
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"avito-test-task/internal/domain"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -96,27 +99,84 @@ func setupTestDB(db *sql.DB) error {
 	migrations := []string{
 		`CREATE TABLE IF NOT EXISTS teams (
 			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) UNIQUE NOT NULL
+			name VARCHAR(255) UNIQUE NOT NULL,
+			review_sla_hours INTEGER NULL,
+			reassign_after_hours INTEGER NULL
 		)`,
 		`CREATE TABLE IF NOT EXISTS users (
 			id VARCHAR(255) PRIMARY KEY,
 			username VARCHAR(255) NOT NULL,
 			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
-			is_active BOOLEAN DEFAULT TRUE
+			is_active BOOLEAN DEFAULT TRUE,
+			capacity INTEGER NOT NULL DEFAULT 100 CHECK (capacity BETWEEN 0 AND 100)
 		)`,
+		`CREATE TABLE IF NOT EXISTS team_memberships (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+			role VARCHAR(32) NOT NULL DEFAULT 'member' CHECK (role IN ('lead', 'senior', 'member', 'trainee')),
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+			joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, team_id)
+		)`,
+		`CREATE OR REPLACE FUNCTION ensure_primary_membership() RETURNS trigger AS $$
+		BEGIN
+			INSERT INTO team_memberships (user_id, team_id) VALUES (NEW.id, NEW.team_id)
+			ON CONFLICT DO NOTHING;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql`,
+		`CREATE OR REPLACE TRIGGER users_ensure_primary_membership
+			AFTER INSERT OR UPDATE OF team_id ON users
+			FOR EACH ROW
+			EXECUTE FUNCTION ensure_primary_membership()`,
 		`CREATE TABLE IF NOT EXISTS pull_requests (
 			id VARCHAR(255) PRIMARY KEY,
 			title VARCHAR(500) NOT NULL,
 			author_id VARCHAR(255) NOT NULL REFERENCES users(id),
 			status VARCHAR(50) NOT NULL DEFAULT 'OPEN',
 			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-			merged_at TIMESTAMP WITH TIME ZONE NULL
+			merged_at TIMESTAMP WITH TIME ZONE NULL,
+			required_reviewers INTEGER NOT NULL DEFAULT 2 CHECK (required_reviewers BETWEEN 1 AND 10),
+			team_id INTEGER NULL REFERENCES teams(id),
+			required_tags TEXT[] NOT NULL DEFAULT '{}',
+			priority VARCHAR(16) NOT NULL DEFAULT 'normal' CHECK (priority IN ('low', 'normal', 'high', 'hotfix')),
+			labels TEXT[] NOT NULL DEFAULT '{}',
+			repository VARCHAR(255) NOT NULL DEFAULT '',
+			source_branch VARCHAR(255) NOT NULL DEFAULT '',
+			target_branch VARCHAR(255) NOT NULL DEFAULT '',
+			url VARCHAR(2048) NOT NULL DEFAULT '',
+			lines_changed INTEGER NOT NULL DEFAULT 0 CHECK (lines_changed >= 0)
 		)`,
 		`CREATE TABLE IF NOT EXISTS pr_reviewers (
 			pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
 			reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+			assigned_at TIMESTAMP WITH TIME ZONE NULL,
+			reminded_at TIMESTAMP WITH TIME ZONE NULL,
 			PRIMARY KEY(pr_id, reviewer_id)
 		)`,
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id VARCHAR(255) NOT NULL,
+			action VARCHAR(50) NOT NULL,
+			actor VARCHAR(255) NOT NULL DEFAULT 'system',
+			reason VARCHAR(50) NOT NULL DEFAULT '',
+			old_value JSONB NULL,
+			new_value JSONB NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS outbox (
+			id BIGSERIAL PRIMARY KEY,
+			event_type VARCHAR(50) NOT NULL,
+			aggregate_type VARCHAR(50) NOT NULL,
+			aggregate_id VARCHAR(255) NOT NULL,
+			payload JSONB NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_error TEXT NULL,
+			delivered_at TIMESTAMP WITH TIME ZONE NULL
+		)`,
 
 		`INSERT INTO teams (name) VALUES 
 			('backend-team'),
@@ -157,7 +217,9 @@ func cleanupTestDB(db *sql.DB) error {
 			pr_reviewers,
 			pull_requests,
 			users,
-			teams 
+			teams,
+			audit_log,
+			outbox
 		RESTART IDENTITY CASCADE
 	`)
 	return err
@@ -566,6 +628,322 @@ func TestPRRepository_ReplaceReviewer(t *testing.T) {
 	}
 }
 
+func outboxEvents(t *testing.T, aggregateID string) []domain.DomainEventType {
+	t.Helper()
+	rows, err := testDB.Query(`SELECT event_type FROM outbox WHERE aggregate_id = $1 ORDER BY id`, aggregateID)
+	if err != nil {
+		t.Fatalf("Failed to read outbox: %v", err)
+	}
+	defer rows.Close()
+
+	var events []domain.DomainEventType
+	for rows.Next() {
+		var event domain.DomainEventType
+		if err := rows.Scan(&event); err != nil {
+			t.Fatalf("Failed to scan outbox: %v", err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestPRRepository_Outbox(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	pr := &domain.PullRequest{
+		ID:                "pr_outbox",
+		Title:             "Outbox",
+		AuthorID:          "user_1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"user_2"},
+	}
+	if err := repo.SavePR(ctx, pr); err != nil {
+		t.Fatalf("SavePR() error = %v", err)
+	}
+	// повторное сохранение не создаёт PR заново
+	if err := repo.SavePR(ctx, pr); err != nil {
+		t.Fatalf("Second SavePR() error = %v", err)
+	}
+
+	if err := repo.ReplaceReviewer(ctx, "pr_outbox", "user_1", "user_3"); err == nil {
+		t.Fatal("ReplaceReviewer() of unassigned reviewer should fail")
+	}
+	if err := repo.ReplaceReviewer(ctx, "pr_outbox", "user_2", "user_3"); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+
+	mergedAt := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := repo.UpdateStatus(ctx, "pr_outbox", domain.PRStatusMerged, &mergedAt); err != nil {
+			t.Fatalf("UpdateStatus() error = %v", err)
+		}
+	}
+
+	want := []domain.DomainEventType{domain.DomainEventPRCreated, domain.DomainEventReviewerReplaced, domain.DomainEventPRMerged}
+	if got := outboxEvents(t, "pr_outbox"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Outbox events = %v, want %v", got, want)
+	}
+
+	var payload domain.ReviewerReplacedPayload
+	if err := testDB.QueryRow(
+		`SELECT payload FROM outbox WHERE aggregate_id = 'pr_outbox' AND event_type = $1`, domain.DomainEventReviewerReplaced,
+	).Scan(jsonValue{&payload}); err != nil {
+		t.Fatalf("Failed to read payload: %v", err)
+	}
+	if payload.OldReviewerID != "user_2" || payload.NewReviewerID != "user_3" {
+		t.Errorf("Payload = %+v", payload)
+	}
+}
+
+// jsonValue разбирает JSONB-колонку в v
+type jsonValue struct{ v any }
+
+func (j jsonValue) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("unexpected JSON column type %T", src)
+	}
+	return json.Unmarshal(data, j.v)
+}
+
+func TestPRRepository_AddReviewers(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		prID          string
+		reviewerIDs   []string
+		wantErr       bool
+		wantReviewers int
+	}{
+		{
+			name:          "add new reviewer",
+			prID:          "pr_1",
+			reviewerIDs:   []string{"user_4"},
+			wantErr:       false,
+			wantReviewers: 3,
+		},
+		{
+			name:          "add already assigned reviewer is no-op",
+			prID:          "pr_1",
+			reviewerIDs:   []string{"user_2"},
+			wantErr:       false,
+			wantReviewers: 2,
+		},
+		{
+			name:          "add several reviewers to PR without reviewers",
+			prID:          "pr_4",
+			reviewerIDs:   []string{"user_2", "user_3"},
+			wantErr:       false,
+			wantReviewers: 2,
+		},
+		{
+			name:        "add non-existent reviewer rolls back",
+			prID:        "pr_4",
+			reviewerIDs: []string{"user_2", "non_existent_user"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanAndSetup(t)
+
+			err := repo.AddReviewers(ctx, tt.prID, tt.reviewerIDs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddReviewers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			pr, err := repo.FindByID(ctx, tt.prID)
+			if err != nil {
+				t.Fatalf("Failed to get PR: %v", err)
+			}
+
+			if tt.wantErr {
+				if len(pr.AssignedReviewers) != 0 {
+					t.Errorf("Reviewers should not be added on error, got %v", pr.AssignedReviewers)
+				}
+				return
+			}
+
+			if len(pr.AssignedReviewers) != tt.wantReviewers {
+				t.Errorf("Reviewers count = %d, want %d", len(pr.AssignedReviewers), tt.wantReviewers)
+			}
+		})
+	}
+}
+
+func TestPRRepository_AddReviewer(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		prID       string
+		reviewerID string
+		wantErr    error
+	}{
+		{name: "add to PR with free slot", prID: "pr_5", reviewerID: "user_2"},
+		{name: "add already assigned reviewer", prID: "pr_1", reviewerID: "user_2", wantErr: domain.ErrReviewerAlreadyAssigned},
+		{name: "add over the limit", prID: "pr_1", reviewerID: "user_4", wantErr: domain.ErrReviewersLimit},
+		{name: "add to merged PR", prID: "pr_2", reviewerID: "user_3", wantErr: domain.ErrPRMerged},
+		{name: "add to non-existent PR", prID: "non_existent", reviewerID: "user_2", wantErr: domain.ErrPRNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanAndSetup(t)
+			if _, err := testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status) VALUES ('pr_5', 'Empty PR', 'user_1', 'OPEN')`); err != nil {
+				t.Fatalf("Failed to insert PR: %v", err)
+			}
+
+			entry := domain.AuditEntry{EntityType: domain.AuditEntityPullRequest, EntityID: tt.prID, Action: domain.AuditActionReviewerAssigned}
+			if err := repo.AddReviewer(ctx, tt.prID, tt.reviewerID, entry); err != tt.wantErr {
+				t.Fatalf("AddReviewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// запись журнала фиксируется и откатывается вместе с назначением
+			var audited int
+			if err := testDB.QueryRow(`SELECT COUNT(*) FROM audit_log`).Scan(&audited); err != nil {
+				t.Fatalf("Failed to count audit entries: %v", err)
+			}
+			if want := map[bool]int{true: 1, false: 0}[tt.wantErr == nil]; audited != want {
+				t.Errorf("Audit entries = %d, want %d", audited, want)
+			}
+		})
+	}
+
+	t.Run("concurrent adds do not exceed the limit", func(t *testing.T) {
+		cleanAndSetup(t)
+		if _, err := testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status) VALUES ('pr_5', 'Empty PR', 'user_1', 'OPEN')`); err != nil {
+			t.Fatalf("Failed to insert PR: %v", err)
+		}
+
+		errs := make(chan error, 3)
+		for _, reviewerID := range []string{"user_2", "user_3", "user_4"} {
+			go func(reviewerID string) {
+				errs <- repo.AddReviewer(ctx, "pr_5", reviewerID)
+			}(reviewerID)
+		}
+		var limited int
+		for i := 0; i < 3; i++ {
+			switch err := <-errs; err {
+			case nil:
+			case domain.ErrReviewersLimit:
+				limited++
+			default:
+				t.Errorf("AddReviewer() error = %v", err)
+			}
+		}
+
+		pr, err := repo.FindByID(ctx, "pr_5")
+		if err != nil {
+			t.Fatalf("Failed to get PR: %v", err)
+		}
+		if len(pr.AssignedReviewers) != 2 || limited != 1 {
+			t.Errorf("Reviewers = %v, limited = %d, want 2 reviewers and 1 rejection", pr.AssignedReviewers, limited)
+		}
+	})
+}
+
+func TestPRRepository_RemoveReviewer(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		prID          string
+		reviewerID    string
+		wantErr       error
+		wantReviewers []string
+	}{
+		{
+			name:          "remove assigned reviewer",
+			prID:          "pr_1",
+			reviewerID:    "user_2",
+			wantReviewers: []string{"user_3"},
+		},
+		{
+			name:          "remove not assigned reviewer",
+			prID:          "pr_1",
+			reviewerID:    "user_4",
+			wantErr:       domain.ErrReviewerNotAssigned,
+			wantReviewers: []string{"user_2", "user_3"},
+		},
+		{
+			name:          "remove from non-existent PR",
+			prID:          "non_existent_pr",
+			reviewerID:    "user_2",
+			wantErr:       domain.ErrReviewerNotAssigned,
+			wantReviewers: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanAndSetup(t)
+
+			err := repo.RemoveReviewer(ctx, tt.prID, tt.reviewerID)
+			if err != tt.wantErr {
+				t.Fatalf("RemoveReviewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantReviewers == nil {
+				return
+			}
+
+			pr, err := repo.FindByID(ctx, tt.prID)
+			if err != nil {
+				t.Fatalf("Failed to get PR: %v", err)
+			}
+
+			got := append([]string{}, pr.AssignedReviewers...)
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.wantReviewers) {
+				t.Errorf("Reviewers = %v, want %v", got, tt.wantReviewers)
+			}
+		})
+	}
+}
+
+func TestPRRepository_RequiredReviewers(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	now := time.Now()
+	pr := &domain.PullRequest{
+		ID:                "pr_required",
+		Title:             "Critical change",
+		AuthorID:          "user_1",
+		Status:            domain.PRStatusOpen,
+		CreatedAt:         &now,
+		RequiredReviewers: 3,
+	}
+	if err := repo.SavePR(ctx, pr); err != nil {
+		t.Fatalf("SavePR() error = %v", err)
+	}
+
+	saved, err := repo.FindByID(ctx, "pr_required")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if saved.RequiredReviewers != 3 {
+		t.Errorf("RequiredReviewers = %d, want 3", saved.RequiredReviewers)
+	}
+
+	legacy, err := repo.FindByID(ctx, "pr_1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if legacy.RequiredReviewers != domain.DefaultReviewersCount {
+		t.Errorf("Legacy PR RequiredReviewers = %d, want %d", legacy.RequiredReviewers, domain.DefaultReviewersCount)
+	}
+}
+
 func TestPRRepository_FindByReviewerID(t *testing.T) {
 	repo := NewPRRepository(testDB)
 	ctx := context.Background()
@@ -611,7 +989,7 @@ func TestPRRepository_FindByReviewerID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			cleanAndSetup(t)
 
-			prs, err := repo.FindByReviewerID(ctx, tt.reviewerID)
+			prs, err := repo.FindByReviewerID(ctx, tt.reviewerID, domain.ReviewListFilter{})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("FindByReviewerID() error = %v, wantErr %v", err, tt.wantErr)
@@ -655,6 +1033,115 @@ func TestPRRepository_FindByReviewerID(t *testing.T) {
 	}
 }
 
+func TestPRRepository_FindOpenByReviewerIDs(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	got, err := repo.FindOpenByReviewerIDs(ctx, []string{"user_1", "user_2", "user_3", "user_4", "non_existent_user"})
+	if err != nil {
+		t.Fatalf("FindOpenByReviewerIDs() error = %v", err)
+	}
+
+	// pr_2 у user_1 уже смёржен и в ответ не попадает
+	wantPRs := map[string][]string{
+		"user_1": {"pr_3"},
+		"user_2": {"pr_1"},
+		"user_3": {"pr_1"},
+		"user_4": {"pr_3"},
+	}
+	if len(got) != len(wantPRs) {
+		t.Errorf("FindOpenByReviewerIDs() returned %d reviewers, want %d", len(got), len(wantPRs))
+	}
+	for reviewerID, wantIDs := range wantPRs {
+		var gotIDs []string
+		for _, pr := range got[reviewerID] {
+			gotIDs = append(gotIDs, pr.ID)
+		}
+		if !reflect.DeepEqual(gotIDs, wantIDs) {
+			t.Errorf("FindOpenByReviewerIDs()[%s] = %v, want %v", reviewerID, gotIDs, wantIDs)
+		}
+	}
+
+	wantReviewers := map[string][]string{
+		"pr_1": {"user_2", "user_3"},
+		"pr_3": {"user_1", "user_4"},
+	}
+	for _, prs := range got {
+		for _, pr := range prs {
+			if !reflect.DeepEqual(pr.AssignedReviewers, wantReviewers[pr.ID]) {
+				t.Errorf("PR %s reviewers = %v, want %v", pr.ID, pr.AssignedReviewers, wantReviewers[pr.ID])
+			}
+		}
+	}
+	if got["user_2"][0] != got["user_3"][0] {
+		t.Error("PR shared by several reviewers should be loaded once")
+	}
+}
+
+func TestPRRepository_FindStaleReviews(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	staleIDs := func(reviews []*domain.StaleReview) []string {
+		var ids []string
+		for _, review := range reviews {
+			ids = append(ids, review.PullRequestID+"/"+review.ReviewerID)
+		}
+		return ids
+	}
+
+	// pr_1 создан 2024-01-01 10:00, pr_3 - 2024-01-03 13:00, pr_2 уже смёржен
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	got, err := repo.FindStaleReviews(ctx, now, 24*time.Hour, 72*time.Hour)
+	if err != nil {
+		t.Fatalf("FindStaleReviews() error = %v", err)
+	}
+	if want := []string{"pr_1/user_2", "pr_1/user_3"}; !reflect.DeepEqual(staleIDs(got), want) {
+		t.Errorf("FindStaleReviews() = %v, want %v", staleIDs(got), want)
+	}
+	if got[0].TeamName != "backend-team" || got[0].ReviewerName != "bob" || got[0].RemindedAt != nil {
+		t.Errorf("FindStaleReviews()[0] = %+v", got[0])
+	}
+
+	t.Run("team threshold overrides default", func(t *testing.T) {
+		if _, err := testDB.Exec("UPDATE teams SET review_sla_hours = 1 WHERE name = 'frontend-team'"); err != nil {
+			t.Fatalf("Failed to set team SLA: %v", err)
+		}
+
+		now := time.Date(2024, 1, 3, 15, 0, 0, 0, time.UTC)
+		got, err := repo.FindStaleReviews(ctx, now, 24*time.Hour, 72*time.Hour)
+		if err != nil {
+			t.Fatalf("FindStaleReviews() error = %v", err)
+		}
+		want := []string{"pr_1/user_2", "pr_1/user_3", "pr_3/user_1", "pr_3/user_4"}
+		if !reflect.DeepEqual(staleIDs(got), want) {
+			t.Errorf("FindStaleReviews() = %v, want %v", staleIDs(got), want)
+		}
+		if got[2].ReviewSLAHours != 1 || got[2].ReassignAfterHours != 0 {
+			t.Errorf("FindStaleReviews()[2] thresholds = %d/%d, want 1/0", got[2].ReviewSLAHours, got[2].ReassignAfterHours)
+		}
+	})
+
+	t.Run("mark reminded", func(t *testing.T) {
+		if err := repo.MarkReminded(ctx, "pr_1", "user_2", now); err != nil {
+			t.Fatalf("MarkReminded() error = %v", err)
+		}
+		if err := repo.MarkReminded(ctx, "pr_1", "user_4", now); err != domain.ErrReviewerNotAssigned {
+			t.Errorf("MarkReminded() error = %v, want %v", err, domain.ErrReviewerNotAssigned)
+		}
+
+		got, err := repo.FindStaleReviews(ctx, now, 24*time.Hour, 72*time.Hour)
+		if err != nil {
+			t.Fatalf("FindStaleReviews() error = %v", err)
+		}
+		if got[0].RemindedAt == nil || !got[0].RemindedAt.Equal(now) {
+			t.Errorf("RemindedAt = %v, want %v", got[0].RemindedAt, now)
+		}
+	})
+}
+
 func TestPRRepository_Integration_CompleteWorkflow(t *testing.T) {
 	repo := NewPRRepository(testDB)
 	ctx := context.Background()
@@ -732,7 +1219,7 @@ func TestPRRepository_Integration_CompleteWorkflow(t *testing.T) {
 			t.Errorf("Final PR status should be MERGED, got: %s", finalPR.Status)
 		}
 
-		user4PRs, err := repo.FindByReviewerID(ctx, "user_4")
+		user4PRs, err := repo.FindByReviewerID(ctx, "user_4", domain.ReviewListFilter{})
 		if err != nil {
 			t.Fatalf("Failed to find PRs by reviewer: %v", err)
 		}
@@ -795,3 +1282,182 @@ func TestPRRepository_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestPRRepository_Priority(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	pr := &domain.PullRequest{ID: "pr_hotfix", Title: "Hotfix", AuthorID: "user_1", Status: domain.PRStatusOpen, Priority: domain.PRPriorityHotfix}
+	if err := repo.SavePR(ctx, pr); err != nil {
+		t.Fatalf("SavePR() error = %v", err)
+	}
+	found, err := repo.FindByID(ctx, "pr_hotfix")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Priority != domain.PRPriorityHotfix {
+		t.Errorf("Priority = %q, want hotfix", found.Priority)
+	}
+
+	// PR из фикстур созданы без приоритета
+	found, err = repo.FindByID(ctx, "pr_1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Priority != domain.PRPriorityNormal {
+		t.Errorf("default Priority = %q, want normal", found.Priority)
+	}
+
+	// user_1 ревьюит pr_2 и pr_3
+	if err := repo.UpdatePriority(ctx, "pr_2", domain.PRPriorityLow); err != nil {
+		t.Fatalf("UpdatePriority() error = %v", err)
+	}
+	if err := repo.UpdatePriority(ctx, "pr_3", domain.PRPriorityHigh); err != nil {
+		t.Fatalf("UpdatePriority() error = %v", err)
+	}
+
+	ids := func(filter domain.ReviewListFilter) []string {
+		t.Helper()
+		prs, err := repo.FindByReviewerID(ctx, "user_1", filter)
+		if err != nil {
+			t.Fatalf("FindByReviewerID() error = %v", err)
+		}
+		result := []string{}
+		for _, pr := range prs {
+			result = append(result, pr.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.ReviewListFilter
+		want   []string
+	}{
+		{name: "by id", want: []string{"pr_2", "pr_3"}},
+		{name: "by priority", filter: domain.ReviewListFilter{ByPriority: true}, want: []string{"pr_3", "pr_2"}},
+		{name: "only low", filter: domain.ReviewListFilter{Priorities: []domain.PRPriority{domain.PRPriorityLow}}, want: []string{"pr_2"}},
+		{name: "no match", filter: domain.ReviewListFilter{Priorities: []domain.PRPriority{domain.PRPriorityNormal, domain.PRPriorityHotfix}}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.filter); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindByReviewerID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := repo.UpdatePriority(ctx, "pr_1", "urgent"); err == nil {
+		t.Error("UpdatePriority() with unknown priority should violate the check constraint")
+	}
+	if err := repo.UpdatePriority(ctx, "non_existent", domain.PRPriorityHigh); err != domain.ErrPRNotFound {
+		t.Errorf("UpdatePriority() error = %v, want %v", err, domain.ErrPRNotFound)
+	}
+}
+
+func TestPRRepository_Details(t *testing.T) {
+	repo := NewPRRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	metadata := domain.PRMetadata{
+		Repository:   "org/search",
+		SourceBranch: "feature/search",
+		TargetBranch: "main",
+		URL:          "https://git.example.com/org/search/pull/5",
+		LinesChanged: 240,
+	}
+	pr := &domain.PullRequest{
+		ID:                "pr_5",
+		Title:             "Search index",
+		AuthorID:          "user_3",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"user_1"},
+		Labels:            []string{"backend", "search"},
+		Metadata:          metadata,
+	}
+	if err := repo.SavePR(ctx, pr); err != nil {
+		t.Fatalf("SavePR() error = %v", err)
+	}
+	found, err := repo.FindByID(ctx, "pr_5")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !reflect.DeepEqual(found.Labels, pr.Labels) || found.Metadata != metadata {
+		t.Errorf("FindByID() labels = %v, metadata = %+v, want %v, %+v", found.Labels, found.Metadata, pr.Labels, metadata)
+	}
+
+	// у user_1 на ревью pr_2, pr_3 и pr_5; у pr_3 только метка backend
+	labels := []string{"backend"}
+	entry := domain.AuditEntry{EntityType: domain.AuditEntityPullRequest, EntityID: "pr_3", Action: domain.AuditActionPRUpdated}
+	if err := repo.UpdateDetails(ctx, "pr_3", domain.PRUpdate{Labels: &labels}, entry); err != nil {
+		t.Fatalf("UpdateDetails() error = %v", err)
+	}
+	// поле, не заданное в update, не затирается
+	if err := repo.UpdateDetails(ctx, "pr_3", domain.PRUpdate{Metadata: &domain.PRMetadata{Repository: "org/ui"}}, entry); err != nil {
+		t.Fatalf("UpdateDetails() error = %v", err)
+	}
+	// без изменений журнал не пополняется
+	if err := repo.UpdateDetails(ctx, "pr_3", domain.PRUpdate{Labels: &labels}, entry); err != nil {
+		t.Fatalf("UpdateDetails() error = %v", err)
+	}
+	var audited int
+	if err := testDB.QueryRow(`SELECT COUNT(*) FROM audit_log WHERE entity_id = 'pr_3'`).Scan(&audited); err != nil {
+		t.Fatalf("Failed to count audit entries: %v", err)
+	}
+	if audited != 2 {
+		t.Errorf("Audit entries = %d, want 2", audited)
+	}
+	found, err = repo.FindByID(ctx, "pr_3")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !reflect.DeepEqual(found.Labels, labels) || found.Metadata.Repository != "org/ui" {
+		t.Errorf("FindByID() labels = %v, metadata = %+v, want both updates kept", found.Labels, found.Metadata)
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.ReviewListFilter
+		want   []string
+	}{
+		{name: "no filter", want: []string{"pr_2", "pr_3", "pr_5"}},
+		{name: "one label", filter: domain.ReviewListFilter{Labels: []string{"backend"}}, want: []string{"pr_3", "pr_5"}},
+		{name: "all labels", filter: domain.ReviewListFilter{Labels: []string{"backend", "search"}}, want: []string{"pr_5"}},
+		{name: "repository", filter: domain.ReviewListFilter{Repository: "org/ui"}, want: []string{"pr_3"}},
+		{name: "label and repository", filter: domain.ReviewListFilter{Labels: []string{"search"}, Repository: "org/ui"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prs, err := repo.FindByReviewerID(ctx, "user_1", tt.filter)
+			if err != nil {
+				t.Fatalf("FindByReviewerID() error = %v", err)
+			}
+			got := []string{}
+			for _, pr := range prs {
+				got = append(got, pr.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindByReviewerID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	open, err := repo.FindOpenByReviewerIDs(ctx, []string{"user_1"})
+	if err != nil {
+		t.Fatalf("FindOpenByReviewerIDs() error = %v", err)
+	}
+	for _, pr := range open["user_1"] {
+		if pr.ID == "pr_5" && pr.Metadata != metadata {
+			t.Errorf("FindOpenByReviewerIDs() metadata = %+v, want %+v", pr.Metadata, metadata)
+		}
+	}
+
+	if err := repo.UpdateDetails(ctx, "pr_1", domain.PRUpdate{Metadata: &domain.PRMetadata{LinesChanged: -1}}, entry); err == nil {
+		t.Error("UpdateDetails() with negative lines_changed should violate the check constraint")
+	}
+	if err := repo.UpdateDetails(ctx, "non_existent", domain.PRUpdate{}, entry); err != domain.ErrPRNotFound {
+		t.Errorf("UpdateDetails() error = %v, want %v", err, domain.ErrPRNotFound)
+	}
+}
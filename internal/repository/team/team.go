@@ -5,6 +5,8 @@ import (
 	"database/sql"
 
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository/audit"
+	"avito-test-task/internal/repository/outbox"
 
 	"github.com/lib/pq"
 )
@@ -17,10 +19,25 @@ func NewTeamRepository(db *sql.DB) *TeamRepository {
 	return &TeamRepository{db: db}
 }
 
-func (r *TeamRepository) SaveTeam(ctx context.Context, team *domain.Team) error {
-	query := `INSERT INTO teams (name) VALUES ($1) RETURNING id`
+// SaveTeam создаёт команду и записывает TeamCreated в outbox той же транзакцией
+func (r *TeamRepository) SaveTeam(ctx context.Context, team *domain.Team, audits ...domain.AuditEntry) error {
+	if team.ReviewersCount == 0 {
+		team.ReviewersCount = domain.DefaultReviewersCount
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	err := r.db.QueryRowContext(ctx, query, team.Name).Scan(&team.ID)
+	query := `INSERT INTO teams (name, reviewers_count, review_sla_hours, reassign_after_hours, parent_id, review_rotation)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+
+	err = tx.QueryRowContext(ctx, query,
+		team.Name, team.ReviewersCount, nullHours(team.ReviewSLAHours), nullHours(team.ReassignAfterHours), nullTeamID(team.ParentID),
+		team.ReviewRotation,
+	).Scan(&team.ID)
 	if err != nil {
 		if isUniqueViolation(err) {
 			return domain.ErrTeamExists
@@ -28,33 +45,414 @@ func (r *TeamRepository) SaveTeam(ctx context.Context, team *domain.Team) error
 		return err
 	}
 
-	return nil
+	err = outbox.Append(ctx, tx, domain.DomainEventTeamCreated, domain.AuditEntityTeam, team.Name, domain.TeamCreatedPayload{
+		TeamName:           team.Name,
+		ReviewersCount:     team.ReviewersCount,
+		ReviewSLAHours:     team.ReviewSLAHours,
+		ReassignAfterHours: team.ReassignAfterHours,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *TeamRepository) FindByName(ctx context.Context, name string) (*domain.Team, error) {
-	query := `SELECT id, name FROM teams WHERE name = $1`
+	query := `SELECT ` + teamColumns + ` FROM ` + teamTables + ` WHERE t.name = $1`
 
-	var team domain.Team
-	err := r.db.QueryRowContext(ctx, query, name).Scan(&team.ID, &team.Name)
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query, name))
 
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrTeamNotFound
 	}
 
-	return &team, err
+	return team, err
 }
 
 func (r *TeamRepository) FindByID(ctx context.Context, id int) (*domain.Team, error) {
-	query := `SELECT id, name FROM teams WHERE id = $1`
+	query := `SELECT ` + teamColumns + ` FROM ` + teamTables + ` WHERE t.id = $1`
 
-	var team domain.Team
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&team.ID, &team.Name)
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query, id))
 
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrTeamNotFound
 	}
 
-	return &team, err
+	return team, err
+}
+
+// FindAll возвращает все команды без участников, отсортированные по имени
+func (r *TeamRepository) FindAll(ctx context.Context) ([]*domain.Team, error) {
+	return r.findTeams(ctx, `SELECT `+teamColumns+` FROM `+teamTables+` ORDER BY t.name`)
+}
+
+// FindByIDs возвращает команды с указанными id одним запросом; несуществующие id пропускаются
+func (r *TeamRepository) FindByIDs(ctx context.Context, ids []int) ([]*domain.Team, error) {
+	return r.findTeams(ctx, `SELECT `+teamColumns+` FROM `+teamTables+` WHERE t.id = ANY($1) ORDER BY t.name`, pq.Array(ids))
+}
+
+func (r *TeamRepository) findTeams(ctx context.Context, query string, args ...any) ([]*domain.Team, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*domain.Team
+	for rows.Next() {
+		team, err := scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
+
+// UpdateReviewersCount меняет требуемое число ревьюверов для новых PR команды
+func (r *TeamRepository) UpdateReviewersCount(ctx context.Context, teamID int, count int, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE teams SET reviewers_count = $1 WHERE id = $2",
+		count, teamID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateReviewSLA меняет пороги напоминания и переназначения команды, 0 - значение из конфигурации
+func (r *TeamRepository) UpdateReviewSLA(ctx context.Context, teamID int, slaHours, reassignAfterHours int, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE teams SET review_sla_hours = $1, reassign_after_hours = $2 WHERE id = $3",
+		nullHours(slaHours), nullHours(reassignAfterHours), teamID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateReviewRotation включает или выключает ротацию ревьюверов команды
+func (r *TeamRepository) UpdateReviewRotation(ctx context.Context, teamID int, enabled bool, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE teams SET review_rotation = $1 WHERE id = $2",
+		enabled, teamID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Move делает parentID родителем команды, 0 - переносит команду на верхний уровень.
+// Перенос в собственное поддерево возвращает ErrTeamCycle
+func (r *TeamRepository) Move(ctx context.Context, teamID, parentID int, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", treeLock); err != nil {
+		return err
+	}
+
+	if parentID != 0 {
+		// цикл появится, если команда - предок нового родителя или он сам
+		var cycle bool
+		err := tx.QueryRowContext(ctx, `
+			WITH RECURSIVE ancestors AS (
+				SELECT id, parent_id FROM teams WHERE id = $1
+				UNION ALL
+				SELECT t.id, t.parent_id FROM teams t JOIN ancestors a ON t.id = a.parent_id
+			)
+			SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+		`, parentID, teamID).Scan(&cycle)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return domain.ErrTeamCycle
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, "UPDATE teams SET parent_id = $1 WHERE id = $2", nullTeamID(parentID), teamID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindSubtree возвращает команду и все вложенные в неё: родитель всегда раньше детей, соседи по имени
+func (r *TeamRepository) FindSubtree(ctx context.Context, teamID int) ([]*domain.Team, error) {
+	query := `
+        WITH RECURSIVE tree AS (
+            SELECT id, 0 AS depth FROM teams WHERE id = $1
+            UNION ALL
+            SELECT c.id, tree.depth + 1 FROM teams c JOIN tree ON c.parent_id = tree.id
+        )
+        SELECT ` + teamColumns + `
+        FROM tree
+        JOIN teams t ON t.id = tree.id
+        LEFT JOIN teams p ON p.id = t.parent_id
+        ORDER BY tree.depth, t.name
+    `
+
+	return r.findTeams(ctx, query, teamID)
+}
+
+// FindFallbackIDs возвращает команды, из которых добираются ревьюверы, если в команде нет кандидатов:
+// сначала соседи по родителю, затем сам родитель, затем соседи родителя и так до верхнего уровня
+func (r *TeamRepository) FindFallbackIDs(ctx context.Context, teamID int) ([]int, error) {
+	query := `
+        WITH RECURSIVE ancestors AS (
+            SELECT id, parent_id, 0 AS depth FROM teams WHERE id = $1
+            UNION ALL
+            SELECT t.id, t.parent_id, a.depth + 1 FROM teams t JOIN ancestors a ON t.id = a.parent_id
+        )
+        SELECT id FROM (
+            SELECT s.id, a.depth * 2 AS step, s.name
+            FROM ancestors a
+            JOIN teams s ON s.parent_id = a.parent_id AND s.id <> a.id
+            UNION ALL
+            SELECT p.id, a.depth * 2 + 1, p.name
+            FROM ancestors a
+            JOIN teams p ON p.id = a.parent_id
+        ) fallback
+        ORDER BY step, name
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// subtreeCTE - команды поддерева $1 с глубиной (tree), пары "команда - команда её поддерева" (closure)
+// и PR каждого поддерева (prs). Команда PR - указанная при создании, для старых PR - основная команда автора
+const subtreeCTE = `
+        WITH RECURSIVE tree AS (
+            SELECT id, name, 0 AS depth FROM teams WHERE id = $1
+            UNION ALL
+            SELECT c.id, c.name, tree.depth + 1 FROM teams c JOIN tree ON c.parent_id = tree.id
+        ),
+        closure AS (
+            SELECT id AS root_id, id AS team_id FROM tree
+            UNION ALL
+            SELECT closure.root_id, c.id FROM teams c JOIN closure ON c.parent_id = closure.team_id
+        ),
+        prs AS (
+            SELECT c.root_id, pr.id, pr.status, pr.repository
+            FROM closure c
+            JOIN pull_requests pr ON c.team_id = COALESCE(pr.team_id, (SELECT team_id FROM users WHERE id = pr.author_id))
+        )`
+
+// FindSubtreeStats считает показатели для поддерева каждой команды из поддерева teamID, корень - первый.
+// Показатели PR дополнительно разбиваются по репозиториям
+func (r *TeamRepository) FindSubtreeStats(ctx context.Context, teamID int) ([]domain.TeamStats, error) {
+	query := subtreeCTE + `,
+        members AS (
+            SELECT c.root_id, m.user_id, BOOL_OR(m.is_active AND u.is_active) AS is_active, MAX(u.capacity) AS capacity
+            FROM closure c
+            JOIN team_memberships m ON m.team_id = c.team_id
+            JOIN users u ON u.id = m.user_id
+            GROUP BY c.root_id, m.user_id
+        )
+        SELECT tree.id, tree.name, tree.depth,
+            (SELECT COUNT(*) FROM closure c WHERE c.root_id = tree.id),
+            (SELECT COUNT(*) FROM members m WHERE m.root_id = tree.id),
+            (SELECT COUNT(*) FROM members m WHERE m.root_id = tree.id AND m.is_active),
+            (SELECT COALESCE(SUM(m.capacity), 0) FROM members m WHERE m.root_id = tree.id AND m.is_active),
+            (SELECT COUNT(*) FROM prs WHERE prs.root_id = tree.id AND prs.status = 'OPEN'),
+            (SELECT COUNT(*) FROM prs WHERE prs.root_id = tree.id AND prs.status = 'MERGED'),
+            (SELECT COUNT(*) FROM prs JOIN pr_reviewers r ON r.pr_id = prs.id WHERE prs.root_id = tree.id AND prs.status = 'OPEN')
+        FROM tree
+        ORDER BY tree.depth, tree.name
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []domain.TeamStats
+	byTeam := make(map[int]int)
+	for rows.Next() {
+		var id int
+		s := domain.TeamStats{Repositories: []domain.RepositoryStats{}}
+		err := rows.Scan(&id, &s.TeamName, &s.Depth, &s.Teams, &s.Members, &s.ActiveMembers, &s.ActiveCapacity, &s.OpenPRs, &s.MergedPRs, &s.OpenReviews)
+		if err != nil {
+			return nil, err
+		}
+		byTeam[id] = len(stats)
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.addRepositoryStats(ctx, teamID, stats, byTeam); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// addRepositoryStats дописывает в stats разбивку PR по репозиториям; byTeam - индекс команды в stats
+func (r *TeamRepository) addRepositoryStats(ctx context.Context, teamID int, stats []domain.TeamStats, byTeam map[int]int) error {
+	query := subtreeCTE + `
+        SELECT prs.root_id, prs.repository,
+            COUNT(*) FILTER (WHERE prs.status = 'OPEN'),
+            COUNT(*) FILTER (WHERE prs.status = 'MERGED'),
+            COALESCE(SUM((SELECT COUNT(*) FROM pr_reviewers r WHERE r.pr_id = prs.id)) FILTER (WHERE prs.status = 'OPEN'), 0)
+        FROM prs
+        GROUP BY prs.root_id, prs.repository
+        ORDER BY prs.root_id, prs.repository
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var s domain.RepositoryStats
+		if err := rows.Scan(&id, &s.Repository, &s.OpenPRs, &s.MergedPRs, &s.OpenReviews); err != nil {
+			return err
+		}
+		if i, ok := byTeam[id]; ok {
+			stats[i].Repositories = append(stats[i].Repositories, s)
+		}
+	}
+
+	return rows.Err()
+}
+
+// treeLock - ключ транзакционной advisory-блокировки перемещений: два встречных Move не должны вместе создать цикл
+const treeLock = 0x7465616d
+
+const (
+	teamColumns = "t.id, t.name, t.reviewers_count, t.review_sla_hours, t.reassign_after_hours, t.parent_id, p.name, t.review_rotation"
+	// teamTables - команда t с родителем p, из которых читает teamColumns
+	teamTables = "teams t LEFT JOIN teams p ON p.id = t.parent_id"
+)
+
+// scanTeam читает строку teamColumns; NULL в порогах SLA и родителе становится нулевым значением
+func scanTeam(row interface{ Scan(...any) error }) (*domain.Team, error) {
+	var team domain.Team
+	var slaHours, reassignAfterHours, parentID sql.NullInt32
+	var parentName sql.NullString
+	if err := row.Scan(&team.ID, &team.Name, &team.ReviewersCount, &slaHours, &reassignAfterHours, &parentID, &parentName, &team.ReviewRotation); err != nil {
+		return nil, err
+	}
+	team.ReviewSLAHours = int(slaHours.Int32)
+	team.ReassignAfterHours = int(reassignAfterHours.Int32)
+	team.ParentID = int(parentID.Int32)
+	team.ParentName = parentName.String
+	return &team, nil
+}
+
+func nullTeamID(id int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(id), Valid: id != 0}
+}
+
+func nullHours(hours int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(hours), Valid: hours != 0}
 }
 
 func isUniqueViolation(err error) bool {
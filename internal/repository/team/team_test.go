@@ -4,9 +4,12 @@ import (
 	"avito-test-task/internal/domain"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -96,13 +99,51 @@ func setupTestDB(db *sql.DB) error {
 	migrations := []string{
 		`CREATE TABLE IF NOT EXISTS teams (
 			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) UNIQUE NOT NULL CHECK (name <> '')
+			name VARCHAR(255) UNIQUE NOT NULL CHECK (name <> ''),
+			reviewers_count INTEGER NOT NULL DEFAULT 2 CHECK (reviewers_count BETWEEN 1 AND 10),
+			review_sla_hours INTEGER NULL CHECK (review_sla_hours > 0),
+			reassign_after_hours INTEGER NULL CHECK (reassign_after_hours > review_sla_hours),
+			parent_id INTEGER NULL REFERENCES teams(id) CHECK (parent_id <> id),
+			review_rotation BOOLEAN NOT NULL DEFAULT FALSE
 		)`,
 		`CREATE TABLE IF NOT EXISTS users (
 			id VARCHAR(255) PRIMARY KEY,
 			username VARCHAR(255) NOT NULL,
 			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
-			is_active BOOLEAN DEFAULT TRUE
+			is_active BOOLEAN DEFAULT TRUE,
+			capacity INTEGER NOT NULL DEFAULT 100 CHECK (capacity BETWEEN 0 AND 100)
+		)`,
+		`CREATE TABLE IF NOT EXISTS team_memberships (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+			role VARCHAR(32) NOT NULL DEFAULT 'member',
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+			PRIMARY KEY (user_id, team_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS pull_requests (
+			id VARCHAR(255) PRIMARY KEY,
+			title VARCHAR(500) NOT NULL,
+			author_id VARCHAR(255) NOT NULL REFERENCES users(id),
+			status VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+			team_id INTEGER NULL REFERENCES teams(id),
+			repository VARCHAR(255) NOT NULL DEFAULT ''
+		)`,
+		`CREATE TABLE IF NOT EXISTS pr_reviewers (
+			pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+			reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+			PRIMARY KEY (pr_id, reviewer_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS outbox (
+			id BIGSERIAL PRIMARY KEY,
+			event_type VARCHAR(50) NOT NULL,
+			aggregate_type VARCHAR(50) NOT NULL,
+			aggregate_id VARCHAR(255) NOT NULL,
+			payload JSONB NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_error TEXT NULL,
+			delivered_at TIMESTAMP WITH TIME ZONE NULL
 		)`,
 	}
 
@@ -118,7 +159,11 @@ func cleanupTestDB(db *sql.DB) error {
 	_, err := db.Exec(`
 		TRUNCATE TABLE 
 			users,
-			teams 
+			teams,
+			team_memberships,
+			pull_requests,
+			pr_reviewers,
+			outbox
 		RESTART IDENTITY CASCADE
 	`)
 	return err
@@ -405,6 +450,165 @@ func TestTeamRepository_Integration_SaveAndFind(t *testing.T) {
 	})
 }
 
+func TestTeamRepository_ReviewersCount(t *testing.T) {
+	repo := NewTeamRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("default reviewers count is stored", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		team := &domain.Team{Name: "default-count-team"}
+		if err := repo.SaveTeam(ctx, team); err != nil {
+			t.Fatalf("Failed to save team: %v", err)
+		}
+		if team.ReviewersCount != domain.DefaultReviewersCount {
+			t.Errorf("SaveTeam() ReviewersCount = %d, want %d", team.ReviewersCount, domain.DefaultReviewersCount)
+		}
+
+		found, err := repo.FindByName(ctx, "default-count-team")
+		if err != nil {
+			t.Fatalf("Failed to find team: %v", err)
+		}
+		if found.ReviewersCount != domain.DefaultReviewersCount {
+			t.Errorf("FindByName() ReviewersCount = %d, want %d", found.ReviewersCount, domain.DefaultReviewersCount)
+		}
+	})
+
+	t.Run("custom reviewers count is stored and updated", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		team := &domain.Team{Name: "critical-team", ReviewersCount: 3}
+		if err := repo.SaveTeam(ctx, team); err != nil {
+			t.Fatalf("Failed to save team: %v", err)
+		}
+
+		if err := repo.UpdateReviewersCount(ctx, team.ID, 1); err != nil {
+			t.Fatalf("UpdateReviewersCount() error = %v", err)
+		}
+
+		found, err := repo.FindByID(ctx, team.ID)
+		if err != nil {
+			t.Fatalf("Failed to find team: %v", err)
+		}
+		if found.ReviewersCount != 1 {
+			t.Errorf("FindByID() ReviewersCount = %d, want 1", found.ReviewersCount)
+		}
+	})
+
+	t.Run("update non-existent team", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		err := repo.UpdateReviewersCount(ctx, 9999, 2)
+		if err != domain.ErrTeamNotFound {
+			t.Errorf("UpdateReviewersCount() error = %v, want %v", err, domain.ErrTeamNotFound)
+		}
+	})
+
+	t.Run("out of range count is rejected by database", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		if err := repo.UpdateReviewersCount(ctx, 1, 11); err == nil {
+			t.Error("UpdateReviewersCount() should fail for count above 10")
+		}
+	})
+}
+
+func TestTeamRepository_ReviewSLA(t *testing.T) {
+	repo := NewTeamRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("thresholds are stored and reset to default", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		team := &domain.Team{Name: "sla-team", ReviewSLAHours: 8, ReassignAfterHours: 24}
+		if err := repo.SaveTeam(ctx, team); err != nil {
+			t.Fatalf("Failed to save team: %v", err)
+		}
+
+		found, err := repo.FindByName(ctx, "sla-team")
+		if err != nil {
+			t.Fatalf("Failed to find team: %v", err)
+		}
+		if found.ReviewSLAHours != 8 || found.ReassignAfterHours != 24 {
+			t.Errorf("FindByName() SLA = %d/%d, want 8/24", found.ReviewSLAHours, found.ReassignAfterHours)
+		}
+
+		if err := repo.UpdateReviewSLA(ctx, team.ID, 0, 0); err != nil {
+			t.Fatalf("UpdateReviewSLA() error = %v", err)
+		}
+
+		found, err = repo.FindByID(ctx, team.ID)
+		if err != nil {
+			t.Fatalf("Failed to find team: %v", err)
+		}
+		if found.ReviewSLAHours != 0 || found.ReassignAfterHours != 0 {
+			t.Errorf("FindByID() SLA = %d/%d, want 0/0", found.ReviewSLAHours, found.ReassignAfterHours)
+		}
+	})
+
+	t.Run("update non-existent team", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		err := repo.UpdateReviewSLA(ctx, 9999, 8, 24)
+		if err != domain.ErrTeamNotFound {
+			t.Errorf("UpdateReviewSLA() error = %v, want %v", err, domain.ErrTeamNotFound)
+		}
+	})
+
+	t.Run("reassign before reminder is rejected by database", func(t *testing.T) {
+		cleanAndSetup(t)
+
+		if err := repo.UpdateReviewSLA(ctx, 1, 24, 8); err == nil {
+			t.Error("UpdateReviewSLA() should fail when reassign_after_hours <= review_sla_hours")
+		}
+	})
+}
+
+func TestTeamRepository_SaveTeamOutbox(t *testing.T) {
+	repo := NewTeamRepository(testDB)
+	ctx := context.Background()
+	if err := cleanupTestDB(testDB); err != nil {
+		t.Fatalf("Failed to cleanup: %v", err)
+	}
+
+	if err := repo.SaveTeam(ctx, &domain.Team{Name: "payments", ReviewersCount: 3, ReviewSLAHours: 8}); err != nil {
+		t.Fatalf("SaveTeam() error = %v", err)
+	}
+	if err := repo.SaveTeam(ctx, &domain.Team{Name: "payments"}); !errors.Is(err, domain.ErrTeamExists) {
+		t.Fatalf("SaveTeam() duplicate error = %v, want ErrTeamExists", err)
+	}
+
+	rows, err := testDB.Query(`SELECT event_type, payload FROM outbox WHERE aggregate_type = 'team' AND aggregate_id = 'payments'`)
+	if err != nil {
+		t.Fatalf("Failed to read outbox: %v", err)
+	}
+	defer rows.Close()
+
+	var payloads []domain.TeamCreatedPayload
+	for rows.Next() {
+		var (
+			eventType domain.DomainEventType
+			data      []byte
+			payload   domain.TeamCreatedPayload
+		)
+		if err := rows.Scan(&eventType, &data); err != nil {
+			t.Fatalf("Failed to scan outbox: %v", err)
+		}
+		if eventType != domain.DomainEventTeamCreated {
+			t.Errorf("Event type = %s, want %s", eventType, domain.DomainEventTeamCreated)
+		}
+		if err := json.Unmarshal(data, &payload); err != nil {
+			t.Fatalf("Failed to decode payload: %v", err)
+		}
+		payloads = append(payloads, payload)
+	}
+
+	want := domain.TeamCreatedPayload{TeamName: "payments", ReviewersCount: 3, ReviewSLAHours: 8}
+	if len(payloads) != 1 || payloads[0] != want {
+		t.Errorf("TeamCreated payloads = %+v, want [%+v]", payloads, want)
+	}
+}
+
 func TestTeamRepository_ConcurrentOperations(t *testing.T) {
 	repo := NewTeamRepository(testDB)
 	ctx := context.Background()
@@ -471,3 +675,143 @@ func TestTeamRepository_EmptyAndNullCases(t *testing.T) {
 		}
 	})
 }
+
+func TestTeamRepository_FindAllAndByIDs(t *testing.T) {
+	repo := NewTeamRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	names := func(teams []*domain.Team) []string {
+		var result []string
+		for _, team := range teams {
+			result = append(result, team.Name)
+		}
+		return result
+	}
+
+	all, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if got := names(all); !reflect.DeepEqual(got, []string{"backend-team", "frontend-team", "mobile-team"}) {
+		t.Errorf("FindAll() = %v", got)
+	}
+
+	teams, err := repo.FindByIDs(ctx, []int{3, 1, 999})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+	if got := names(teams); !reflect.DeepEqual(got, []string{"backend-team", "mobile-team"}) {
+		t.Errorf("FindByIDs() = %v, want backend-team and mobile-team", got)
+	}
+}
+
+func TestTeamRepository_Hierarchy(t *testing.T) {
+	repo := NewTeamRepository(testDB)
+	ctx := context.Background()
+	cleanAndSetup(t)
+
+	// 4 engineering: 1 backend-team, 2 frontend-team; 3 mobile-team - верхнего уровня; 5 platform внутри backend-team
+	engineering := &domain.Team{Name: "engineering"}
+	if err := repo.SaveTeam(ctx, engineering); err != nil {
+		t.Fatalf("SaveTeam() error = %v", err)
+	}
+	for _, teamID := range []int{1, 2} {
+		if err := repo.Move(ctx, teamID, engineering.ID); err != nil {
+			t.Fatalf("Move(%d) error = %v", teamID, err)
+		}
+	}
+	platform := &domain.Team{Name: "platform", ParentID: 1}
+	if err := repo.SaveTeam(ctx, platform); err != nil {
+		t.Fatalf("SaveTeam() error = %v", err)
+	}
+
+	found, err := repo.FindByName(ctx, "platform")
+	if err != nil {
+		t.Fatalf("FindByName() error = %v", err)
+	}
+	if found.ParentID != 1 || found.ParentName != "backend-team" {
+		t.Errorf("Parent = %d %q, want backend-team", found.ParentID, found.ParentName)
+	}
+
+	t.Run("subtree lists parents before children", func(t *testing.T) {
+		teams, err := repo.FindSubtree(ctx, engineering.ID)
+		if err != nil {
+			t.Fatalf("FindSubtree() error = %v", err)
+		}
+		var names []string
+		for _, team := range teams {
+			names = append(names, team.Name)
+		}
+		if want := []string{"engineering", "backend-team", "frontend-team", "platform"}; !reflect.DeepEqual(names, want) {
+			t.Errorf("FindSubtree() = %v, want %v", names, want)
+		}
+	})
+
+	t.Run("moving into own subtree is rejected", func(t *testing.T) {
+		if err := repo.Move(ctx, engineering.ID, platform.ID); err != domain.ErrTeamCycle {
+			t.Errorf("Move() error = %v, want ErrTeamCycle", err)
+		}
+		if err := repo.Move(ctx, 1, 1); err != domain.ErrTeamCycle {
+			t.Errorf("Move() to itself error = %v, want ErrTeamCycle", err)
+		}
+		if err := repo.Move(ctx, 999, 1); err != domain.ErrTeamNotFound {
+			t.Errorf("Move() of unknown team error = %v, want ErrTeamNotFound", err)
+		}
+	})
+
+	t.Run("fallback walks siblings then parents", func(t *testing.T) {
+		ids, err := repo.FindFallbackIDs(ctx, platform.ID)
+		if err != nil {
+			t.Fatalf("FindFallbackIDs() error = %v", err)
+		}
+		// у platform нет соседей: родитель backend-team, его сосед frontend-team, затем engineering
+		if want := []int{1, 2, engineering.ID}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("FindFallbackIDs() = %v, want %v", ids, want)
+		}
+
+		ids, err = repo.FindFallbackIDs(ctx, 3)
+		if err != nil {
+			t.Fatalf("FindFallbackIDs() error = %v", err)
+		}
+		if len(ids) != 0 {
+			t.Errorf("Top-level team has no fallback, got %v", ids)
+		}
+	})
+
+	t.Run("stats aggregate the whole subtree", func(t *testing.T) {
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES
+			('u1', 'alice', 1, true), ('u2', 'bob', 2, true), ('u3', 'carol', 5, false)`)
+		testDB.Exec(`INSERT INTO team_memberships (user_id, team_id) VALUES
+			('u1', 1), ('u1', 5), ('u2', 2), ('u3', 5)`)
+		testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status, team_id, repository) VALUES
+			('pr1', 'One', 'u1', 'OPEN', 5, 'acme/api'), ('pr2', 'Two', 'u2', 'MERGED', NULL, 'acme/web'),
+			('pr3', 'Three', 'u1', 'MERGED', 1, 'acme/api'), ('pr4', 'Four', 'u2', 'OPEN', 2, '')`)
+		testDB.Exec(`INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ('pr1', 'u1'), ('pr1', 'u2'), ('pr4', 'u1')`)
+
+		stats, err := repo.FindSubtreeStats(ctx, engineering.ID)
+		if err != nil {
+			t.Fatalf("FindSubtreeStats() error = %v", err)
+		}
+		want := []domain.TeamStats{
+			{TeamName: "engineering", Depth: 0, Teams: 4, Members: 3, ActiveMembers: 2, ActiveCapacity: 200, OpenPRs: 2, MergedPRs: 2, OpenReviews: 3,
+				Repositories: []domain.RepositoryStats{
+					{Repository: "", OpenPRs: 1, OpenReviews: 1},
+					{Repository: "acme/api", OpenPRs: 1, MergedPRs: 1, OpenReviews: 2},
+					{Repository: "acme/web", MergedPRs: 1},
+				}},
+			{TeamName: "backend-team", Depth: 1, Teams: 2, Members: 2, ActiveMembers: 1, ActiveCapacity: 100, OpenPRs: 1, MergedPRs: 1, OpenReviews: 2,
+				Repositories: []domain.RepositoryStats{{Repository: "acme/api", OpenPRs: 1, MergedPRs: 1, OpenReviews: 2}}},
+			{TeamName: "frontend-team", Depth: 1, Teams: 1, Members: 1, ActiveMembers: 1, ActiveCapacity: 100, OpenPRs: 1, MergedPRs: 1, OpenReviews: 1,
+				Repositories: []domain.RepositoryStats{
+					{Repository: "", OpenPRs: 1, OpenReviews: 1},
+					{Repository: "acme/web", MergedPRs: 1},
+				}},
+			{TeamName: "platform", Depth: 2, Teams: 1, Members: 2, ActiveMembers: 1, ActiveCapacity: 100, OpenPRs: 1, OpenReviews: 2,
+				Repositories: []domain.RepositoryStats{{Repository: "acme/api", OpenPRs: 1, OpenReviews: 2}}},
+		}
+		if !reflect.DeepEqual(stats, want) {
+			t.Errorf("FindSubtreeStats() =\n%+v\nwant\n%+v", stats, want)
+		}
+	})
+}
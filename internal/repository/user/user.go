@@ -2,8 +2,13 @@ package user
 
 import (
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository/audit"
+	"avito-test-task/internal/repository/outbox"
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type UserRepository struct {
@@ -14,60 +19,103 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-func (ur *UserRepository) SaveUser(ctx context.Context, user *domain.User) error {
+// SaveUser создаёт пользователя с основной командой user.TeamID или обновляет имя существующего.
+// Основная команда и активность существующего пользователя не меняются: членство в других командах задаёт SaveMembership
+func (ur *UserRepository) SaveUser(ctx context.Context, user *domain.User, audits ...domain.AuditEntry) error {
+	tx, err := ur.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 	INSERT INTO users (id, username, team_id, is_active)
         VALUES ($1, $2, $3, $4)
         ON CONFLICT (id) DO UPDATE SET
-            username = EXCLUDED.username,
-            team_id = EXCLUDED.team_id,
-            is_active = EXCLUDED.is_active
+            username = EXCLUDED.username
 			`
 
-	_, err := ur.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		user.ID,
 		user.Username,
 		user.TeamID,
 		user.IsActive)
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
 func (r *UserRepository) FindByID(ctx context.Context, userID string) (*domain.User, error) {
 	query := `
-        SELECT u.id, u.username, u.team_id, u.is_active, t.name
+        SELECT ` + userColumns + `
         FROM users u
         JOIN teams t ON u.team_id = t.id
         WHERE u.id = $1
     `
 
-	var user domain.User
-	var teamName string
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&user.ID,
-		&user.Username,
-		&user.TeamID,
-		&user.IsActive,
-		&teamName,
-	)
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, userID))
 
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrUserNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	user.Teams, err = r.findMemberships(ctx, user)
+	if err != nil {
+		return nil, err
+	}
 
-	user.TeamName = teamName
-	return &user, err
+	return user, nil
 }
 
-// FindActiveByTeamID ищет активных пользователей команды (исключая автора)
+// findMemberships возвращает команды пользователя, основная - первой
+func (r *UserRepository) findMemberships(ctx context.Context, user *domain.User) ([]domain.TeamMembership, error) {
+	query := `
+        SELECT m.team_id, t.name, m.role, m.is_active
+        FROM team_memberships m
+        JOIN teams t ON t.id = m.team_id
+        WHERE m.user_id = $1
+        ORDER BY m.team_id <> $2, t.name
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, user.ID, user.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var memberships []domain.TeamMembership
+	for rows.Next() {
+		var m domain.TeamMembership
+		if err := rows.Scan(&m.TeamID, &m.TeamName, &m.Role, &m.IsActive); err != nil {
+			return nil, err
+		}
+		m.IsPrimary = m.TeamID == user.TeamID
+		memberships = append(memberships, m)
+	}
+
+	return memberships, rows.Err()
+}
+
+// FindActiveByTeamID ищет участников команды, активных и в ней, и в целом (исключая автора); Role - роль в этой команде
 func (r *UserRepository) FindActiveByTeamID(ctx context.Context, teamID int, excludeUserID string) ([]*domain.User, error) {
 	query := `
-        SELECT id, username, team_id, is_active
-        FROM users 
-        WHERE team_id = $1 
-        AND is_active = true 
-        AND id != $2
-        ORDER BY id
+        SELECT u.id, u.username, u.team_id, u.is_active, u.capacity, m.role, ` + userTags + `
+        FROM team_memberships m
+        JOIN users u ON u.id = m.user_id
+        WHERE m.team_id = $1
+        AND m.is_active = true
+        AND u.is_active = true
+        AND u.id != $2
+        ORDER BY u.id
     `
 
 	rows, err := r.db.QueryContext(ctx, query, teamID, excludeUserID)
@@ -84,6 +132,9 @@ func (r *UserRepository) FindActiveByTeamID(ctx context.Context, teamID int, exc
 			&user.Username,
 			&user.TeamID,
 			&user.IsActive,
+			&user.Capacity,
+			&user.Role,
+			pq.Array(&user.Tags),
 			// &user.CreatedAt,
 			// &user.UpdatedAt,
 		); err != nil {
@@ -95,35 +146,52 @@ func (r *UserRepository) FindActiveByTeamID(ctx context.Context, teamID int, exc
 	return users, rows.Err()
 }
 
-// UpdateActivity обновляет флаг активности
-func (r *UserRepository) UpdateActivity(ctx context.Context, userID string, isActive bool) error {
-	query := `UPDATE users SET is_active = $1 WHERE id = $2`
-	// , updated_at = $2
-
-	result, err := r.db.ExecContext(ctx, query, isActive, userID)
+// UpdateActivity меняет флаг активности; фактическое изменение записывает UserActivityChanged в outbox той же транзакцией
+func (r *UserRepository) UpdateActivity(ctx context.Context, userID string, isActive bool, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	rows, err := result.RowsAffected()
+	var wasActive bool
+	err = tx.QueryRowContext(ctx, `SELECT is_active FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&wasActive)
+	if err == sql.ErrNoRows {
+		return domain.ErrUserNotFound
+	}
 	if err != nil {
 		return err
 	}
 
-	if rows == 0 {
-		return domain.ErrUserNotFound
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET is_active = $1 WHERE id = $2`, isActive, userID); err != nil {
+		return err
 	}
 
-	return nil
+	if wasActive != isActive {
+		err = outbox.Append(ctx, tx, domain.DomainEventUserActivityChanged, domain.AuditEntityUser, userID, domain.UserActivityChangedPayload{
+			UserID:   userID,
+			IsActive: isActive,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-// FindByTeamID возвращает всех пользователей команды
+// FindByTeamID возвращает всех участников команды, в том числе тех, для кого она не основная
 func (r *UserRepository) FindByTeamID(ctx context.Context, teamID int) ([]*domain.User, error) {
 	query := `
-        SELECT id, username, team_id, is_active
-        FROM users 
-        WHERE team_id = $1
-        ORDER BY id
+        SELECT u.id, u.username, u.team_id, u.is_active, u.capacity
+        FROM team_memberships m
+        JOIN users u ON u.id = m.user_id
+        WHERE m.team_id = $1
+        ORDER BY u.id
     `
 
 	rows, err := r.db.QueryContext(ctx, query, teamID)
@@ -140,6 +208,7 @@ func (r *UserRepository) FindByTeamID(ctx context.Context, teamID int) ([]*domai
 			&user.Username,
 			&user.TeamID,
 			&user.IsActive,
+			&user.Capacity,
 		); err != nil {
 			return nil, err
 		}
@@ -148,3 +217,421 @@ func (r *UserRepository) FindByTeamID(ctx context.Context, teamID int) ([]*domai
 
 	return users, rows.Err()
 }
+
+// FindByIDs возвращает пользователей с указанными id одним запросом; несуществующие id пропускаются
+func (r *UserRepository) FindByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	query := `
+        SELECT ` + userColumns + `
+        FROM users u
+        JOIN teams t ON u.team_id = t.id
+        WHERE u.id = ANY($1)
+        ORDER BY u.id
+    `
+
+	return r.findUsers(ctx, query, pq.Array(userIDs))
+}
+
+// FindByTeamIDs возвращает участников нескольких команд одним запросом, сгруппированных по команде.
+// IsActive участника учитывает и его членство в этой команде
+func (r *UserRepository) FindByTeamIDs(ctx context.Context, teamIDs []int) (map[int][]*domain.User, error) {
+	query := `
+        SELECT m.team_id, m.is_active, ` + userColumns + `
+        FROM team_memberships m
+        JOIN users u ON u.id = m.user_id
+        JOIN teams t ON u.team_id = t.id
+        WHERE m.team_id = ANY($1)
+        ORDER BY m.team_id, u.id
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(teamIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make(map[int][]*domain.User)
+	for rows.Next() {
+		var teamID int
+		var membershipActive bool
+		user, err := scanUser(prefixScanner{row: rows, prefix: []any{&teamID, &membershipActive}})
+		if err != nil {
+			return nil, err
+		}
+		user.IsActive = user.IsActive && membershipActive
+		members[teamID] = append(members[teamID], user)
+	}
+
+	return members, rows.Err()
+}
+
+// FindMembers возвращает участников команды с их ролью; IsActive учитывает и пользователя, и членство
+func (r *UserRepository) FindMembers(ctx context.Context, teamID int) ([]domain.TeamMember, error) {
+	query := `
+        SELECT u.id, u.username, u.is_active AND m.is_active, m.role, u.capacity
+        FROM team_memberships m
+        JOIN users u ON u.id = m.user_id
+        WHERE m.team_id = $1
+        ORDER BY u.id
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []domain.TeamMember{}
+	for rows.Next() {
+		var member domain.TeamMember
+		if err := rows.Scan(&member.UserID, &member.Username, &member.IsActive, &member.Role, &member.Capacity); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// SaveMembership добавляет пользователя в команду или меняет роль и активность существующего членства
+func (r *UserRepository) SaveMembership(ctx context.Context, userID string, teamID int, role domain.MemberRole, isActive bool, audits ...domain.AuditEntry) error {
+	if role == "" {
+		role = domain.MemberRoleMember
+	}
+
+	query := `
+        INSERT INTO team_memberships (user_id, team_id, role, is_active)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (user_id, team_id) DO UPDATE SET
+            role = EXCLUDED.role,
+            is_active = EXCLUDED.is_active
+    `
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query, userID, teamID, string(role), isActive); err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveMembership исключает пользователя из команды; основную команду сначала нужно сменить
+func (r *UserRepository) RemoveMembership(ctx context.Context, userID string, teamID int, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// блокировка строки не даёт SetPrimaryTeam сделать команду основной, пока членство удаляется
+	var primaryTeamID int
+	err = tx.QueryRowContext(ctx, `SELECT team_id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&primaryTeamID)
+	if err == sql.ErrNoRows {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if primaryTeamID == teamID {
+		return domain.ErrPrimaryTeam
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM team_memberships WHERE user_id = $1 AND team_id = $2`, userID, teamID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotTeamMember
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetPrimaryTeam делает основной командой пользователя одну из тех, где он уже состоит
+func (r *UserRepository) SetPrimaryTeam(ctx context.Context, userID string, teamID int, audits ...domain.AuditEntry) error {
+	query := `
+        UPDATE users u SET team_id = m.team_id
+        FROM team_memberships m
+        WHERE m.user_id = u.id AND u.id = $1 AND m.team_id = $2
+    `
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, userID, teamID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotTeamMember
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserRepository) findUsers(ctx context.Context, query string, args ...any) ([]*domain.User, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// UpdateCapacity меняет долю ревью пользователя в процентах
+func (r *UserRepository) UpdateCapacity(ctx context.Context, userID string, capacity int, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE users SET capacity = $1 WHERE id = $2`, capacity, userID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateLastSeen запоминает heartbeat пользователя; более ранний seenAt, пришедший с опозданием, время не откатывает
+func (r *UserRepository) UpdateLastSeen(ctx context.Context, userID string, seenAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET last_seen_at = GREATEST(last_seen_at, $1) WHERE id = $2`,
+		seenAt, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// SetTags заменяет теги экспертизы пользователя целиком
+func (r *UserRepository) SetTags(ctx context.Context, userID string, tags []string, audits ...domain.AuditEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT TRUE FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_tags WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO user_tags (user_id, tag) SELECT $1, tag FROM unnest($2::text[]) AS tag`,
+		userID, pq.Array(tags),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindTags возвращает теги каждого из userIDs; пользователей без тегов в ответе нет
+func (r *UserRepository) FindTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT user_id, tag FROM user_tags WHERE user_id = ANY($1) ORDER BY user_id, tag`,
+		pq.Array(userIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string, len(userIDs))
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, err
+		}
+		tags[userID] = append(tags[userID], tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// FindByTag ищет пользователей с тегом; teamID != 0 оставляет только участников этой команды
+func (r *UserRepository) FindByTag(ctx context.Context, tag string, teamID int) ([]*domain.User, error) {
+	query := `
+        SELECT ` + userColumns + `
+        FROM user_tags tg
+        JOIN users u ON u.id = tg.user_id
+        JOIN teams t ON u.team_id = t.id
+        WHERE tg.tag = $1
+        AND ($2 = 0 OR EXISTS (SELECT 1 FROM team_memberships m WHERE m.user_id = u.id AND m.team_id = $2))
+        ORDER BY u.id
+    `
+
+	return r.findUsers(ctx, query, tag, teamID)
+}
+
+// UpdateNotifications заменяет адреса и предпочтения уведомлений пользователя
+func (r *UserRepository) UpdateNotifications(ctx context.Context, userID string, settings domain.NotificationSettings, audits ...domain.AuditEntry) error {
+	query := `
+        UPDATE users
+        SET email = $1, chat_handle = $2, locale = $3, mute_email = $4, mute_chat = $5
+        WHERE id = $6
+    `
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query,
+		nullString(settings.Email),
+		nullString(settings.ChatHandle),
+		nullString(string(settings.Locale)),
+		settings.MuteEmail,
+		settings.MuteChat,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	if err := audit.Append(ctx, tx, audits...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// userColumns - колонки для scanUser, запрос должен соединять users u и teams t
+const userColumns = `u.id, u.username, u.team_id, u.is_active, u.capacity, t.name,
+            u.email, u.chat_handle, u.locale, u.mute_email, u.mute_chat, u.last_seen_at, ` + userTags
+
+// userTags - теги пользователя u в алфавитном порядке
+const userTags = `ARRAY(SELECT ut.tag FROM user_tags ut WHERE ut.user_id = u.id ORDER BY ut.tag)`
+
+func scanUser(row interface{ Scan(...any) error }) (*domain.User, error) {
+	var user domain.User
+	var email, chatHandle, locale sql.NullString
+	var lastSeenAt sql.NullTime
+	if err := row.Scan(
+		&user.ID,
+		&user.Username,
+		&user.TeamID,
+		&user.IsActive,
+		&user.Capacity,
+		&user.TeamName,
+		&email,
+		&chatHandle,
+		&locale,
+		&user.Notifications.MuteEmail,
+		&user.Notifications.MuteChat,
+		&lastSeenAt,
+		pq.Array(&user.Tags),
+	); err != nil {
+		return nil, err
+	}
+	if lastSeenAt.Valid {
+		user.LastSeenAt = &lastSeenAt.Time
+	}
+	user.Notifications.Email = email.String
+	user.Notifications.ChatHandle = chatHandle.String
+	user.Notifications.Locale = domain.Locale(locale.String)
+	return &user, nil
+}
+
+// prefixScanner читает колонки запроса, идущие перед userColumns
+type prefixScanner struct {
+	row    interface{ Scan(...any) error }
+	prefix []any
+}
+
+func (s prefixScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(s.prefix, dest...)...)
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -4,9 +4,11 @@ import (
 	"avito-test-task/internal/domain"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -104,7 +106,50 @@ func setupTestDB(db *sql.DB) error {
 			id VARCHAR(255) PRIMARY KEY,
 			username VARCHAR(255) NOT NULL,
 			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
-			is_active BOOLEAN DEFAULT TRUE
+			is_active BOOLEAN DEFAULT TRUE,
+			capacity INTEGER NOT NULL DEFAULT 100 CHECK (capacity BETWEEN 0 AND 100),
+			email VARCHAR(255) NULL,
+			chat_handle VARCHAR(255) NULL,
+			locale VARCHAR(8) NULL CHECK (locale IN ('ru', 'en')),
+			mute_email BOOLEAN NOT NULL DEFAULT FALSE,
+			mute_chat BOOLEAN NOT NULL DEFAULT FALSE,
+			last_seen_at TIMESTAMP WITH TIME ZONE NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS user_tags (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			tag VARCHAR(64) NOT NULL CHECK (tag <> ''),
+			PRIMARY KEY (user_id, tag)
+		)`,
+		`CREATE TABLE IF NOT EXISTS team_memberships (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+			role VARCHAR(32) NOT NULL DEFAULT 'member' CHECK (role IN ('lead', 'senior', 'member', 'trainee')),
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+			joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, team_id)
+		)`,
+		`CREATE OR REPLACE FUNCTION ensure_primary_membership() RETURNS trigger AS $$
+		BEGIN
+			INSERT INTO team_memberships (user_id, team_id) VALUES (NEW.id, NEW.team_id)
+			ON CONFLICT DO NOTHING;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql`,
+		`CREATE OR REPLACE TRIGGER users_ensure_primary_membership
+			AFTER INSERT OR UPDATE OF team_id ON users
+			FOR EACH ROW
+			EXECUTE FUNCTION ensure_primary_membership()`,
+		`CREATE TABLE IF NOT EXISTS outbox (
+			id BIGSERIAL PRIMARY KEY,
+			event_type VARCHAR(50) NOT NULL,
+			aggregate_type VARCHAR(50) NOT NULL,
+			aggregate_id VARCHAR(255) NOT NULL,
+			payload JSONB NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_error TEXT NULL,
+			delivered_at TIMESTAMP WITH TIME ZONE NULL
 		)`,
 		`INSERT INTO teams (name) VALUES 
 			('backend-team'),
@@ -140,7 +185,7 @@ func TestUserRepository_SaveUser(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "successful update existing user",
+			name: "update existing user keeps primary team and activity",
 			user: &domain.User{
 				ID:       "user_1",
 				Username: "alice_updated",
@@ -151,7 +196,17 @@ func TestUserRepository_SaveUser(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
+	// повторное сохранение меняет только имя: в другую команду пользователя добавляет SaveMembership
+	want := []struct {
+		username string
+		teamID   int
+		isActive bool
+	}{
+		{"alice", 1, true},
+		{"alice_updated", 1, true},
+	}
+
+	for i, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := repo.SaveUser(ctx, tt.user)
 			if (err != nil) != tt.wantErr {
@@ -170,9 +225,14 @@ func TestUserRepository_SaveUser(t *testing.T) {
 					return
 				}
 
-				if username != tt.user.Username || teamID != tt.user.TeamID || isActive != tt.user.IsActive {
-					t.Errorf("User data mismatch: got (%s, %d, %t), want (%s, %d, %t)",
-						username, teamID, isActive, tt.user.Username, tt.user.TeamID, tt.user.IsActive)
+				if username != want[i].username || teamID != want[i].teamID || isActive != want[i].isActive {
+					t.Errorf("User data mismatch: got (%s, %d, %t), want %+v", username, teamID, isActive, want[i])
+				}
+
+				var memberships int
+				testDB.QueryRow("SELECT COUNT(*) FROM team_memberships WHERE user_id = $1", tt.user.ID).Scan(&memberships)
+				if memberships != 1 {
+					t.Errorf("User has %d memberships, want only the primary one", memberships)
 				}
 			}
 		})
@@ -352,11 +412,381 @@ func TestUserRepository_UpdateActivity(t *testing.T) {
 	}
 }
 
+func TestUserRepository_UpdateActivityOutbox(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+	if err := cleanupTestDB(testDB); err != nil {
+		t.Fatalf("Failed to cleanup: %v", err)
+	}
+
+	if err := repo.SaveUser(ctx, &domain.User{ID: "user_1", Username: "alice", TeamID: 1, IsActive: true}); err != nil {
+		t.Fatalf("SaveUser() error = %v", err)
+	}
+
+	// без изменения флага событие не пишется
+	for _, active := range []bool{true, false, false} {
+		if err := repo.UpdateActivity(ctx, "user_1", active); err != nil {
+			t.Fatalf("UpdateActivity(%v) error = %v", active, err)
+		}
+	}
+
+	var count int
+	var payload []byte
+	if err := testDB.QueryRow(
+		`SELECT COUNT(*), MAX(payload::text) FROM outbox WHERE aggregate_type = 'user' AND aggregate_id = 'user_1' AND event_type = $1`,
+		domain.DomainEventUserActivityChanged,
+	).Scan(&count, &payload); err != nil {
+		t.Fatalf("Failed to read outbox: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("Expected 1 UserActivityChanged event, got %d", count)
+	}
+	var got domain.UserActivityChangedPayload
+	if err := json.Unmarshal(payload, &got); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if got != (domain.UserActivityChangedPayload{UserID: "user_1", IsActive: false}) {
+		t.Errorf("Payload = %+v", got)
+	}
+}
+
+func TestUserRepository_UpdateNotifications(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	if err := repo.SaveUser(ctx, &domain.User{ID: "notify_user", Username: "notify_test", TeamID: 1, IsActive: true}); err != nil {
+		t.Fatalf("Failed to setup test user: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, "notify_user")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Notifications != (domain.NotificationSettings{}) {
+		t.Errorf("New user notifications = %+v, want zero value", found.Notifications)
+	}
+
+	settings := domain.NotificationSettings{
+		Email:      "notify@example.com",
+		ChatHandle: "@notify",
+		Locale:     domain.LocaleEN,
+		MuteChat:   true,
+	}
+	if err := repo.UpdateNotifications(ctx, "notify_user", settings); err != nil {
+		t.Fatalf("UpdateNotifications() error = %v", err)
+	}
+
+	// SaveUser из /team/add не должен сбрасывать адреса
+	if err := repo.SaveUser(ctx, &domain.User{ID: "notify_user", Username: "renamed", TeamID: 2, IsActive: true}); err != nil {
+		t.Fatalf("Failed to resave user: %v", err)
+	}
+
+	users, err := repo.FindByIDs(ctx, []string{"notify_user"})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+	if len(users) != 1 || users[0].Notifications != settings {
+		t.Errorf("FindByIDs() notifications = %+v, want %+v", users, settings)
+	}
+
+	if err := repo.UpdateNotifications(ctx, "non_existent", settings); err != domain.ErrUserNotFound {
+		t.Errorf("UpdateNotifications() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
+
+func TestUserRepository_UpdateCapacity(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	if err := repo.SaveUser(ctx, &domain.User{ID: "capacity_user", Username: "capacity_test", TeamID: 1, IsActive: true}); err != nil {
+		t.Fatalf("Failed to setup test user: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, "capacity_user")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Capacity != domain.MaxCapacity {
+		t.Errorf("New user capacity = %d, want %d", found.Capacity, domain.MaxCapacity)
+	}
+
+	if err := repo.UpdateCapacity(ctx, "capacity_user", 0); err != nil {
+		t.Fatalf("UpdateCapacity() error = %v", err)
+	}
+
+	// SaveUser из /team/add не должен сбрасывать долю ревью
+	if err := repo.SaveUser(ctx, &domain.User{ID: "capacity_user", Username: "renamed", TeamID: 1, IsActive: true}); err != nil {
+		t.Fatalf("Failed to resave user: %v", err)
+	}
+
+	candidates, err := repo.FindActiveByTeamID(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("FindActiveByTeamID() error = %v", err)
+	}
+	for _, c := range candidates {
+		if c.ID == "capacity_user" && c.Capacity != 0 {
+			t.Errorf("FindActiveByTeamID() capacity = %d, want 0", c.Capacity)
+		}
+	}
+
+	if err := repo.UpdateCapacity(ctx, "capacity_user", domain.MaxCapacity+1); err == nil {
+		t.Error("UpdateCapacity() above the maximum should violate the check constraint")
+	}
+	if err := repo.UpdateCapacity(ctx, "non_existent", 50); err != domain.ErrUserNotFound {
+		t.Errorf("UpdateCapacity() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
+
+func TestUserRepository_UpdateLastSeen(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	if err := repo.SaveUser(ctx, &domain.User{ID: "seen_user", Username: "seen_test", TeamID: 1, IsActive: true}); err != nil {
+		t.Fatalf("Failed to setup test user: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, "seen_user")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.LastSeenAt != nil {
+		t.Errorf("New user LastSeenAt = %v, want nil", found.LastSeenAt)
+	}
+
+	seenAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	if err := repo.UpdateLastSeen(ctx, "seen_user", seenAt); err != nil {
+		t.Fatalf("UpdateLastSeen() error = %v", err)
+	}
+	// запоздавший heartbeat время не откатывает
+	if err := repo.UpdateLastSeen(ctx, "seen_user", seenAt.Add(-time.Minute)); err != nil {
+		t.Fatalf("UpdateLastSeen() error = %v", err)
+	}
+
+	found, err = repo.FindByID(ctx, "seen_user")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.LastSeenAt == nil || !found.LastSeenAt.Equal(seenAt) {
+		t.Errorf("LastSeenAt = %v, want %v", found.LastSeenAt, seenAt)
+	}
+
+	if err := repo.UpdateLastSeen(ctx, "non_existent", seenAt); err != domain.ErrUserNotFound {
+		t.Errorf("UpdateLastSeen() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
+
 func cleanupTestDB(db *sql.DB) error {
 	_, err := db.Exec(`
         TRUNCATE TABLE 
-            users
+            users,
+            outbox
         RESTART IDENTITY CASCADE
     `)
 	return err
 }
+
+func TestUserRepository_FindByIDsAndTeamIDs(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	for _, u := range []*domain.User{
+		{ID: "batch_user_1", Username: "alice", TeamID: 1, IsActive: true},
+		{ID: "batch_user_2", Username: "bob", TeamID: 2, IsActive: false},
+	} {
+		if err := repo.SaveUser(ctx, u); err != nil {
+			t.Fatalf("Failed to setup test user: %v", err)
+		}
+	}
+
+	users, err := repo.FindByIDs(ctx, []string{"batch_user_2", "batch_user_1", "non_existent_user"})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("FindByIDs() count = %d, want 2", len(users))
+	}
+	if users[0].ID != "batch_user_1" || users[0].TeamName != "backend-team" ||
+		users[1].ID != "batch_user_2" || users[1].TeamName != "frontend-team" || users[1].IsActive {
+		t.Errorf("FindByIDs() = %+v, %+v", users[0], users[1])
+	}
+
+	if err := repo.SaveMembership(ctx, "batch_user_1", 2, domain.MemberRoleLead, false); err != nil {
+		t.Fatalf("SaveMembership() error = %v", err)
+	}
+
+	members, err := repo.FindByTeamIDs(ctx, []int{2})
+	if err != nil {
+		t.Fatalf("FindByTeamIDs() error = %v", err)
+	}
+	var found, guest bool
+	for _, u := range members[2] {
+		switch u.ID {
+		case "batch_user_2":
+			found = u.TeamName == "frontend-team"
+		case "batch_user_1":
+			// основная команда остаётся прежней, а неактивное членство делает участника неактивным в этой команде
+			guest = u.TeamName == "backend-team" && !u.IsActive
+		}
+	}
+	if !found || !guest {
+		t.Errorf("FindByTeamIDs() = %+v", members[2])
+	}
+}
+
+func TestUserRepository_Memberships(t *testing.T) {
+	cleanupTestDB(testDB)
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	for _, u := range []*domain.User{
+		{ID: "m_alice", Username: "alice", TeamID: 1, IsActive: true},
+		{ID: "m_bob", Username: "bob", TeamID: 1, IsActive: true},
+		{ID: "m_carol", Username: "carol", TeamID: 2, IsActive: true},
+	} {
+		if err := repo.SaveUser(ctx, u); err != nil {
+			t.Fatalf("Failed to setup test user: %v", err)
+		}
+	}
+
+	if err := repo.SaveMembership(ctx, "m_alice", 2, domain.MemberRoleLead, true); err != nil {
+		t.Fatalf("SaveMembership() error = %v", err)
+	}
+	if err := repo.SaveMembership(ctx, "m_bob", 2, "", false); err != nil {
+		t.Fatalf("SaveMembership() error = %v", err)
+	}
+
+	t.Run("user lists all teams, primary first", func(t *testing.T) {
+		alice, err := repo.FindByID(ctx, "m_alice")
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		want := []domain.TeamMembership{
+			{TeamID: 1, TeamName: "backend-team", Role: domain.MemberRoleMember, IsActive: true, IsPrimary: true},
+			{TeamID: 2, TeamName: "frontend-team", Role: domain.MemberRoleLead, IsActive: true},
+		}
+		if len(alice.Teams) != len(want) {
+			t.Fatalf("Teams = %+v, want %+v", alice.Teams, want)
+		}
+		for i := range want {
+			if alice.Teams[i] != want[i] {
+				t.Errorf("Teams[%d] = %+v, want %+v", i, alice.Teams[i], want[i])
+			}
+		}
+	})
+
+	t.Run("candidates come from memberships", func(t *testing.T) {
+		candidates, err := repo.FindActiveByTeamID(ctx, 2, "m_carol")
+		if err != nil {
+			t.Fatalf("FindActiveByTeamID() error = %v", err)
+		}
+		if len(candidates) != 1 || candidates[0].ID != "m_alice" || candidates[0].TeamID != 1 {
+			t.Errorf("FindActiveByTeamID() = %+v, want only m_alice with her primary team", candidates)
+		}
+
+		members, err := repo.FindMembers(ctx, 2)
+		if err != nil {
+			t.Fatalf("FindMembers() error = %v", err)
+		}
+		want := []domain.TeamMember{
+			{UserID: "m_alice", Username: "alice", IsActive: true, Role: domain.MemberRoleLead, Capacity: domain.MaxCapacity},
+			{UserID: "m_bob", Username: "bob", IsActive: false, Role: domain.MemberRoleMember, Capacity: domain.MaxCapacity},
+			{UserID: "m_carol", Username: "carol", IsActive: true, Role: domain.MemberRoleMember, Capacity: domain.MaxCapacity},
+		}
+		if len(members) != len(want) {
+			t.Fatalf("FindMembers() = %+v, want %+v", members, want)
+		}
+		for i := range want {
+			if members[i] != want[i] {
+				t.Errorf("members[%d] = %+v, want %+v", i, members[i], want[i])
+			}
+		}
+	})
+
+	t.Run("primary team", func(t *testing.T) {
+		if err := repo.RemoveMembership(ctx, "m_alice", 1); err != domain.ErrPrimaryTeam {
+			t.Errorf("RemoveMembership(primary) error = %v, want %v", err, domain.ErrPrimaryTeam)
+		}
+		if err := repo.SetPrimaryTeam(ctx, "m_carol", 1); err != domain.ErrNotTeamMember {
+			t.Errorf("SetPrimaryTeam(not a member) error = %v, want %v", err, domain.ErrNotTeamMember)
+		}
+
+		if err := repo.SetPrimaryTeam(ctx, "m_alice", 2); err != nil {
+			t.Fatalf("SetPrimaryTeam() error = %v", err)
+		}
+		if err := repo.RemoveMembership(ctx, "m_alice", 1); err != nil {
+			t.Fatalf("RemoveMembership() error = %v", err)
+		}
+
+		alice, err := repo.FindByID(ctx, "m_alice")
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if alice.TeamName != "frontend-team" || len(alice.Teams) != 1 || !alice.Teams[0].IsPrimary {
+			t.Errorf("FindByID() = %+v", alice)
+		}
+
+		if err := repo.RemoveMembership(ctx, "m_alice", 1); err != domain.ErrNotTeamMember {
+			t.Errorf("RemoveMembership() twice error = %v, want %v", err, domain.ErrNotTeamMember)
+		}
+	})
+}
+
+func TestUserRepository_Tags(t *testing.T) {
+	repo := NewUserRepository(testDB)
+	ctx := context.Background()
+
+	for _, u := range []*domain.User{
+		{ID: "tags_user_1", Username: "tags_1", TeamID: 1, IsActive: true},
+		{ID: "tags_user_2", Username: "tags_2", TeamID: 2, IsActive: true},
+	} {
+		if err := repo.SaveUser(ctx, u); err != nil {
+			t.Fatalf("Failed to setup test user: %v", err)
+		}
+	}
+
+	if err := repo.SetTags(ctx, "tags_user_1", []string{"rust"}); err != nil {
+		t.Fatalf("SetTags() error = %v", err)
+	}
+	if err := repo.SetTags(ctx, "tags_user_1", []string{"go", "postgres"}); err != nil {
+		t.Fatalf("SetTags() error = %v", err)
+	}
+	if err := repo.SetTags(ctx, "tags_user_2", []string{"go"}); err != nil {
+		t.Fatalf("SetTags() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, "tags_user_1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if want := []string{"go", "postgres"}; !reflect.DeepEqual(found.Tags, want) {
+		t.Errorf("Tags = %v, want %v", found.Tags, want)
+	}
+
+	tags, err := repo.FindTags(ctx, []string{"tags_user_1", "tags_user_2", "non_existent"})
+	if err != nil {
+		t.Fatalf("FindTags() error = %v", err)
+	}
+	want := map[string][]string{"tags_user_1": {"go", "postgres"}, "tags_user_2": {"go"}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("FindTags() = %v, want %v", tags, want)
+	}
+
+	all, err := repo.FindByTag(ctx, "go", 0)
+	if err != nil {
+		t.Fatalf("FindByTag() error = %v", err)
+	}
+	if len(all) != 2 || all[0].ID != "tags_user_1" || all[1].ID != "tags_user_2" {
+		t.Errorf("FindByTag() = %+v, want tags_user_1 and tags_user_2", all)
+	}
+	inTeam, err := repo.FindByTag(ctx, "go", 2)
+	if err != nil {
+		t.Fatalf("FindByTag() error = %v", err)
+	}
+	if len(inTeam) != 1 || inTeam[0].ID != "tags_user_2" {
+		t.Errorf("FindByTag(team 2) = %+v, want tags_user_2", inTeam)
+	}
+
+	if err := repo.SetTags(ctx, "non_existent", []string{"go"}); err != domain.ErrUserNotFound {
+		t.Errorf("SetTags() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
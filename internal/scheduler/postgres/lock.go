@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log"
+	"time"
+)
+
+// unlockTimeout ограничивает снятие блокировки, когда контекст задачи уже отменён
+const unlockTimeout = 5 * time.Second
+
+// AdvisoryLock - сессионная advisory-блокировка Postgres. Она держится на выделенном соединении
+// и снимается сама, если реплика упала вместе с соединением
+type AdvisoryLock struct {
+	db  *sql.DB
+	key int64
+}
+
+func NewAdvisoryLock(db *sql.DB, key int64) *AdvisoryLock {
+	return &AdvisoryLock{db: db, key: key}
+}
+
+func (l *AdvisoryLock) TryLock(ctx context.Context) (func(), bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), unlockTimeout)
+		defer cancel()
+
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+			// соединение с неснятой блокировкой нельзя возвращать в пул: его закрытие снимает блокировку
+			log.Printf("Advisory lock %d release failed: %v", l.key, err)
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+	return unlock, true, nil
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Locker - блокировка, общая для всех реплик. TryLock не ждёт: если блокировку держит другая реплика,
+// он возвращает acquired=false
+type Locker interface {
+	TryLock(ctx context.Context) (unlock func(), acquired bool, err error)
+}
+
+// Job - периодическая задача, now - время запуска
+type Job func(ctx context.Context, now time.Time) error
+
+// Scheduler запускает задачу раз в интервал на той реплике, которой досталась блокировка
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	locker   Locker
+	job      Job
+}
+
+func New(name string, interval time.Duration, locker Locker, job Job) *Scheduler {
+	return &Scheduler{
+		name:     name,
+		interval: interval,
+		locker:   locker,
+		job:      job,
+	}
+}
+
+// Run запускает задачу сразу и затем раз в интервал до отмены ctx; ошибки только пишутся в лог
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Scheduled job %s failed: %v", s.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce выполняет задачу под блокировкой; ran=false, если задачу сейчас выполняет другая реплика
+func (s *Scheduler) RunOnce(ctx context.Context) (ran bool, err error) {
+	unlock, acquired, err := s.locker.TryLock(ctx)
+	if err != nil {
+		return false, fmt.Errorf("acquire lock: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer unlock()
+
+	return true, s.job(ctx, time.Now())
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeLocker struct {
+	busy     bool
+	err      error
+	unlocked int
+}
+
+func (l *fakeLocker) TryLock(context.Context) (func(), bool, error) {
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	if l.busy {
+		return nil, false, nil
+	}
+	return func() { l.unlocked++ }, true, nil
+}
+
+func TestRunOnce(t *testing.T) {
+	jobErr := errors.New("job failed")
+
+	tests := []struct {
+		name      string
+		locker    *fakeLocker
+		jobErr    error
+		wantRan   bool
+		wantErr   bool
+		wantCalls int
+	}{
+		{name: "runs job under lock", locker: &fakeLocker{}, wantRan: true, wantCalls: 1},
+		{name: "skips when another replica holds lock", locker: &fakeLocker{busy: true}},
+		{name: "lock error", locker: &fakeLocker{err: errors.New("connection refused")}, wantErr: true},
+		{name: "job error releases lock", locker: &fakeLocker{}, jobErr: jobErr, wantRan: true, wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			s := New("test", time.Minute, tt.locker, func(context.Context, time.Time) error {
+				calls++
+				return tt.jobErr
+			})
+
+			ran, err := s.RunOnce(context.Background())
+			if ran != tt.wantRan || (err != nil) != tt.wantErr {
+				t.Fatalf("RunOnce() = %v, %v; want ran=%v, error=%v", ran, err, tt.wantRan, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("job calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if tt.locker.unlocked != tt.wantCalls {
+				t.Errorf("unlock calls = %d, want %d", tt.locker.unlocked, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	s := New("test", 10*time.Millisecond, &fakeLocker{}, func(context.Context, time.Time) error {
+		if calls.Add(1) == 3 {
+			cancel()
+		}
+		return nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run() did not stop after context cancel")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("job calls = %d, want 3", got)
+	}
+}
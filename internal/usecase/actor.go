@@ -0,0 +1,19 @@
+package usecase
+
+import "context"
+
+// SystemActor записывается в журнал, когда инициатор изменения неизвестен
+const SystemActor = "system"
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository/audit"
+	pullrequest "avito-test-task/internal/repository/pull_request"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+type AuditUseCase struct {
+	auditRepo audit.AuditRepository
+	prRepo    pullrequest.PRRepository
+}
+
+func NewAuditUseCase(auditRepo audit.AuditRepository, prRepo pullrequest.PRRepository) *AuditUseCase {
+	return &AuditUseCase{
+		auditRepo: auditRepo,
+		prRepo:    prRepo,
+	}
+}
+
+// GetPRHistory возвращает полную историю PR: создание, назначения, переназначения, merge
+func (uc *AuditUseCase) GetPRHistory(ctx context.Context, prID string) ([]*domain.AuditEntry, error) {
+	if _, err := uc.prRepo.FindByID(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	return uc.auditRepo.Find(ctx, domain.AuditFilter{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+	})
+}
+
+func (uc *AuditUseCase) FindEntries(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditEntry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit > maxAuditLimit {
+		filter.Limit = maxAuditLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	return uc.auditRepo.Find(ctx, filter)
+}
+
+// auditEntry подписывает запись журнала инициатором запроса. Запись передаётся методу репозитория,
+// который сохраняет её в транзакции изменения: журнал не расходится с данными
+func auditEntry(ctx context.Context, entry domain.AuditEntry) domain.AuditEntry {
+	entry.Actor = ActorFromContext(ctx)
+	return entry
+}
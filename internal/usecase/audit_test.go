@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"testing"
+)
+
+func TestAuditUseCase_GetPRHistory(t *testing.T) {
+	setupTestData(t)
+	ctx := WithActor(context.Background(), "lead_1")
+
+	if _, err := prUseCase.CreatePR(ctx, "pr_history", "Audited PR", "user_1"); err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	if _, err := prUseCase.MergePR(ctx, "pr_history"); err != nil {
+		t.Fatalf("Failed to merge PR: %v", err)
+	}
+
+	history, err := auditUseCase.GetPRHistory(ctx, "pr_history")
+	if err != nil {
+		t.Fatalf("GetPRHistory() error = %v", err)
+	}
+
+	wantActions := []domain.AuditAction{
+		domain.AuditActionPRCreated,
+		domain.AuditActionReviewerAssigned,
+		domain.AuditActionPRMerged,
+	}
+	if len(history) != len(wantActions) {
+		t.Fatalf("History has %d entries, want %d", len(history), len(wantActions))
+	}
+
+	for i, entry := range history {
+		if entry.Action != wantActions[i] {
+			t.Errorf("Entry %d action = %s, want %s", i, entry.Action, wantActions[i])
+		}
+		if entry.Actor != "lead_1" {
+			t.Errorf("Entry %d actor = %s, want lead_1", i, entry.Actor)
+		}
+	}
+
+	assigned := history[1]
+	if assigned.Reason != domain.AuditReasonAutoAssign {
+		t.Errorf("Assignment reason = %s, want %s", assigned.Reason, domain.AuditReasonAutoAssign)
+	}
+	if assigned.NewValue["reviewer_id"] != "user_5" {
+		t.Errorf("Assigned reviewer = %v, want user_5", assigned.NewValue["reviewer_id"])
+	}
+}
+
+func TestAuditUseCase_GetPRHistoryNotFound(t *testing.T) {
+	setupTestData(t)
+
+	_, err := auditUseCase.GetPRHistory(context.Background(), "non_existent_pr")
+	if err != domain.ErrPRNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrPRNotFound, err)
+	}
+}
+
+func TestAuditUseCase_FindEntries(t *testing.T) {
+	setupTestData(t)
+	ctx := context.Background()
+
+	if _, err := userUseCase.SetUserActivity(ctx, "user_3", false); err != nil {
+		t.Fatalf("Failed to deactivate user: %v", err)
+	}
+	if _, err := teamUseCase.CreateTeam(ctx, &domain.Team{
+		Name:    "platform-team",
+		Members: []domain.TeamMember{{UserID: "user_4", Username: "dave", IsActive: true}},
+	}); err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+
+	t.Run("deactivation is recorded with reason and system actor", func(t *testing.T) {
+		entries, err := auditUseCase.FindEntries(ctx, domain.AuditFilter{
+			EntityType: domain.AuditEntityUser,
+			Action:     domain.AuditActionUserActivityChanged,
+		})
+		if err != nil {
+			t.Fatalf("FindEntries() error = %v", err)
+		}
+		if len(entries) != 1 {
+			t.Fatalf("Expected 1 entry, got %d", len(entries))
+		}
+		if entries[0].Reason != domain.AuditReasonDeactivation {
+			t.Errorf("Reason = %s, want %s", entries[0].Reason, domain.AuditReasonDeactivation)
+		}
+		if entries[0].Actor != SystemActor {
+			t.Errorf("Actor = %s, want %s", entries[0].Actor, SystemActor)
+		}
+		if entries[0].OldValue["is_active"] != true || entries[0].NewValue["is_active"] != false {
+			t.Errorf("Unexpected values: %v -> %v", entries[0].OldValue, entries[0].NewValue)
+		}
+	})
+
+	t.Run("joining another team keeps primary team", func(t *testing.T) {
+		entries, err := auditUseCase.FindEntries(ctx, domain.AuditFilter{
+			EntityType: domain.AuditEntityUser,
+			EntityID:   "user_4",
+			Action:     domain.AuditActionUserSaved,
+		})
+		if err != nil {
+			t.Fatalf("FindEntries() error = %v", err)
+		}
+		if len(entries) != 1 {
+			t.Fatalf("Expected 1 entry, got %d", len(entries))
+		}
+		if entries[0].OldValue["team_name"] != "frontend-team" || entries[0].NewValue["team_name"] != "frontend-team" {
+			t.Errorf("Team changed: %v -> %v", entries[0].OldValue["team_name"], entries[0].NewValue["team_name"])
+		}
+	})
+
+	t.Run("new membership is recorded for the team", func(t *testing.T) {
+		entries, err := auditUseCase.FindEntries(ctx, domain.AuditFilter{
+			EntityType: domain.AuditEntityTeam,
+			EntityID:   "platform-team",
+			Action:     domain.AuditActionMembershipChanged,
+		})
+		if err != nil {
+			t.Fatalf("FindEntries() error = %v", err)
+		}
+		if len(entries) != 1 {
+			t.Fatalf("Expected 1 entry, got %d", len(entries))
+		}
+		if entries[0].OldValue != nil {
+			t.Errorf("Old value = %v, want nil", entries[0].OldValue)
+		}
+		if entries[0].NewValue["user_id"] != "user_4" || entries[0].NewValue["role"] != "member" {
+			t.Errorf("New value = %v", entries[0].NewValue)
+		}
+	})
+
+	t.Run("limit is applied", func(t *testing.T) {
+		entries, err := auditUseCase.FindEntries(ctx, domain.AuditFilter{Limit: 1})
+		if err != nil {
+			t.Fatalf("FindEntries() error = %v", err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("Expected 1 entry, got %d", len(entries))
+		}
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 // DB_HOST=localhost DB_PORT=5433 DB_USER=postgres DB_PASSWORD=password go test -v ./internal/usecase/...
 
 import (
+	"avito-test-task/internal/repository/audit"
 	pullrequest "avito-test-task/internal/repository/pull_request"
 	"avito-test-task/internal/repository/team"
 	"avito-test-task/internal/repository/user"
@@ -25,6 +26,8 @@ var teamRepo *team.TeamRepository
 var teamUseCase *TeamUseCase
 var prRepo *pullrequest.PRRepository
 var prUseCase PRUseCase
+var auditRepo *audit.AuditRepository
+var auditUseCase *AuditUseCase
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
@@ -97,10 +100,12 @@ func TestMain(m *testing.M) {
 
 	teamRepo = team.NewTeamRepository(testDB)
 	userRepo = user.NewUserRepository(testDB)
+	auditRepo = audit.NewAuditRepository(testDB)
 	userUseCase = NewUserUseCase(*userRepo)
 	teamUseCase = NewTeamUseCase(*teamRepo, *userRepo)
 	prRepo = pullrequest.NewPRRepository(testDB)
 	prUseCase = *NewPRUseCase(*prRepo, *userRepo, *teamRepo)
+	auditUseCase = NewAuditUseCase(*auditRepo, *prRepo)
 	code := m.Run()
 
 	testDB.Close()
@@ -111,31 +116,102 @@ func setupTestDB(db *sql.DB) error {
 	migrations := []string{
 		`CREATE TABLE IF NOT EXISTS teams (
 			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) UNIQUE NOT NULL CHECK (name <> '')
+			name VARCHAR(255) UNIQUE NOT NULL CHECK (name <> ''),
+			reviewers_count INTEGER NOT NULL DEFAULT 2 CHECK (reviewers_count BETWEEN 1 AND 10),
+			review_sla_hours INTEGER NULL CHECK (review_sla_hours > 0),
+			reassign_after_hours INTEGER NULL CHECK (reassign_after_hours > review_sla_hours),
+			parent_id INTEGER NULL REFERENCES teams(id) CHECK (parent_id <> id),
+			review_rotation BOOLEAN NOT NULL DEFAULT FALSE
 		)`,
 		`CREATE TABLE IF NOT EXISTS users (
 			id VARCHAR(255) PRIMARY KEY,
 			username VARCHAR(255) NOT NULL CHECK (username <> ''),
 			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
-			is_active BOOLEAN DEFAULT TRUE
+			is_active BOOLEAN DEFAULT TRUE,
+			capacity INTEGER NOT NULL DEFAULT 100 CHECK (capacity BETWEEN 0 AND 100),
+			email VARCHAR(255) NULL,
+			chat_handle VARCHAR(255) NULL,
+			locale VARCHAR(8) NULL CHECK (locale IN ('ru', 'en')),
+			mute_email BOOLEAN NOT NULL DEFAULT FALSE,
+			mute_chat BOOLEAN NOT NULL DEFAULT FALSE,
+			last_seen_at TIMESTAMP WITH TIME ZONE NULL
 		)`,
+		`CREATE TABLE IF NOT EXISTS user_tags (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			tag VARCHAR(64) NOT NULL CHECK (tag <> ''),
+			PRIMARY KEY (user_id, tag)
+		)`,
+		`CREATE TABLE IF NOT EXISTS team_memberships (
+			user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+			role VARCHAR(32) NOT NULL DEFAULT 'member' CHECK (role IN ('lead', 'senior', 'member', 'trainee')),
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+			joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, team_id)
+		)`,
+		`CREATE OR REPLACE FUNCTION ensure_primary_membership() RETURNS trigger AS $$
+		BEGIN
+			INSERT INTO team_memberships (user_id, team_id) VALUES (NEW.id, NEW.team_id)
+			ON CONFLICT DO NOTHING;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql`,
+		`CREATE OR REPLACE TRIGGER users_ensure_primary_membership
+			AFTER INSERT OR UPDATE OF team_id ON users
+			FOR EACH ROW
+			EXECUTE FUNCTION ensure_primary_membership()`,
 		`CREATE TABLE IF NOT EXISTS pull_requests (
 			id VARCHAR(255) PRIMARY KEY,
 			title VARCHAR(500) NOT NULL CHECK (title <> ''),
 			author_id VARCHAR(255) NOT NULL REFERENCES users(id),
 			status VARCHAR(50) NOT NULL DEFAULT 'OPEN',
 			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-			merged_at TIMESTAMP WITH TIME ZONE NULL
+			merged_at TIMESTAMP WITH TIME ZONE NULL,
+			required_reviewers INTEGER NOT NULL DEFAULT 2 CHECK (required_reviewers BETWEEN 1 AND 10),
+			team_id INTEGER NULL REFERENCES teams(id),
+			required_tags TEXT[] NOT NULL DEFAULT '{}',
+			priority VARCHAR(16) NOT NULL DEFAULT 'normal' CHECK (priority IN ('low', 'normal', 'high', 'hotfix')),
+			labels TEXT[] NOT NULL DEFAULT '{}',
+			repository VARCHAR(255) NOT NULL DEFAULT '',
+			source_branch VARCHAR(255) NOT NULL DEFAULT '',
+			target_branch VARCHAR(255) NOT NULL DEFAULT '',
+			url VARCHAR(2048) NOT NULL DEFAULT '',
+			lines_changed INTEGER NOT NULL DEFAULT 0 CHECK (lines_changed >= 0)
 		);
 		CREATE INDEX IF NOT EXISTS idx_pr_author_id ON pull_requests(author_id);
 		CREATE INDEX IF NOT EXISTS idx_pr_status ON pull_requests(status);`,
 		`CREATE TABLE IF NOT EXISTS pr_reviewers (
 			pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
 			reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+			assigned_at TIMESTAMP WITH TIME ZONE NULL DEFAULT CURRENT_TIMESTAMP,
+			reminded_at TIMESTAMP WITH TIME ZONE NULL,
 			PRIMARY KEY(pr_id, reviewer_id)
 		);
 		CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr_id ON pr_reviewers(pr_id);
 		CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_id ON pr_reviewers(reviewer_id);`,
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id VARCHAR(255) NOT NULL,
+			action VARCHAR(50) NOT NULL,
+			actor VARCHAR(255) NOT NULL DEFAULT 'system',
+			reason VARCHAR(50) NOT NULL DEFAULT '',
+			old_value JSONB NULL,
+			new_value JSONB NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS outbox (
+			id BIGSERIAL PRIMARY KEY,
+			event_type VARCHAR(50) NOT NULL,
+			aggregate_type VARCHAR(50) NOT NULL,
+			aggregate_id VARCHAR(255) NOT NULL,
+			payload JSONB NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			last_error TEXT NULL,
+			delivered_at TIMESTAMP WITH TIME ZONE NULL
+		)`,
 		// Test data
 		`INSERT INTO teams (name) VALUES 
 			('backend-team'),
@@ -174,7 +250,9 @@ func cleanupTestDB(db *sql.DB) error {
 			users,
 			teams,
 			pull_requests,
-			pr_reviewers
+			pr_reviewers,
+			audit_log,
+			outbox
 		RESTART IDENTITY CASCADE
 	`)
 	return err
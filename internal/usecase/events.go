@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository/audit"
+	pullrequest "avito-test-task/internal/repository/pull_request"
+	"avito-test-task/internal/repository/team"
+	"avito-test-task/internal/repository/user"
+)
+
+// eventActions - действия журнала, которые попадают в поток событий
+var eventActions = map[domain.AuditAction]domain.ReviewEventType{
+	domain.AuditActionPRCreated:        domain.EventPRCreated,
+	domain.AuditActionReviewerAssigned: domain.EventReviewerAssigned,
+	domain.AuditActionReviewerReplaced: domain.EventReviewerReassigned,
+	domain.AuditActionPRMerged:         domain.EventPRMerged,
+}
+
+// EventUseCase строит события потока назначений из журнала изменений
+type EventUseCase struct {
+	auditRepo audit.AuditRepository
+	prRepo    pullrequest.PRRepository
+	userRepo  user.UserRepository
+	teamRepo  team.TeamRepository
+}
+
+func NewEventUseCase(auditRepo audit.AuditRepository, prRepo pullrequest.PRRepository, userRepo user.UserRepository, teamRepo team.TeamRepository) *EventUseCase {
+	return &EventUseCase{
+		auditRepo: auditRepo,
+		prRepo:    prRepo,
+		userRepo:  userRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+// LastEventID возвращает id, после которого начинается живой поток
+func (uc *EventUseCase) LastEventID(ctx context.Context) (int64, error) {
+	return uc.auditRepo.LastID(ctx)
+}
+
+// EventsAfter возвращает до limit событий с id больше afterID в порядке id и id последней просмотренной
+// записи журнала, с которого продолжается чтение. Записи, чей PR, автор или команда уже не находятся,
+// пропускаются, чтобы одна битая запись не останавливала поток
+func (uc *EventUseCase) EventsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.ReviewEvent, int64, error) {
+	actions := make([]domain.AuditAction, 0, len(eventActions))
+	for action := range eventActions {
+		actions = append(actions, action)
+	}
+
+	entries, err := uc.auditRepo.FindAfterID(ctx, afterID, actions, limit)
+	if err != nil {
+		return nil, afterID, err
+	}
+
+	// в одной пачке обычно несколько событий одного PR: создание и назначения
+	prs := make(map[string]*domain.PullRequest)
+	teams := make(map[int]string)
+
+	next := afterID
+	events := make([]*domain.ReviewEvent, 0, len(entries))
+	for _, entry := range entries {
+		event, err := uc.buildEvent(ctx, entry, prs, teams)
+		switch {
+		case errors.Is(err, domain.ErrPRNotFound), errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrTeamNotFound):
+			log.Printf("Skipping review event %d of %s: %v", entry.ID, entry.EntityID, err)
+		case err != nil:
+			return nil, afterID, err
+		default:
+			events = append(events, event)
+		}
+		next = entry.ID
+	}
+
+	return events, next, nil
+}
+
+func (uc *EventUseCase) buildEvent(ctx context.Context, entry *domain.AuditEntry, prs map[string]*domain.PullRequest, teams map[int]string) (*domain.ReviewEvent, error) {
+	pr, ok := prs[entry.EntityID]
+	if !ok {
+		var err error
+		if pr, err = uc.prRepo.FindByID(ctx, entry.EntityID); err != nil {
+			return nil, err
+		}
+		prs[entry.EntityID] = pr
+	}
+
+	teamID, err := uc.prTeamID(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	teamName, ok := teams[teamID]
+	if !ok {
+		if teamName, err = uc.teamName(ctx, teamID); err != nil {
+			return nil, err
+		}
+		teams[teamID] = teamName
+	}
+
+	return &domain.ReviewEvent{
+		ID:            entry.ID,
+		Type:          eventActions[entry.Action],
+		PullRequestID: pr.ID,
+		Title:         pr.Title,
+		AuthorID:      pr.AuthorID,
+		TeamName:      teamName,
+		ReviewerID:    stringValue(entry.NewValue, "reviewer_id"),
+		OldReviewerID: stringValue(entry.OldValue, "reviewer_id"),
+		Reviewers:     pr.AssignedReviewers,
+		Actor:         entry.Actor,
+		CreatedAt:     entry.CreatedAt,
+	}, nil
+}
+
+// prTeamID возвращает команду, в которой создан PR; для PR без команды - основную команду автора
+func (uc *EventUseCase) prTeamID(ctx context.Context, pr *domain.PullRequest) (int, error) {
+	if pr.TeamID != 0 {
+		return pr.TeamID, nil
+	}
+	author, err := uc.userRepo.FindByID(ctx, pr.AuthorID)
+	if err != nil {
+		return 0, err
+	}
+	return author.TeamID, nil
+}
+
+func (uc *EventUseCase) teamName(ctx context.Context, teamID int) (string, error) {
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+	return team.Name, nil
+}
+
+func stringValue(values map[string]any, key string) string {
+	value, _ := values[key].(string)
+	return value
+}
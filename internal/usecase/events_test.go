@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+)
+
+func TestEventUseCase_EventsAfter(t *testing.T) {
+	setupTestData(t)
+	ctx := context.Background()
+	testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+
+	eventUC := NewEventUseCase(*auditRepo, *prRepo, *userRepo, *teamRepo)
+	startID, err := eventUC.LastEventID(ctx)
+	if err != nil {
+		t.Fatalf("LastEventID() error = %v", err)
+	}
+
+	uc := newSeededPRUseCase(1, time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC))
+	if _, err := uc.CreatePR(ctx, "pr_events", "Events PR", "user_1"); err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	if _, err := uc.MergePR(ctx, "pr_events"); err != nil {
+		t.Fatalf("MergePR() error = %v", err)
+	}
+
+	events, next, err := eventUC.EventsAfter(ctx, startID, 100)
+	if err != nil {
+		t.Fatalf("EventsAfter() error = %v", err)
+	}
+	if next != events[len(events)-1].ID {
+		t.Errorf("next = %d, want id of the last event %d", next, events[len(events)-1].ID)
+	}
+
+	var types []domain.ReviewEventType
+	for _, event := range events {
+		types = append(types, event.Type)
+		if event.PullRequestID != "pr_events" || event.AuthorID != "user_1" || event.TeamName != "backend-team" {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	}
+	want := []domain.ReviewEventType{
+		domain.EventPRCreated,
+		domain.EventReviewerAssigned,
+		domain.EventReviewerAssigned,
+		domain.EventPRMerged,
+	}
+	if !reflect.DeepEqual(types, want) {
+		t.Errorf("Event types = %v, want %v", types, want)
+	}
+	if events[1].ReviewerID == "" {
+		t.Error("reviewer_assigned event should carry reviewer_id")
+	}
+}
+
+func TestEventUseCase_EventsAfterTeamAndDanglingEntries(t *testing.T) {
+	setupTestData(t)
+	ctx := context.Background()
+	testDB.Exec("INSERT INTO team_memberships (user_id, team_id) VALUES ('user_1', 2)")
+
+	eventUC := NewEventUseCase(*auditRepo, *prRepo, *userRepo, *teamRepo)
+	startID, err := eventUC.LastEventID(ctx)
+	if err != nil {
+		t.Fatalf("LastEventID() error = %v", err)
+	}
+
+	if _, err := prUseCase.CreatePR(ctx, "pr_gone", "Deleted PR", "user_1"); err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	if _, err := prUseCase.CreatePR(ctx, "pr_frontend", "Frontend PR", "user_1", WithTeam("frontend-team")); err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	// записи журнала удалённого PR остаются, но событий из них не построить
+	testDB.Exec("DELETE FROM pull_requests WHERE id = 'pr_gone'")
+
+	lastID, err := eventUC.LastEventID(ctx)
+	if err != nil {
+		t.Fatalf("LastEventID() error = %v", err)
+	}
+
+	events, next, err := eventUC.EventsAfter(ctx, startID, 100)
+	if err != nil {
+		t.Fatalf("EventsAfter() error = %v", err)
+	}
+	if next != lastID {
+		t.Errorf("next = %d, want %d", next, lastID)
+	}
+	if len(events) == 0 {
+		t.Fatal("Expected events of pr_frontend")
+	}
+	for _, event := range events {
+		// команда события - команда PR, а не основная команда автора
+		if event.PullRequestID != "pr_frontend" || event.TeamName != "frontend-team" {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/notify"
+)
+
+// WithNotifier подключает уведомления о назначениях, переназначениях и merge.
+// Notify вызывается после сохранения изменений и не должен надолго блокировать запрос
+func WithNotifier(notifier notify.Notifier) PROption {
+	return func(uc *PRUseCase) {
+		uc.notifier = notifier
+	}
+}
+
+// notify отправляет уведомление kind каждому из recipientIDs. Ошибки доставки только пишутся в лог:
+// операция над PR к этому моменту уже выполнена
+func (uc *PRUseCase) notify(ctx context.Context, kind notify.Kind, pr *domain.PullRequest, recipientIDs []string, fill func(*notify.Notification)) {
+	if uc.notifier == nil || len(recipientIDs) == 0 {
+		return
+	}
+	// уведомление отправляется и после отмены запроса: изменение уже сохранено
+	ctx = context.WithoutCancel(ctx)
+
+	users, err := uc.userRepo.FindByIDs(ctx, append([]string{pr.AuthorID}, recipientIDs...))
+	if err != nil {
+		log.Printf("Failed to load recipients of %s on PR %s: %v", kind, pr.ID, err)
+		return
+	}
+	byID := make(map[string]*domain.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	author := byID[pr.AuthorID]
+	if author == nil {
+		author = &domain.User{ID: pr.AuthorID, Username: pr.AuthorID}
+	}
+
+	// PR может быть создан не в основной команде автора
+	teamName := author.TeamName
+	if pr.TeamID != 0 && pr.TeamID != author.TeamID {
+		if team, err := uc.teamRepo.FindByID(ctx, pr.TeamID); err == nil {
+			teamName = team.Name
+		} else {
+			log.Printf("Failed to load team of PR %s for %s: %v", pr.ID, kind, err)
+		}
+	}
+
+	for _, id := range recipientIDs {
+		recipient, ok := byID[id]
+		if !ok {
+			continue
+		}
+
+		n := notify.Notification{
+			Kind:            kind,
+			Recipient:       *recipient,
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Title,
+			AuthorID:        pr.AuthorID,
+			AuthorName:      author.Username,
+			TeamName:        teamName,
+			ReviewerID:      id,
+		}
+		if fill != nil {
+			fill(&n)
+		}
+		if err := uc.notifier.Notify(ctx, n); err != nil {
+			log.Printf("Failed to notify %s about %s on PR %s: %v", id, kind, pr.ID, err)
+		}
+	}
+}
+
+// usernameOf возвращает имя пользователя для текста уведомления, без пользователя - его id
+func (uc *PRUseCase) usernameOf(ctx context.Context, userID string) string {
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return userID
+	}
+	return u.Username
+}
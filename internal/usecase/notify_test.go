@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-task/internal/notify"
+)
+
+func TestPRUseCase_Notifications(t *testing.T) {
+	ctx := context.Background()
+
+	kinds := func(notifications []notify.Notification) map[notify.Kind][]string {
+		result := make(map[notify.Kind][]string)
+		for _, n := range notifications {
+			result[n.Kind] = append(result[n.Kind], n.Recipient.ID)
+		}
+		return result
+	}
+
+	t.Run("create, reassign and merge notify participants", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec(`
+			INSERT INTO users (id, username, team_id, is_active, email, locale)
+			VALUES ('user_6', 'eve', 2, true, 'eve@example.com', 'en')
+		`)
+
+		notifier := &recordingNotifier{}
+		uc := NewPRUseCase(*prRepo, *userRepo, *teamRepo, WithNotifier(notifier))
+
+		pr, err := uc.CreatePR(ctx, "pr_notify", "Notify PR", "user_3", WithReviewersCount(1))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		assigned := kinds(notifier.notifications)[notify.KindReviewerAssigned]
+		if len(assigned) != 1 || assigned[0] != pr.AssignedReviewers[0] {
+			t.Fatalf("Assigned notifications = %v, want %v", assigned, pr.AssignedReviewers)
+		}
+		first := notifier.notifications[0]
+		if first.AuthorName != "charlie" || first.TeamName != "frontend-team" || first.PullRequestName != "Notify PR" {
+			t.Errorf("Notification = %+v", first)
+		}
+
+		oldReviewer := pr.AssignedReviewers[0]
+		notifier.notifications = nil
+		newReviewer, err := uc.ReassignReviewer(ctx, "pr_notify", oldReviewer)
+		if err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+		got := kinds(notifier.notifications)
+		if len(got[notify.KindReviewerAssigned]) != 1 || got[notify.KindReviewerAssigned][0] != newReviewer ||
+			len(got[notify.KindReviewerReassigned]) != 1 || got[notify.KindReviewerReassigned][0] != oldReviewer {
+			t.Errorf("Notifications after reassign = %v", got)
+		}
+		for _, n := range notifier.notifications {
+			if n.Kind == notify.KindReviewerReassigned && n.NewReviewerID != newReviewer {
+				t.Errorf("NewReviewerID = %q, want %q", n.NewReviewerID, newReviewer)
+			}
+		}
+
+		notifier.notifications = nil
+		if _, err := uc.MergePR(WithActor(ctx, "user_3"), "pr_notify"); err != nil {
+			t.Fatalf("MergePR() error = %v", err)
+		}
+		merged := kinds(notifier.notifications)[notify.KindPRMerged]
+		if len(merged) != 1 || merged[0] != newReviewer {
+			t.Errorf("Merged notifications = %v, want only reviewer %s (author merged it)", merged, newReviewer)
+		}
+	})
+
+	t.Run("manual add and top-up notify new reviewers", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+		testDB.Exec("INSERT INTO team_memberships (user_id, team_id) VALUES ('user_1', 2)")
+
+		notifier := &recordingNotifier{}
+		uc := NewPRUseCase(*prRepo, *userRepo, *teamRepo, WithNotifier(notifier))
+
+		// PR создан в команде frontend-team, основная команда автора - backend-team
+		if _, err := uc.CreatePR(ctx, "pr_manual", "Manual PR", "user_1", WithTeam("frontend-team"), WithReviewersCount(1)); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		for _, n := range notifier.notifications {
+			if n.TeamName != "frontend-team" {
+				t.Errorf("TeamName = %q, want frontend-team", n.TeamName)
+			}
+		}
+		testDB.Exec("DELETE FROM pr_reviewers WHERE pr_id = 'pr_manual'")
+		testDB.Exec("UPDATE pull_requests SET required_reviewers = 2 WHERE id = 'pr_manual'")
+
+		notifier.notifications = nil
+		if _, err := uc.AddReviewer(ctx, "pr_manual", "user_2"); err != nil {
+			t.Fatalf("AddReviewer() error = %v", err)
+		}
+		if got := kinds(notifier.notifications)[notify.KindReviewerAssigned]; len(got) != 1 || got[0] != "user_2" {
+			t.Errorf("Assigned notifications after AddReviewer = %v, want [user_2]", got)
+		}
+
+		notifier.notifications = nil
+		_, added, err := uc.TopUpReviewers(ctx, "pr_manual")
+		if err != nil {
+			t.Fatalf("TopUpReviewers() error = %v", err)
+		}
+		got := kinds(notifier.notifications)[notify.KindReviewerAssigned]
+		if len(added) != 1 || len(got) != 1 || got[0] != added[0] {
+			t.Errorf("Assigned notifications after top-up = %v, want %v", got, added)
+		}
+	})
+
+	t.Run("no notifier configured", func(t *testing.T) {
+		setupTestData(t)
+
+		if _, err := prUseCase.CreatePR(ctx, "pr_silent", "Silent PR", "user_3"); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+	})
+}
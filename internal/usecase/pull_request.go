@@ -2,11 +2,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"log"
 	"math/rand"
+	"slices"
 	"time"
 
+	"avito-test-task/internal/assignment"
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/notify"
 	pullrequest "avito-test-task/internal/repository/pull_request"
 	"avito-test-task/internal/repository/team"
 	"avito-test-task/internal/repository/user"
@@ -16,43 +20,218 @@ type PRUseCase struct {
 	prRepo   pullrequest.PRRepository
 	userRepo user.UserRepository
 	teamRepo team.TeamRepository
+	rnd      *lockedRand
+	clock    Clock
+	policy   *assignment.PolicyStore
+	notifier notify.Notifier
 }
 
-func NewPRUseCase(prRepo pullrequest.PRRepository, userRepo user.UserRepository, teamRepo team.TeamRepository) *PRUseCase {
-	return &PRUseCase{
+type PROption func(*PRUseCase)
+
+// WithRandSource задаёт источник случайности для выбора ревьюверов (например, с фиксированным seed)
+func WithRandSource(src rand.Source) PROption {
+	return func(uc *PRUseCase) {
+		uc.rnd = newLockedRand(src)
+	}
+}
+
+// WithClock задаёт часы для CreatedAt/MergedAt
+func WithClock(clock Clock) PROption {
+	return func(uc *PRUseCase) {
+		uc.clock = clock
+	}
+}
+
+// WithPolicyStore подключает хранилище политики назначения, которое обновляется без перезапуска
+func WithPolicyStore(store *assignment.PolicyStore) PROption {
+	return func(uc *PRUseCase) {
+		uc.policy = store
+	}
+}
+
+func NewPRUseCase(prRepo pullrequest.PRRepository, userRepo user.UserRepository, teamRepo team.TeamRepository, opts ...PROption) *PRUseCase {
+	uc := &PRUseCase{
 		prRepo:   prRepo,
 		userRepo: userRepo,
 		teamRepo: teamRepo,
+		rnd:      newLockedRand(rand.NewSource(time.Now().UnixNano())),
+		clock:    SystemClock(),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	if uc.policy == nil {
+		uc.policy = assignment.NewPolicyStore(&assignment.ActivePolicy{
+			Policy:   assignment.DefaultPolicy(),
+			Source:   assignment.DefaultSource,
+			LoadedAt: uc.clock.Now(),
+		})
+	}
+	return uc
+}
+
+// PolicyStatus возвращает активную политику назначения и результат последней перезагрузки
+func (uc *PRUseCase) PolicyStatus() assignment.PolicyStatus {
+	return uc.policy.Status()
+}
+
+type createPROptions struct {
+	reviewersCount int
+	teamName       string
+	requiredTags   []string
+	priority       domain.PRPriority
+	labels         []string
+	metadata       domain.PRMetadata
+}
+
+type CreatePROption func(*createPROptions)
+
+// WithReviewersCount переопределяет число ревьюверов команды для одного PR
+func WithReviewersCount(count int) CreatePROption {
+	return func(o *createPROptions) {
+		o.reviewersCount = count
+	}
+}
+
+// WithTeam назначает ревьюверов из указанной команды автора вместо основной
+func WithTeam(teamName string) CreatePROption {
+	return func(o *createPROptions) {
+		o.teamName = teamName
+	}
+}
+
+// WithRequiredTags просит покрыть теги экспертизы ревьюверами: хотя бы у одного из них должен быть каждый тег
+func WithRequiredTags(tags ...string) CreatePROption {
+	return func(o *createPROptions) {
+		o.requiredTags = tags
+	}
+}
+
+// WithPriority задаёт приоритет PR, по умолчанию domain.PRPriorityNormal
+func WithPriority(priority domain.PRPriority) CreatePROption {
+	return func(o *createPROptions) {
+		o.priority = priority
+	}
+}
+
+// WithLabels задаёт метки PR
+func WithLabels(labels ...string) CreatePROption {
+	return func(o *createPROptions) {
+		o.labels = labels
+	}
+}
+
+// WithMetadata задаёт репозиторий, ветки, ссылку и размер изменений PR
+func WithMetadata(metadata domain.PRMetadata) CreatePROption {
+	return func(o *createPROptions) {
+		o.metadata = metadata
 	}
 }
 
-func (uc *PRUseCase) CreatePR(ctx context.Context, prID, title, authorID string) (*domain.PullRequest, error) {
+func (uc *PRUseCase) CreatePR(ctx context.Context, prID, title, authorID string, opts ...CreatePROption) (*domain.PullRequest, error) {
+	var options createPROptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	requiredTags := domain.NormalizeTags(options.requiredTags)
+	labels := domain.NormalizeLabels(options.labels)
+	input := domain.PullRequest{
+		ID:           prID,
+		Title:        title,
+		AuthorID:     authorID,
+		RequiredTags: requiredTags,
+		Priority:     options.priority,
+		Labels:       labels,
+		Metadata:     options.metadata,
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	priority := options.priority
+	if priority == "" {
+		priority = domain.PRPriorityNormal
+	}
+
+	// политика берётся один раз, чтобы перезагрузка посреди запроса не смешала старые и новые настройки
+	policy := uc.policy.Current()
+
 	author, err := uc.userRepo.FindByID(ctx, authorID)
 	if err != nil {
 		log.Printf("Error searching author: %v", err)
 		return nil, domain.ErrUserNotFound
 	}
 
-	reviewers, err := uc.autoAssignReviewers(ctx, author.TeamID, authorID)
+	teamID, err := uc.prTeam(ctx, author, options.teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := uc.requiredReviewers(ctx, policy, teamID, options.reviewersCount)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers, err := uc.autoAssignReviewers(ctx, policy, teamID, pickRequest{authorID: authorID, tags: requiredTags, priority: priority, n: required})
 	if err != nil {
 		log.Printf("Error in autoAssignReviewers: %v", err)
 		return nil, err
 	}
 
+	uncoveredTags, err := uc.uncoveredTags(ctx, requiredTags, reviewers)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Println(reviewers)
 
+	createdAt := uc.clock.Now()
 	pr := &domain.PullRequest{
 		ID:                prID,
 		Title:             title,
 		AuthorID:          authorID,
 		Status:            domain.PRStatusOpen,
 		AssignedReviewers: reviewers,
+		RequiredReviewers: required,
+		CreatedAt:         &createdAt,
+		TeamID:            teamID,
+		RequiredTags:      requiredTags,
+		UncoveredTags:     uncoveredTags,
+		Priority:          priority,
+		Labels:            labels,
+		Metadata:          options.metadata,
 	}
 
-	if err := uc.prRepo.SavePR(ctx, pr); err != nil {
+	entries := []domain.AuditEntry{auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   pr.ID,
+		Action:     domain.AuditActionPRCreated,
+		NewValue: map[string]any{
+			"title":          pr.Title,
+			"author_id":      pr.AuthorID,
+			"status":         pr.Status,
+			"priority":       pr.Priority,
+			"required_tags":  pr.RequiredTags,
+			"uncovered_tags": pr.UncoveredTags,
+			"labels":         pr.Labels,
+			"metadata":       pr.Metadata,
+		},
+	})}
+	for _, reviewerID := range reviewers {
+		entries = append(entries, auditEntry(ctx, domain.AuditEntry{
+			EntityType: domain.AuditEntityPullRequest,
+			EntityID:   pr.ID,
+			Action:     domain.AuditActionReviewerAssigned,
+			Reason:     domain.AuditReasonAutoAssign,
+			NewValue:   map[string]any{"reviewer_id": reviewerID},
+		}))
+	}
+	if err := uc.prRepo.SavePR(ctx, pr, entries...); err != nil {
 		return nil, err
 	}
 
+	uc.notify(ctx, notify.KindReviewerAssigned, pr, reviewers, nil)
+
 	return pr, nil
 }
 
@@ -70,18 +249,67 @@ func (uc *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequ
 		return pr, nil // idempotence
 	}
 
-	now := time.Now()
+	now := uc.clock.Now()
 	pr.Status = domain.PRStatusMerged
 	pr.MergedAt = &now
 
-	if err := uc.prRepo.UpdateStatus(ctx, prID, domain.PRStatusMerged, &now); err != nil {
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionPRMerged,
+		OldValue:   map[string]any{"status": domain.PRStatusOpen},
+		NewValue:   map[string]any{"status": domain.PRStatusMerged, "merged_at": now},
+	})
+	if err := uc.prRepo.UpdateStatus(ctx, prID, domain.PRStatusMerged, &now, entry); err != nil {
 		return nil, err
 	}
 
+	// кто смёржил, тот об этом знает
+	actor := ActorFromContext(ctx)
+	var recipients []string
+	for _, id := range append([]string{pr.AuthorID}, pr.AssignedReviewers...) {
+		if id != actor {
+			recipients = append(recipients, id)
+		}
+	}
+	uc.notify(ctx, notify.KindPRMerged, pr, recipients, nil)
+
 	return pr, nil
 }
 
+// UpdatePR меняет метки и метаданные PR, в том числе смёрженного; заданное поле update заменяет значение целиком
+func (uc *PRUseCase) UpdatePR(ctx context.Context, prID string, update domain.PRUpdate) (*domain.PullRequest, error) {
+	if update.Labels != nil {
+		labels := domain.NormalizeLabels(*update.Labels)
+		update.Labels = &labels
+	}
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+
+	// прежние значения для журнала репозиторий берёт под блокировкой строки
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionPRUpdated,
+	})
+	if err := uc.prRepo.UpdateDetails(ctx, prID, update, entry); err != nil {
+		return nil, err
+	}
+
+	return uc.prRepo.FindByID(ctx, prID)
+}
+
 func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (string, error) {
+	return uc.reassign(ctx, prID, oldReviewerID, domain.AuditReasonReassign)
+}
+
+// ReassignStaleReviewer заменяет ревьювера, который не сделал ревью за отведённое командой время
+func (uc *PRUseCase) ReassignStaleReviewer(ctx context.Context, prID, oldReviewerID string) (string, error) {
+	return uc.reassign(ctx, prID, oldReviewerID, domain.AuditReasonSLAExpired)
+}
+
+func (uc *PRUseCase) reassign(ctx context.Context, prID, oldReviewerID string, reason domain.AuditReason) (string, error) {
 	pr, err := uc.prRepo.FindByID(ctx, prID)
 	if err != nil {
 		return "", err
@@ -90,15 +318,9 @@ func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID s
 	if pr.Status == domain.PRStatusMerged {
 		return "", domain.ErrPRMerged
 	}
+	policy := uc.policy.Current()
 
-	isAssigned := false
-	for _, reviewer := range pr.AssignedReviewers {
-		if reviewer == oldReviewerID {
-			isAssigned = true
-			break
-		}
-	}
-	if !isAssigned {
+	if !contains(pr.AssignedReviewers, oldReviewerID) {
 		return "", domain.ErrReviewerNotAssigned
 	}
 
@@ -107,75 +329,512 @@ func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID s
 		return "", err
 	}
 
-	newReviewerID, err := uc.selectRandomReviewer(ctx, pr, oldReviewer.TeamID, oldReviewerID)
+	newReviewerID, err := uc.selectReviewer(ctx, policy, pr, uc.reviewerTeam(pr, oldReviewer), oldReviewerID)
 	if err != nil {
 		return "", err
 	}
 
-	if err := uc.prRepo.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID); err != nil {
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionReviewerReplaced,
+		Reason:     reason,
+		OldValue:   map[string]any{"reviewer_id": oldReviewerID},
+		NewValue:   map[string]any{"reviewer_id": newReviewerID},
+	})
+	if err := uc.prRepo.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID, entry); err != nil {
 		return "", err
 	}
 
+	uc.notify(ctx, notify.KindReviewerAssigned, pr, []string{newReviewerID}, nil)
+	uc.notify(ctx, notify.KindReviewerReassigned, pr, []string{oldReviewerID}, func(n *notify.Notification) {
+		n.NewReviewerID = newReviewerID
+		n.NewReviewerName = uc.usernameOf(ctx, newReviewerID)
+	})
+
+	// после замены PR добирается до требуемого числа, если раньше кандидатов не хватало
+	pr.AssignedReviewers = replaceID(pr.AssignedReviewers, oldReviewerID, newReviewerID)
+	if _, err := uc.fillReviewers(ctx, policy, pr, []string{oldReviewerID}); err != nil && !errors.Is(err, domain.ErrNoCandidates) {
+		log.Printf("Error topping up PR %s after reassign: %v", prID, err)
+	}
+
 	return newReviewerID, nil
 }
 
-func (uc *PRUseCase) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]*domain.PullRequest, error) {
-	return uc.prRepo.FindByReviewerID(ctx, reviewerID)
+// TopUpReviewers добавляет недостающих ревьюверов из команды PR до требуемого числа
+func (uc *PRUseCase) TopUpReviewers(ctx context.Context, prID string) (*domain.PullRequest, []string, error) {
+	pr, err := uc.prRepo.FindByID(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pr.Status == domain.PRStatusMerged {
+		return nil, nil, domain.ErrPRMerged
+	}
+
+	added, err := uc.fillReviewers(ctx, uc.policy.Current(), pr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pr, added, nil
 }
 
-func (uc *PRUseCase) autoAssignReviewers(ctx context.Context, teamID int, excludeUserID string) ([]string, error) {
-	candidates, err := uc.userRepo.FindActiveByTeamID(ctx, teamID, excludeUserID)
+// AddReviewer вручную назначает конкретного ревьювера, в том числе из другой команды
+func (uc *PRUseCase) AddReviewer(ctx context.Context, prID, reviewerID string) (*domain.PullRequest, error) {
+	pr, err := uc.prRepo.FindByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(candidates) == 0 {
-		return []string{}, domain.ErrNoCandidates
+	if pr.Status == domain.PRStatusMerged {
+		return nil, domain.ErrPRMerged
+	}
+
+	reviewer, err := uc.userRepo.FindByID(ctx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case reviewer.ID == pr.AuthorID:
+		return nil, domain.ErrAuthorAsReviewer
+	case !reviewer.IsActive:
+		return nil, domain.ErrUserInactive
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionReviewerAssigned,
+		Reason:     domain.AuditReasonManual,
+		NewValue:   map[string]any{"reviewer_id": reviewer.ID},
+	})
+	if err := uc.prRepo.AddReviewer(ctx, prID, reviewer.ID, entry); err != nil {
+		return nil, err
+	}
+	if pr, err = uc.prRepo.FindByID(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	uc.notify(ctx, notify.KindReviewerAssigned, pr, []string{reviewer.ID}, nil)
+
+	return pr, nil
+}
+
+// RemoveReviewer снимает ревьювера с PR без замены
+func (uc *PRUseCase) RemoveReviewer(ctx context.Context, prID, reviewerID string) (*domain.PullRequest, error) {
+	pr, err := uc.prRepo.FindByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status == domain.PRStatusMerged {
+		return nil, domain.ErrPRMerged
+	}
+
+	if !contains(pr.AssignedReviewers, reviewerID) {
+		return nil, domain.ErrReviewerNotAssigned
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionReviewerRemoved,
+		Reason:     domain.AuditReasonManual,
+		OldValue:   map[string]any{"reviewer_id": reviewerID},
+	})
+	if err := uc.prRepo.RemoveReviewer(ctx, prID, reviewerID, entry); err != nil {
+		return nil, err
+	}
+	pr.AssignedReviewers = removeID(pr.AssignedReviewers, reviewerID)
+
+	return pr, nil
+}
+
+// GetPRsByReviewer возвращает PR, где назначен ревьювер, отфильтрованные и упорядоченные по filter
+func (uc *PRUseCase) GetPRsByReviewer(ctx context.Context, reviewerID string, filter domain.ReviewListFilter) ([]*domain.PullRequest, error) {
+	if filter.Labels != nil {
+		filter.Labels = domain.NormalizeLabels(filter.Labels)
+	}
+	return uc.prRepo.FindByReviewerID(ctx, reviewerID, filter)
+}
+
+// SetPriority меняет приоритет открытого PR; уже назначенные ревьюверы остаются, новый приоритет
+// учитывается при следующих переназначениях и доборе
+func (uc *PRUseCase) SetPriority(ctx context.Context, prID string, priority domain.PRPriority) (*domain.PullRequest, error) {
+	if err := domain.ValidatePriority(priority); err != nil {
+		return nil, err
+	}
+
+	pr, err := uc.prRepo.FindByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status == domain.PRStatusMerged {
+		return nil, domain.ErrPRMerged
+	}
+	if pr.Priority == priority {
+		return pr, nil
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityPullRequest,
+		EntityID:   prID,
+		Action:     domain.AuditActionPRPriorityChanged,
+		OldValue:   map[string]any{"priority": pr.Priority},
+		NewValue:   map[string]any{"priority": priority},
+	})
+	if err := uc.prRepo.UpdatePriority(ctx, prID, priority, entry); err != nil {
+		return nil, err
+	}
+
+	pr.Priority = priority
+	return pr, nil
+}
+
+// GetOpenPRsByReviewers возвращает открытые PR каждого ревьювера; используется для пакетной загрузки
+func (uc *PRUseCase) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]*domain.PullRequest, error) {
+	return uc.prRepo.FindOpenByReviewerIDs(ctx, reviewerIDs)
+}
+
+// GetReviewMatrix считает, сколько раз каждый ревьювер ревьюил каждого автора в PR команды
+func (uc *PRUseCase) GetReviewMatrix(ctx context.Context, teamName string) (*domain.ReviewMatrix, error) {
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	indexes := make([]int, 1, 2)
-	indexes[0] = rand.Intn(len(candidates))
-	if len(candidates) > 1 {
-		indexes = append(indexes, rand.Intn(len(candidates)))
+	members, err := uc.userRepo.FindByTeamID(ctx, team.ID)
+	if err != nil {
+		return nil, err
 	}
-	for len(indexes) > 1 && indexes[0] == indexes[1] {
-		indexes[1] = rand.Intn(len(candidates))
+	pairs, err := uc.prRepo.FindReviewPairs(ctx, team.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	reviewers := make([]string, len(indexes))
-	for i := 0; i < len(indexes); i++ {
-		reviewers[i] = candidates[i].ID
+	return &domain.ReviewMatrix{
+		TeamName: team.Name,
+		Members:  Map(members, func(u *domain.User) string { return u.ID }),
+		Pairs:    pairs,
+	}, nil
+}
+
+// uncoveredTags возвращает теги required, которых нет ни у одного из reviewers
+func (uc *PRUseCase) uncoveredTags(ctx context.Context, required, reviewers []string) ([]string, error) {
+	if len(required) == 0 {
+		return []string{}, nil
+	}
+	tags, err := uc.userRepo.FindTags(ctx, reviewers)
+	if err != nil {
+		return nil, err
+	}
+	return assignment.UncoveredTags(required, reviewers, tags), nil
+}
+
+// prTeam выбирает команду, из которой назначаются ревьюверы PR: указанную автором или его основную.
+// Указать можно только команду, в которой автор состоит
+func (uc *PRUseCase) prTeam(ctx context.Context, author *domain.User, teamName string) (int, error) {
+	if teamName == "" {
+		return author.TeamID, nil
+	}
+
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := author.Membership(team.ID); !ok {
+		return 0, domain.ErrNotTeamMember
+	}
+	return team.ID, nil
+}
+
+// reviewerTeam выбирает команду, из которой ищется замена ревьюверу: команда PR, если ревьювер в ней состоит,
+// иначе его основная команда (например, для ревьювера, добавленного вручную из другой команды)
+func (uc *PRUseCase) reviewerTeam(pr *domain.PullRequest, reviewer *domain.User) int {
+	if _, ok := reviewer.Membership(pr.TeamID); ok && pr.TeamID != 0 {
+		return pr.TeamID
+	}
+	return reviewer.TeamID
+}
+
+// requiredReviewers определяет число ревьюверов для нового PR: переопределение из запроса,
+// затем из политики назначения, затем настройка команды
+func (uc *PRUseCase) requiredReviewers(ctx context.Context, policy *assignment.ActivePolicy, teamID int, override int) (int, error) {
+	if override == 0 && policy.ReviewersCount > 0 {
+		return policy.ReviewersCount, nil
+	}
+	if override == 0 {
+		team, err := uc.teamRepo.FindByID(ctx, teamID)
+		if err != nil {
+			return 0, err
+		}
+		return team.ReviewersCount, nil
+	}
+
+	members, err := uc.userRepo.FindByTeamID(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := validateReviewersCount(override, len(members)); err != nil {
+		return 0, err
+	}
+	return override, nil
+}
+
+// fillReviewers добирает ревьюверов из команды PR до pr.RequiredReviewers, сохраняет их и уведомляет
+func (uc *PRUseCase) fillReviewers(ctx context.Context, policy *assignment.ActivePolicy, pr *domain.PullRequest, exclude []string) ([]string, error) {
+	missing := pr.RequiredReviewers - len(pr.AssignedReviewers)
+	if missing <= 0 {
+		return []string{}, nil
+	}
+
+	teamID := pr.TeamID
+	if teamID == 0 {
+		author, err := uc.userRepo.FindByID(ctx, pr.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		teamID = author.TeamID
+	}
+
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := uc.userRepo.FindActiveByTeamID(ctx, teamID, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := uc.pickReviewers(ctx, policy, team, candidates, pickRequest{
+		authorID: pr.AuthorID,
+		exclude:  append(exclude, pr.AssignedReviewers...),
+		kept:     pr.AssignedReviewers,
+		priority: pr.Priority,
+		n:        missing,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(added) == 0 {
+		return nil, domain.ErrNoCandidates
+	}
+
+	entries := make([]domain.AuditEntry, 0, len(added))
+	for _, reviewerID := range added {
+		entries = append(entries, auditEntry(ctx, domain.AuditEntry{
+			EntityType: domain.AuditEntityPullRequest,
+			EntityID:   pr.ID,
+			Action:     domain.AuditActionReviewerAssigned,
+			Reason:     domain.AuditReasonTopUp,
+			NewValue:   map[string]any{"reviewer_id": reviewerID},
+		}))
+	}
+	if err := uc.prRepo.AddReviewers(ctx, pr.ID, added, entries...); err != nil {
+		return nil, err
+	}
+	pr.AssignedReviewers = append(pr.AssignedReviewers, added...)
+
+	uc.notify(ctx, notify.KindReviewerAssigned, pr, added, nil)
+
+	return added, nil
+}
+
+func (uc *PRUseCase) autoAssignReviewers(ctx context.Context, policy *assignment.ActivePolicy, teamID int, req pickRequest) ([]string, error) {
+	reviewers, err := uc.pickFromTeams(ctx, policy, teamID, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviewers) == 0 || (!policy.AllowPartial && len(reviewers) < req.n) {
+		return []string{}, domain.ErrNoCandidates
 	}
 
 	return reviewers, nil
 }
 
-func (uc *PRUseCase) selectRandomReviewer(ctx context.Context, pr *domain.PullRequest, teamID int, excludeUserID string) (string, error) {
-	candidates, err := uc.userRepo.FindActiveByTeamID(ctx, teamID, excludeUserID)
+func (uc *PRUseCase) selectReviewer(ctx context.Context, policy *assignment.ActivePolicy, pr *domain.PullRequest, teamID int, excludeUserID string) (string, error) {
+	selected, err := uc.pickFromTeams(ctx, policy, teamID, pickRequest{
+		authorID: pr.AuthorID,
+		exclude:  append([]string{excludeUserID}, pr.AssignedReviewers...),
+		kept:     removeID(pr.AssignedReviewers, excludeUserID),
+		priority: pr.Priority,
+		n:        1,
+	})
 	if err != nil {
 		return "", err
 	}
+	if len(selected) == 0 {
+		return "", domain.ErrNoCandidates
+	}
+
+	return selected[0], nil
+}
+
+// pickRequest - сколько ревьюверов выбрать: exclude не выбираются никогда,
+// kept остаются ревьюверами PR и учитываются правилами ролей вместе с выбранными,
+// tags по возможности покрываются выбранными
+type pickRequest struct {
+	authorID string
+	exclude  []string
+	kept     []string
+	tags     []string
+	priority domain.PRPriority
+	n        int
+}
+
+// pickFromTeams выбирает ревьюверов из команды; если в ней никто не подошёл и политика разрешает,
+// по очереди пробует соседние и вышестоящие команды и берёт ревьюверов из первой, где они есть
+func (uc *PRUseCase) pickFromTeams(ctx context.Context, policy *assignment.ActivePolicy, teamID int, req pickRequest) ([]string, error) {
+	selected, err := uc.pickFromTeam(ctx, policy, teamID, req)
+	if !policy.Fallback || !noneSuitable(selected, err) {
+		return selected, err
+	}
+
+	fallback, ferr := uc.teamRepo.FindFallbackIDs(ctx, teamID)
+	if ferr != nil {
+		return nil, ferr
+	}
+	for _, id := range fallback {
+		selected, err = uc.pickFromTeam(ctx, policy, id, req)
+		if !noneSuitable(selected, err) {
+			return selected, err
+		}
+	}
+
+	return selected, err
+}
+
+// noneSuitable - в команде не нашлось ревьюверов, и можно попробовать следующую
+func noneSuitable(selected []string, err error) bool {
+	if err != nil {
+		return errors.Is(err, domain.ErrReviewerRules)
+	}
+	return len(selected) == 0
+}
+
+func (uc *PRUseCase) pickFromTeam(ctx context.Context, policy *assignment.ActivePolicy, teamID int, req pickRequest) ([]string, error) {
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := uc.userRepo.FindActiveByTeamID(ctx, teamID, req.authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.pickReviewers(ctx, policy, team, candidates, req)
+}
+
+// pickReviewers выбирает ревьюверов из candidates команды team с учётом тегов и правил ролей
+func (uc *PRUseCase) pickReviewers(ctx context.Context, policy *assignment.ActivePolicy, team *domain.Team, candidates []*domain.User, req pickRequest) ([]string, error) {
+	if !policy.Roles.Enabled() && len(req.tags) == 0 {
+		return uc.orderReviewers(ctx, policy, team, candidates, req, req.n)
+	}
+
+	// тегам и правилам нужен весь порядок предпочтения, чтобы было из кого выбирать
+	ordered, err := uc.orderReviewers(ctx, policy, team, candidates, req, len(candidates))
+	if err != nil {
+		return nil, err
+	}
+	if len(req.tags) > 0 {
+		tags := make(map[string][]string, len(candidates))
+		for _, candidate := range candidates {
+			tags[candidate.ID] = candidate.Tags
+		}
+		ordered = assignment.PreferTags(ordered, tags, req.tags, req.n)
+	}
+	if !policy.Roles.Enabled() {
+		return ordered[:min(req.n, len(ordered))], nil
+	}
+
+	roles := make(map[string]domain.MemberRole, len(candidates))
+	for _, candidate := range candidates {
+		roles[candidate.ID] = candidate.Role
+	}
+	return assignment.ApplyRoleRules(policy.Roles, ordered, roles, req.kept, req.n)
+}
+
+// orderReviewers возвращает до n подходящих кандидатов в порядке, который задаёт способ выбора:
+// для hotfix - наименее загруженные из тех, кто онлайн, и только если их не хватает - остальные;
+// иначе ротация, если она включена в команде, иначе способ из политики назначения
+func (uc *PRUseCase) orderReviewers(ctx context.Context, policy *assignment.ActivePolicy, team *domain.Team, candidates []*domain.User, req pickRequest, n int) ([]string, error) {
+	hotfix := req.priority == domain.PRPriorityHotfix
+	if !hotfix && !team.ReviewRotation && policy.Mode != assignment.ModeLeastLoaded {
+		return assignment.SampleReviewers(uc.rnd, candidates, req.authorID, req.exclude, n), nil
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID)
+	}
+	if !hotfix && team.ReviewRotation {
+		last, err := uc.prRepo.LastReviewedAuthor(ctx, req.authorID, ids)
+		if err != nil {
+			return nil, err
+		}
+		return assignment.RotationReviewers(uc.rnd, candidates, last, uc.clock.Now(), req.authorID, req.exclude, n), nil
+	}
+
+	load, err := uc.prRepo.CountOpenReviews(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if !hotfix {
+		return assignment.LeastLoadedReviewers(uc.rnd, candidates, load, req.authorID, req.exclude, n), nil
+	}
 
-	availableCandidates := make([]*domain.User, 0)
+	now := uc.clock.Now()
+	online := make([]*domain.User, 0, len(candidates))
 	for _, candidate := range candidates {
-		isAlreadyReviewer := false
-		for _, reviewer := range pr.AssignedReviewers {
-			if reviewer == candidate.ID {
-				isAlreadyReviewer = true
-				break
-			}
+		if candidate.Online(now) {
+			online = append(online, candidate)
 		}
-		if !isAlreadyReviewer {
-			availableCandidates = append(availableCandidates, candidate)
+	}
+	reviewers := assignment.LeastLoadedReviewers(uc.rnd, online, load, req.authorID, req.exclude, n)
+	if len(reviewers) < n {
+		exclude := append(slices.Clone(req.exclude), reviewers...)
+		reviewers = append(reviewers, assignment.LeastLoadedReviewers(uc.rnd, candidates, load, req.authorID, exclude, n-len(reviewers))...)
+	}
+	return reviewers, nil
+}
+
+// validateReviewersCount проверяет допустимый диапазон и то, что в команде хватает людей кроме автора
+func validateReviewersCount(count int, teamSize int) error {
+	if count < domain.MinReviewersCount || count > domain.MaxReviewersCount || count > teamSize-1 {
+		return domain.ErrInvalidReviewersCount
+	}
+	return nil
+}
+
+func replaceID(ids []string, oldID, newID string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == oldID {
+			id = newID
 		}
+		result = append(result, id)
 	}
+	return result
+}
 
-	if len(availableCandidates) == 0 {
-		return "", domain.ErrNoCandidates
+func removeID(ids []string, removed string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != removed {
+			result = append(result, id)
+		}
 	}
+	return result
+}
 
-	rand.Seed(time.Now().UnixNano())
-	selected := availableCandidates[rand.Intn(len(availableCandidates))]
-	return selected.ID, nil
+func contains(ids []string, id string) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
 }
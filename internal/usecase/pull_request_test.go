@@ -1,8 +1,16 @@
 package usecase
 
 import (
+	"avito-test-task/internal/assignment"
 	"avito-test-task/internal/domain"
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 )
@@ -638,7 +646,7 @@ func TestPRUseCase_GetPRsByReviewer(t *testing.T) {
 			setupTestData(t)
 			tt.setupData()
 
-			results, err := prUseCase.GetPRsByReviewer(ctx, tt.reviewerID)
+			results, err := prUseCase.GetPRsByReviewer(ctx, tt.reviewerID, domain.ReviewListFilter{})
 
 			if tt.expectedError != nil {
 				if err == nil {
@@ -725,7 +733,7 @@ func TestPRUseCase_Integration_CreateMergeAndReassign(t *testing.T) {
 
 		if len(createdPR.AssignedReviewers) > 0 {
 			reviewerID := createdPR.AssignedReviewers[0]
-			reviewerPRs, err := prUseCase.GetPRsByReviewer(ctx, reviewerID)
+			reviewerPRs, err := prUseCase.GetPRsByReviewer(ctx, reviewerID, domain.ReviewListFilter{})
 			if err != nil {
 				t.Fatalf("Failed to get PRs by reviewer: %v", err)
 			}
@@ -809,3 +817,1290 @@ func TestPRUseCase_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func newSeededPRUseCase(seed int64, now time.Time) *PRUseCase {
+	return NewPRUseCase(*prRepo, *userRepo, *teamRepo,
+		WithRandSource(rand.NewSource(seed)),
+		WithClock(fixedClock{now: now}),
+	)
+}
+
+func TestPRUseCase_SeededAssignment(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+
+	setupSeededData := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`
+			INSERT INTO users (id, username, team_id, is_active) VALUES
+				('user_6', 'eve', 1, true),
+				('user_7', 'frank', 1, true),
+				('user_8', 'grace', 1, true)
+		`)
+		testDB.Exec(`
+			INSERT INTO pull_requests (id, title, author_id, status)
+			VALUES ('pr_seeded', 'Seeded PR', 'user_3', 'OPEN')
+		`)
+		testDB.Exec(`
+			INSERT INTO pr_reviewers (pr_id, reviewer_id)
+			VALUES ('pr_seeded', 'user_5'), ('pr_seeded', 'user_6')
+		`)
+	}
+
+	t.Run("reassign picks exact reviewers for fixed seed", func(t *testing.T) {
+		setupSeededData(t)
+		uc := newSeededPRUseCase(42, now)
+
+		// кандидаты упорядочены по id: [user_1 user_7 user_8], затем [user_1 user_5 user_7]
+		first, err := uc.ReassignReviewer(ctx, "pr_seeded", "user_5")
+		if err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+		if first != "user_8" {
+			t.Errorf("First replacement = %s, want user_8", first)
+		}
+
+		second, err := uc.ReassignReviewer(ctx, "pr_seeded", "user_6")
+		if err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+		if second != "user_7" {
+			t.Errorf("Second replacement = %s, want user_7", second)
+		}
+	})
+
+	t.Run("create samples exact pair for fixed seed", func(t *testing.T) {
+		setupSeededData(t)
+		uc := newSeededPRUseCase(42, now)
+
+		// активные кандидаты команды автора: [user_5 user_6 user_7 user_8]
+		pr, err := uc.CreatePR(ctx, "pr_seeded_create", "Seeded create", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+
+		want := []string{"user_6", "user_8"}
+		if !reflect.DeepEqual(pr.AssignedReviewers, want) {
+			t.Errorf("AssignedReviewers = %v, want %v", pr.AssignedReviewers, want)
+		}
+	})
+
+	t.Run("same seed gives same reassignment sequence", func(t *testing.T) {
+		var runs [2][]string
+		for i := range runs {
+			setupSeededData(t)
+			uc := newSeededPRUseCase(7, now)
+
+			for _, old := range []string{"user_5", "user_6"} {
+				newReviewer, err := uc.ReassignReviewer(ctx, "pr_seeded", old)
+				if err != nil {
+					t.Fatalf("ReassignReviewer() error = %v", err)
+				}
+				runs[i] = append(runs[i], newReviewer)
+			}
+		}
+
+		if !reflect.DeepEqual(runs[0], runs[1]) {
+			t.Errorf("Runs with the same seed differ: %v vs %v", runs[0], runs[1])
+		}
+	})
+
+	t.Run("timestamps come from injected clock", func(t *testing.T) {
+		setupSeededData(t)
+		uc := newSeededPRUseCase(42, now)
+
+		created, err := uc.CreatePR(ctx, "pr_clock", "Clock PR", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if created.CreatedAt == nil || !created.CreatedAt.Equal(now) {
+			t.Errorf("CreatedAt = %v, want %v", created.CreatedAt, now)
+		}
+
+		merged, err := uc.MergePR(ctx, "pr_clock")
+		if err != nil {
+			t.Fatalf("MergePR() error = %v", err)
+		}
+		if merged.MergedAt == nil || !merged.MergedAt.Equal(now) {
+			t.Errorf("MergedAt = %v, want %v", merged.MergedAt, now)
+		}
+
+		dbPR, err := prRepo.FindByID(ctx, "pr_clock")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if dbPR.CreatedAt == nil || !dbPR.CreatedAt.Equal(now) {
+			t.Errorf("CreatedAt in DB = %v, want %v", dbPR.CreatedAt, now)
+		}
+	})
+}
+
+func TestPRUseCase_ReviewersCount(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		setupData     func()
+		opts          []CreatePROption
+		expectedError error
+		wantReviewers int
+		wantRequired  int
+	}{
+		{
+			name: "team setting limits reviewers",
+			setupData: func() {
+				testDB.Exec("UPDATE teams SET reviewers_count = 1 WHERE id = 1")
+				testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+			},
+			wantReviewers: 1,
+			wantRequired:  1,
+		},
+		{
+			name: "per-PR override wins over team setting",
+			setupData: func() {
+				testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+			},
+			opts:          []CreatePROption{WithReviewersCount(1)},
+			wantReviewers: 1,
+			wantRequired:  1,
+		},
+		{
+			name:          "override larger than team is rejected",
+			setupData:     func() {},
+			opts:          []CreatePROption{WithReviewersCount(3)},
+			expectedError: domain.ErrInvalidReviewersCount,
+		},
+		{
+			name:          "override out of range is rejected",
+			setupData:     func() {},
+			opts:          []CreatePROption{WithReviewersCount(11)},
+			expectedError: domain.ErrInvalidReviewersCount,
+		},
+		{
+			name:          "fewer candidates than required assigns what is available",
+			setupData:     func() {},
+			wantReviewers: 1,
+			wantRequired:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestData(t)
+			tt.setupData()
+
+			pr, err := prUseCase.CreatePR(ctx, "pr_count", "Counted PR", "user_1", tt.opts...)
+			if err != tt.expectedError {
+				t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+			}
+			if tt.expectedError != nil {
+				return
+			}
+
+			if len(pr.AssignedReviewers) != tt.wantReviewers {
+				t.Errorf("Assigned %d reviewers, want %d", len(pr.AssignedReviewers), tt.wantReviewers)
+			}
+			if pr.RequiredReviewers != tt.wantRequired {
+				t.Errorf("RequiredReviewers = %d, want %d", pr.RequiredReviewers, tt.wantRequired)
+			}
+
+			dbPR, err := prRepo.FindByID(ctx, "pr_count")
+			if err != nil {
+				t.Fatalf("Failed to verify PR in DB: %v", err)
+			}
+			if dbPR.RequiredReviewers != tt.wantRequired {
+				t.Errorf("RequiredReviewers in DB = %d, want %d", dbPR.RequiredReviewers, tt.wantRequired)
+			}
+		})
+	}
+}
+
+func TestPRUseCase_TopUpReviewers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("new active member is added to understaffed PR", func(t *testing.T) {
+		setupTestData(t)
+
+		pr, err := prUseCase.CreatePR(ctx, "pr_top_up", "Understaffed PR", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if len(pr.AssignedReviewers) != 1 {
+			t.Fatalf("Expected 1 reviewer before top up, got %v", pr.AssignedReviewers)
+		}
+
+		if _, err := userUseCase.SetUserActivity(ctx, "user_2", true); err != nil {
+			t.Fatalf("Failed to activate user: %v", err)
+		}
+
+		updated, added, err := prUseCase.TopUpReviewers(ctx, "pr_top_up")
+		if err != nil {
+			t.Fatalf("TopUpReviewers() error = %v", err)
+		}
+		if !reflect.DeepEqual(added, []string{"user_2"}) {
+			t.Errorf("Added = %v, want [user_2]", added)
+		}
+		if len(updated.AssignedReviewers) != 2 {
+			t.Errorf("Expected 2 reviewers after top up, got %v", updated.AssignedReviewers)
+		}
+
+		_, added, err = prUseCase.TopUpReviewers(ctx, "pr_top_up")
+		if err != nil {
+			t.Fatalf("Second TopUpReviewers() error = %v", err)
+		}
+		if len(added) != 0 {
+			t.Errorf("Fully staffed PR should not get reviewers, got %v", added)
+		}
+	})
+
+	t.Run("no candidates to add", func(t *testing.T) {
+		setupTestData(t)
+
+		if _, err := prUseCase.CreatePR(ctx, "pr_top_up", "Understaffed PR", "user_1"); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+
+		_, _, err := prUseCase.TopUpReviewers(ctx, "pr_top_up")
+		if err != domain.ErrNoCandidates {
+			t.Errorf("Expected error %v, got %v", domain.ErrNoCandidates, err)
+		}
+	})
+
+	t.Run("merged PR is frozen", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec(`
+			INSERT INTO pull_requests (id, title, author_id, status, merged_at)
+			VALUES ('pr_merged_top_up', 'Merged PR', 'user_1', 'MERGED', NOW())
+		`)
+
+		_, _, err := prUseCase.TopUpReviewers(ctx, "pr_merged_top_up")
+		if err != domain.ErrPRMerged {
+			t.Errorf("Expected error %v, got %v", domain.ErrPRMerged, err)
+		}
+	})
+
+	t.Run("non-existent PR", func(t *testing.T) {
+		setupTestData(t)
+
+		_, _, err := prUseCase.TopUpReviewers(ctx, "non_existent_pr")
+		if err != domain.ErrPRNotFound {
+			t.Errorf("Expected error %v, got %v", domain.ErrPRNotFound, err)
+		}
+	})
+
+	t.Run("reassign restores required count", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec(`
+			INSERT INTO users (id, username, team_id, is_active)
+			VALUES ('extra_user_1', 'extra1', 2, true),
+				   ('extra_user_2', 'extra2', 2, true)
+		`)
+		testDB.Exec(`
+			INSERT INTO pull_requests (id, title, author_id, status, required_reviewers)
+			VALUES ('pr_reassign_top_up', 'Understaffed PR', 'user_3', 'OPEN', 2)
+		`)
+		testDB.Exec(`
+			INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ('pr_reassign_top_up', 'user_4')
+		`)
+
+		if _, err := prUseCase.ReassignReviewer(ctx, "pr_reassign_top_up", "user_4"); err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+
+		dbPR, err := prRepo.FindByID(ctx, "pr_reassign_top_up")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if len(dbPR.AssignedReviewers) != 2 {
+			t.Errorf("Expected 2 reviewers after reassign, got %v", dbPR.AssignedReviewers)
+		}
+		for _, reviewer := range dbPR.AssignedReviewers {
+			if reviewer == "user_4" || reviewer == "user_3" {
+				t.Errorf("Replaced reviewer or author %s should not be assigned", reviewer)
+			}
+		}
+	})
+}
+
+func TestPRUseCase_AddReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		prID       string
+		reviewerID string
+		wantErr    error
+		wantCount  int
+	}{
+		{
+			name:       "add reviewer from another team",
+			prID:       "pr_manual",
+			reviewerID: "user_3",
+			wantErr:    nil,
+			wantCount:  2,
+		},
+		{
+			name:       "add author",
+			prID:       "pr_manual",
+			reviewerID: "user_1",
+			wantErr:    domain.ErrAuthorAsReviewer,
+		},
+		{
+			name:       "add inactive user",
+			prID:       "pr_manual",
+			reviewerID: "user_2",
+			wantErr:    domain.ErrUserInactive,
+		},
+		{
+			name:       "add already assigned reviewer",
+			prID:       "pr_manual",
+			reviewerID: "user_5",
+			wantErr:    domain.ErrReviewerAlreadyAssigned,
+		},
+		{
+			name:       "add over the limit",
+			prID:       "pr_full",
+			reviewerID: "user_4",
+			wantErr:    domain.ErrReviewersLimit,
+		},
+		{
+			name:       "add to merged PR",
+			prID:       "pr_merged_manual",
+			reviewerID: "user_3",
+			wantErr:    domain.ErrPRMerged,
+		},
+		{
+			name:       "add non-existent user",
+			prID:       "pr_manual",
+			reviewerID: "non_existent_user",
+			wantErr:    domain.ErrUserNotFound,
+		},
+		{
+			name:       "add to non-existent PR",
+			prID:       "non_existent_pr",
+			reviewerID: "user_3",
+			wantErr:    domain.ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestData(t)
+			testDB.Exec(`
+				INSERT INTO pull_requests (id, title, author_id, status, merged_at)
+				VALUES ('pr_manual', 'Manual PR', 'user_1', 'OPEN', NULL),
+					   ('pr_full', 'Full PR', 'user_1', 'OPEN', NULL),
+					   ('pr_merged_manual', 'Merged PR', 'user_1', 'MERGED', NOW())
+			`)
+			testDB.Exec(`
+				INSERT INTO pr_reviewers (pr_id, reviewer_id)
+				VALUES ('pr_manual', 'user_5'),
+					   ('pr_full', 'user_5'),
+					   ('pr_full', 'user_3')
+			`)
+
+			pr, err := prUseCase.AddReviewer(ctx, tt.prID, tt.reviewerID)
+			if err != tt.wantErr {
+				t.Fatalf("AddReviewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(pr.AssignedReviewers) != tt.wantCount {
+				t.Errorf("Expected %d reviewers, got %v", tt.wantCount, pr.AssignedReviewers)
+			}
+
+			stored, err := prUseCase.GetPR(ctx, tt.prID)
+			if err != nil {
+				t.Fatalf("GetPR() error = %v", err)
+			}
+			if !contains(stored.AssignedReviewers, tt.reviewerID) {
+				t.Errorf("Reviewer %s was not saved, got %v", tt.reviewerID, stored.AssignedReviewers)
+			}
+
+			entries, err := auditUseCase.GetPRHistory(ctx, tt.prID)
+			if err != nil {
+				t.Fatalf("GetPRHistory() error = %v", err)
+			}
+			last := entries[len(entries)-1]
+			if last.Action != domain.AuditActionReviewerAssigned || last.Reason != domain.AuditReasonManual {
+				t.Errorf("Unexpected audit entry: %s/%s", last.Action, last.Reason)
+			}
+		})
+	}
+}
+
+func TestPRUseCase_RemoveReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		prID       string
+		reviewerID string
+		wantErr    error
+	}{
+		{
+			name:       "remove assigned reviewer",
+			prID:       "pr_manual",
+			reviewerID: "user_5",
+			wantErr:    nil,
+		},
+		{
+			name:       "remove not assigned reviewer",
+			prID:       "pr_manual",
+			reviewerID: "user_4",
+			wantErr:    domain.ErrReviewerNotAssigned,
+		},
+		{
+			name:       "remove from merged PR",
+			prID:       "pr_merged_manual",
+			reviewerID: "user_5",
+			wantErr:    domain.ErrPRMerged,
+		},
+		{
+			name:       "remove from non-existent PR",
+			prID:       "non_existent_pr",
+			reviewerID: "user_5",
+			wantErr:    domain.ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestData(t)
+			testDB.Exec(`
+				INSERT INTO pull_requests (id, title, author_id, status, merged_at)
+				VALUES ('pr_manual', 'Manual PR', 'user_1', 'OPEN', NULL),
+					   ('pr_merged_manual', 'Merged PR', 'user_1', 'MERGED', NOW())
+			`)
+			testDB.Exec(`
+				INSERT INTO pr_reviewers (pr_id, reviewer_id)
+				VALUES ('pr_manual', 'user_5'),
+					   ('pr_manual', 'user_3'),
+					   ('pr_merged_manual', 'user_5')
+			`)
+
+			pr, err := prUseCase.RemoveReviewer(ctx, tt.prID, tt.reviewerID)
+			if err != tt.wantErr {
+				t.Fatalf("RemoveReviewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_3"}) {
+				t.Errorf("Expected reviewers [user_3], got %v", pr.AssignedReviewers)
+			}
+
+			stored, err := prUseCase.GetPR(ctx, tt.prID)
+			if err != nil {
+				t.Fatalf("GetPR() error = %v", err)
+			}
+			if contains(stored.AssignedReviewers, tt.reviewerID) {
+				t.Errorf("Reviewer %s is still assigned: %v", tt.reviewerID, stored.AssignedReviewers)
+			}
+		})
+	}
+}
+
+func TestPRUseCase_CreatePRValidation(t *testing.T) {
+	ctx := context.Background()
+	setupTestData(t)
+
+	_, err := prUseCase.CreatePR(ctx, "pr with spaces", "   ", "user_1")
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	if len(validationErr.Fields) != 2 {
+		t.Errorf("Expected 2 field errors, got %v", validationErr.Fields)
+	}
+}
+
+func newPolicyPRUseCase(policy assignment.Policy) *PRUseCase {
+	store := assignment.NewPolicyStore(&assignment.ActivePolicy{Policy: policy, Source: "test"})
+	return NewPRUseCase(*prRepo, *userRepo, *teamRepo,
+		WithRandSource(rand.NewSource(1)),
+		WithPolicyStore(store),
+	)
+}
+
+func TestPRUseCase_AssignmentPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("partial assignment can be disabled", func(t *testing.T) {
+		setupTestData(t)
+		policy := assignment.DefaultPolicy()
+		policy.AllowPartial = false
+
+		// в команде user_1 один активный кандидат, а нужно два
+		_, err := newPolicyPRUseCase(policy).CreatePR(ctx, "pr_strict", "Strict PR", "user_1")
+		if !errors.Is(err, domain.ErrNoCandidates) {
+			t.Fatalf("Expected ErrNoCandidates, got %v", err)
+		}
+	})
+
+	t.Run("policy reviewers count overrides team setting", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+		policy := assignment.DefaultPolicy()
+		policy.ReviewersCount = 1
+
+		pr, err := newPolicyPRUseCase(policy).CreatePR(ctx, "pr_policy_count", "Policy PR", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if pr.RequiredReviewers != 1 || len(pr.AssignedReviewers) != 1 {
+			t.Errorf("Required = %d, assigned = %v, want one reviewer", pr.RequiredReviewers, pr.AssignedReviewers)
+		}
+
+		withOverride, err := newPolicyPRUseCase(policy).CreatePR(ctx, "pr_policy_override", "Override PR", "user_1",
+			WithReviewersCount(2))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if withOverride.RequiredReviewers != 2 {
+			t.Errorf("RequiredReviewers = %d, per-PR override should win over policy", withOverride.RequiredReviewers)
+		}
+	})
+
+	t.Run("least loaded picks reviewer with fewest open reviews", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec("UPDATE users SET is_active = true WHERE id = 'user_2'")
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'eve', 1, true)`)
+		testDB.Exec(`
+			INSERT INTO pull_requests (id, title, author_id, status) VALUES
+				('pr_open', 'Open PR', 'user_3', 'OPEN'),
+				('pr_merged', 'Merged PR', 'user_3', 'MERGED')
+		`)
+		testDB.Exec(`
+			INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES
+				('pr_open', 'user_5'), ('pr_open', 'user_6'), ('pr_merged', 'user_2')
+		`)
+		policy := assignment.DefaultPolicy()
+		policy.ReviewersCount = 1
+		policy.Mode = assignment.ModeLeastLoaded
+
+		pr, err := newPolicyPRUseCase(policy).CreatePR(ctx, "pr_least_loaded", "Least loaded PR", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		// ревью в смёрженных PR нагрузкой не считаются
+		if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_2"}) {
+			t.Errorf("AssignedReviewers = %v, want [user_2]", pr.AssignedReviewers)
+		}
+	})
+}
+
+func TestPRUseCase_TeamContext(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reviewers are assigned from the explicit team", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec("INSERT INTO team_memberships (user_id, team_id) VALUES ('user_1', 2)")
+
+		pr, err := prUseCase.CreatePR(ctx, "pr_frontend", "Frontend PR", "user_1", WithTeam("frontend-team"))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		reviewers := append([]string(nil), pr.AssignedReviewers...)
+		sort.Strings(reviewers)
+		if !reflect.DeepEqual(reviewers, []string{"user_3", "user_4"}) {
+			t.Errorf("AssignedReviewers = %v, want frontend-team members", pr.AssignedReviewers)
+		}
+
+		stored, err := prRepo.FindByID(ctx, "pr_frontend")
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if stored.TeamID != 2 {
+			t.Errorf("TeamID = %d, want 2", stored.TeamID)
+		}
+	})
+
+	t.Run("author must be a member of the explicit team", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := prUseCase.CreatePR(ctx, "pr_foreign", "Foreign PR", "user_1", WithTeam("frontend-team"))
+		if !errors.Is(err, domain.ErrNotTeamMember) {
+			t.Fatalf("Expected ErrNotTeamMember, got %v", err)
+		}
+		if _, err := prUseCase.CreatePR(ctx, "pr_missing", "Missing PR", "user_1", WithTeam("missing-team")); !errors.Is(err, domain.ErrTeamNotFound) {
+			t.Errorf("Expected ErrTeamNotFound, got %v", err)
+		}
+	})
+
+	t.Run("reassign picks from the PR team, not the reviewer's primary team", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec("INSERT INTO team_memberships (user_id, team_id) VALUES ('user_1', 2), ('user_5', 2)")
+		testDB.Exec("INSERT INTO pull_requests (id, title, author_id, status, team_id) VALUES ('pr_ctx', 'Context PR', 'user_1', 'OPEN', 2)")
+		testDB.Exec("INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ('pr_ctx', 'user_5'), ('pr_ctx', 'user_3')")
+
+		// в основной команде user_5 (backend-team) замены нет: user_2 неактивен, user_1 - автор
+		newReviewer, err := prUseCase.ReassignReviewer(ctx, "pr_ctx", "user_5")
+		if err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+		if newReviewer != "user_4" {
+			t.Errorf("New reviewer = %s, want user_4", newReviewer)
+		}
+	})
+}
+
+func TestPRUseCase_TeamFallback(t *testing.T) {
+	ctx := context.Background()
+	setupFallback := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		// пустая команда ux внутри frontend-team, у неё один автор
+		testDB.Exec("INSERT INTO teams (name, parent_id) VALUES ('ux-team', 2)")
+		testDB.Exec("INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'uma', 3, true)")
+	}
+
+	t.Run("without fallback the empty team has no candidates", func(t *testing.T) {
+		setupFallback(t)
+
+		_, err := prUseCase.CreatePR(ctx, "pr_ux", "UX PR", "user_6")
+		if !errors.Is(err, domain.ErrNoCandidates) {
+			t.Fatalf("Expected ErrNoCandidates, got %v", err)
+		}
+	})
+
+	t.Run("fallback takes reviewers from the parent team", func(t *testing.T) {
+		setupFallback(t)
+		policy := assignment.DefaultPolicy()
+		policy.Fallback = true
+
+		pr, err := newPolicyPRUseCase(policy).CreatePR(ctx, "pr_ux", "UX PR", "user_6")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		reviewers := append([]string(nil), pr.AssignedReviewers...)
+		sort.Strings(reviewers)
+		if !reflect.DeepEqual(reviewers, []string{"user_3", "user_4"}) {
+			t.Errorf("AssignedReviewers = %v, want frontend-team members", pr.AssignedReviewers)
+		}
+	})
+}
+
+func TestPRUseCase_RoleRules(t *testing.T) {
+	ctx := context.Background()
+	// в backend-team кроме автора user_1: user_5 (member), user_6 (trainee), user_7 (senior); user_2 неактивен
+	setupRoles := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'tina', 1, true), ('user_7', 'sam', 1, true)`)
+		testDB.Exec(`UPDATE team_memberships SET role = 'trainee' WHERE user_id = 'user_6'`)
+		testDB.Exec(`UPDATE team_memberships SET role = 'senior' WHERE user_id = 'user_7'`)
+	}
+	rolePolicy := func(rules assignment.RoleRules) *PRUseCase {
+		policy := assignment.DefaultPolicy()
+		policy.ReviewersCount = 1
+		policy.Roles = rules
+		return newPolicyPRUseCase(policy)
+	}
+
+	t.Run("senior is always among reviewers", func(t *testing.T) {
+		setupRoles(t)
+		uc := rolePolicy(assignment.RoleRules{RequireSenior: true})
+
+		for i := 0; i < 5; i++ {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_senior_%d", i), "Senior PR", "user_1")
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_7"}) {
+				t.Errorf("AssignedReviewers = %v, want the only senior user_7", pr.AssignedReviewers)
+			}
+		}
+	})
+
+	t.Run("trainee is never the sole reviewer", func(t *testing.T) {
+		setupRoles(t)
+		testDB.Exec(`UPDATE users SET is_active = false WHERE id = 'user_7'`)
+		uc := rolePolicy(assignment.RoleRules{NoSoleTrainee: true})
+
+		for i := 0; i < 5; i++ {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_trainee_%d", i), "Trainee PR", "user_1")
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_5"}) {
+				t.Errorf("AssignedReviewers = %v, want user_5", pr.AssignedReviewers)
+			}
+		}
+	})
+
+	t.Run("leads are assigned only when others are not enough", func(t *testing.T) {
+		setupRoles(t)
+		testDB.Exec(`UPDATE team_memberships SET role = 'lead' WHERE user_id = 'user_5'`)
+		policy := assignment.DefaultPolicy()
+		policy.Roles = assignment.RoleRules{LeadsAsFallback: true}
+		uc := newPolicyPRUseCase(policy)
+
+		for i := 0; i < 5; i++ {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_lead_%d", i), "Lead PR", "user_1")
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if contains(pr.AssignedReviewers, "user_5") {
+				t.Errorf("AssignedReviewers = %v, lead user_5 should not be needed", pr.AssignedReviewers)
+			}
+		}
+
+		pr, err := uc.CreatePR(ctx, "pr_lead_needed", "Lead PR", "user_1", WithReviewersCount(3))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if len(pr.AssignedReviewers) != 3 || !contains(pr.AssignedReviewers, "user_5") {
+			t.Errorf("AssignedReviewers = %v, want the lead to fill the third slot", pr.AssignedReviewers)
+		}
+	})
+
+	t.Run("reassign keeps the rules", func(t *testing.T) {
+		setupRoles(t)
+		testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status, team_id) VALUES ('pr_rules', 'Rules PR', 'user_1', 'OPEN', 1)`)
+		testDB.Exec(`INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ('pr_rules', 'user_7'), ('pr_rules', 'user_5')`)
+		uc := rolePolicy(assignment.RoleRules{RequireSenior: true})
+
+		if _, err := uc.ReassignReviewer(ctx, "pr_rules", "user_7"); !errors.Is(err, domain.ErrReviewerRules) {
+			t.Fatalf("Replacing the only senior: expected ErrReviewerRules, got %v", err)
+		}
+
+		newReviewer, err := uc.ReassignReviewer(ctx, "pr_rules", "user_5")
+		if err != nil {
+			t.Fatalf("ReassignReviewer() error = %v", err)
+		}
+		if newReviewer != "user_6" {
+			t.Errorf("New reviewer = %s, want user_6", newReviewer)
+		}
+	})
+}
+
+func TestPRUseCase_ReviewRotation(t *testing.T) {
+	ctx := context.Background()
+	// в backend-team кроме автора user_1 активны user_5, user_6 и user_7; user_6 ещё не ревьюил user_1
+	setupRotation := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'tina', 1, true), ('user_7', 'sam', 1, true)`)
+		testDB.Exec(`UPDATE teams SET review_rotation = true WHERE id = 1`)
+		testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status, team_id, created_at) VALUES
+			('pr_old', 'Old PR', 'user_1', 'MERGED', 1, NOW() - INTERVAL '3 days'),
+			('pr_recent', 'Recent PR', 'user_1', 'MERGED', 1, NOW() - INTERVAL '1 day')`)
+		testDB.Exec(`INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) VALUES
+			('pr_old', 'user_7', NOW() - INTERVAL '3 days'),
+			('pr_recent', 'user_5', NOW() - INTERVAL '1 day')`)
+	}
+	policy := assignment.DefaultPolicy()
+	policy.ReviewersCount = 1
+
+	t.Run("least recent reviewer of the author is picked", func(t *testing.T) {
+		setupRotation(t)
+		uc := newPolicyPRUseCase(policy)
+
+		want := []string{"user_6", "user_7", "user_5", "user_6"}
+		for i, reviewer := range want {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_rotation_%d", i), "Rotation PR", "user_1")
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{reviewer}) {
+				t.Errorf("PR %d: AssignedReviewers = %v, want [%s]", i, pr.AssignedReviewers, reviewer)
+			}
+		}
+	})
+
+	t.Run("rotation honours capacity", func(t *testing.T) {
+		setupRotation(t)
+		// при доле 25 трое суток простоя user_7 весят меньше суток простоя user_5
+		testDB.Exec(`UPDATE users SET capacity = 25 WHERE id = 'user_7'`)
+		uc := newPolicyPRUseCase(policy)
+
+		for i, reviewer := range []string{"user_6", "user_5"} {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_weighted_%d", i), "Rotation PR", "user_1")
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{reviewer}) {
+				t.Errorf("PR %d: AssignedReviewers = %v, want [%s]", i, pr.AssignedReviewers, reviewer)
+			}
+		}
+	})
+
+	t.Run("team setting toggles rotation", func(t *testing.T) {
+		setupRotation(t)
+		team, err := teamUseCase.SetReviewRotation(ctx, "backend-team", false)
+		if err != nil {
+			t.Fatalf("SetReviewRotation() error = %v", err)
+		}
+		if team.ReviewRotation {
+			t.Error("ReviewRotation = true, want false")
+		}
+		saved, err := teamUseCase.GetTeam(ctx, "backend-team")
+		if err != nil {
+			t.Fatalf("GetTeam() error = %v", err)
+		}
+		if saved.ReviewRotation {
+			t.Error("saved ReviewRotation = true, want false")
+		}
+
+		if _, err := teamUseCase.SetReviewRotation(ctx, "unknown-team", true); !errors.Is(err, domain.ErrTeamNotFound) {
+			t.Errorf("SetReviewRotation() error = %v, want ErrTeamNotFound", err)
+		}
+	})
+
+	t.Run("review matrix counts author and reviewer pairs", func(t *testing.T) {
+		setupRotation(t)
+		testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status, team_id) VALUES ('pr_more', 'More PR', 'user_1', 'OPEN', 1)`)
+		testDB.Exec(`INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ('pr_more', 'user_5')`)
+		uc := newPolicyPRUseCase(policy)
+
+		matrix, err := uc.GetReviewMatrix(ctx, "backend-team")
+		if err != nil {
+			t.Fatalf("GetReviewMatrix() error = %v", err)
+		}
+		wantMembers := []string{"user_1", "user_2", "user_5", "user_6", "user_7"}
+		if !reflect.DeepEqual(matrix.Members, wantMembers) {
+			t.Errorf("Members = %v, want %v", matrix.Members, wantMembers)
+		}
+		got := Map(matrix.Pairs, func(p domain.ReviewPair) string {
+			return fmt.Sprintf("%s>%s:%d", p.AuthorID, p.ReviewerID, p.Reviews)
+		})
+		want := []string{"user_1>user_5:2", "user_1>user_7:1"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Pairs = %v, want %v", got, want)
+		}
+
+		if _, err := uc.GetReviewMatrix(ctx, "unknown-team"); !errors.Is(err, domain.ErrTeamNotFound) {
+			t.Errorf("GetReviewMatrix() error = %v, want ErrTeamNotFound", err)
+		}
+	})
+}
+
+func TestPRUseCase_Capacity(t *testing.T) {
+	ctx := context.Background()
+	// в backend-team кроме автора user_1 активны user_5 и user_6; у user_6 нулевая доля ревью
+	setupCapacity := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'tina', 1, true)`)
+		if _, err := userUseCase.SetCapacity(ctx, "user_6", 0); err != nil {
+			t.Fatalf("SetCapacity() error = %v", err)
+		}
+	}
+
+	t.Run("zero capacity is never assigned", func(t *testing.T) {
+		setupCapacity(t)
+		for _, mode := range []assignment.Mode{assignment.ModeRandom, assignment.ModeLeastLoaded} {
+			policy := assignment.DefaultPolicy()
+			policy.Mode = mode
+			uc := newPolicyPRUseCase(policy)
+
+			for i := 0; i < 5; i++ {
+				pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_%s_%d", mode, i), "Capacity PR", "user_1")
+				if err != nil {
+					t.Fatalf("CreatePR() error = %v", err)
+				}
+				if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_5"}) {
+					t.Errorf("%s: AssignedReviewers = %v, want only user_5", mode, pr.AssignedReviewers)
+				}
+			}
+		}
+	})
+
+	t.Run("capacity is shown in team view and stats", func(t *testing.T) {
+		setupCapacity(t)
+
+		team, err := teamUseCase.GetTeam(ctx, "backend-team")
+		if err != nil {
+			t.Fatalf("GetTeam() error = %v", err)
+		}
+		capacities := make(map[string]int)
+		for _, m := range team.Members {
+			capacities[m.UserID] = m.Capacity
+		}
+		want := map[string]int{"user_1": 100, "user_2": 100, "user_5": 100, "user_6": 0}
+		if !reflect.DeepEqual(capacities, want) {
+			t.Errorf("member capacities = %v, want %v", capacities, want)
+		}
+
+		stats, err := teamUseCase.GetSubtreeStats(ctx, "backend-team")
+		if err != nil {
+			t.Fatalf("GetSubtreeStats() error = %v", err)
+		}
+		// user_2 неактивен, у user_6 нулевая доля
+		if len(stats) != 1 || stats[0].ActiveCapacity != 200 {
+			t.Errorf("GetSubtreeStats() = %+v, want active capacity 200", stats)
+		}
+	})
+}
+
+func TestPRUseCase_RequiredTags(t *testing.T) {
+	ctx := context.Background()
+	// в backend-team кроме автора user_1 активны user_5, user_6 и user_7; теги есть только у user_6 и user_7
+	setupTags := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'tina', 1, true), ('user_7', 'max', 1, true)`)
+		if _, err := userUseCase.SetTags(ctx, "user_6", []string{"go"}); err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+		if _, err := userUseCase.SetTags(ctx, "user_7", []string{"go", "postgres"}); err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+	}
+
+	t.Run("reviewer covering the tags is preferred", func(t *testing.T) {
+		setupTags(t)
+		for _, mode := range []assignment.Mode{assignment.ModeRandom, assignment.ModeLeastLoaded} {
+			policy := assignment.DefaultPolicy()
+			policy.Mode = mode
+			uc := newPolicyPRUseCase(policy)
+
+			for i := 0; i < 3; i++ {
+				pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_%s_%d", mode, i), "Tags PR", "user_1",
+					WithReviewersCount(1), WithRequiredTags("Postgres"))
+				if err != nil {
+					t.Fatalf("CreatePR() error = %v", err)
+				}
+				if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_7"}) {
+					t.Errorf("%s: AssignedReviewers = %v, want [user_7]", mode, pr.AssignedReviewers)
+				}
+				if len(pr.UncoveredTags) != 0 {
+					t.Errorf("%s: UncoveredTags = %v, want none", mode, pr.UncoveredTags)
+				}
+			}
+		}
+	})
+
+	t.Run("uncovered tags are reported and required tags stored", func(t *testing.T) {
+		setupTags(t)
+
+		pr, err := newPolicyPRUseCase(assignment.DefaultPolicy()).CreatePR(ctx, "pr_tags", "Tags PR", "user_1",
+			WithRequiredTags("rust", "postgres", "go"))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if !slices.Contains(pr.AssignedReviewers, "user_7") || len(pr.AssignedReviewers) != 2 {
+			t.Errorf("AssignedReviewers = %v, want user_7 and one more", pr.AssignedReviewers)
+		}
+		if !reflect.DeepEqual(pr.UncoveredTags, []string{"rust"}) {
+			t.Errorf("UncoveredTags = %v, want [rust]", pr.UncoveredTags)
+		}
+
+		stored, err := prRepo.FindByID(ctx, "pr_tags")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if want := []string{"go", "postgres", "rust"}; !reflect.DeepEqual(stored.RequiredTags, want) {
+			t.Errorf("Stored RequiredTags = %v, want %v", stored.RequiredTags, want)
+		}
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		setupTags(t)
+
+		_, err := newPolicyPRUseCase(assignment.DefaultPolicy()).CreatePR(ctx, "pr_bad_tags", "Tags PR", "user_1", WithRequiredTags("two words"))
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestPRUseCase_Priority(t *testing.T) {
+	ctx := context.Background()
+	// в backend-team кроме автора user_1 активны user_5, user_6 и user_7; у user_5 и user_6 по два открытых ревью
+	setupLoad := func(t *testing.T) {
+		t.Helper()
+		setupTestData(t)
+		testDB.Exec(`INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'tina', 1, true), ('user_7', 'max', 1, true)`)
+		testDB.Exec(`INSERT INTO pull_requests (id, title, author_id, status) VALUES ('pr_load_1', 'Load', 'user_3', 'OPEN'), ('pr_load_2', 'Load', 'user_3', 'OPEN')`)
+		testDB.Exec(`INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES
+			('pr_load_1', 'user_5'), ('pr_load_1', 'user_6'), ('pr_load_2', 'user_5'), ('pr_load_2', 'user_6')`)
+	}
+
+	t.Run("hotfix goes to the least loaded reviewer regardless of mode and rotation", func(t *testing.T) {
+		setupLoad(t)
+		if _, err := teamUseCase.SetReviewRotation(ctx, "backend-team", true); err != nil {
+			t.Fatalf("SetReviewRotation() error = %v", err)
+		}
+		uc := newPolicyPRUseCase(assignment.DefaultPolicy())
+
+		for i := 0; i < 2; i++ {
+			pr, err := uc.CreatePR(ctx, fmt.Sprintf("pr_hotfix_%d", i), "Hotfix", "user_1",
+				WithReviewersCount(1), WithPriority(domain.PRPriorityHotfix))
+			if err != nil {
+				t.Fatalf("CreatePR() error = %v", err)
+			}
+			if pr.Priority != domain.PRPriorityHotfix {
+				t.Errorf("Priority = %q, want hotfix", pr.Priority)
+			}
+			if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_7"}) {
+				t.Errorf("AssignedReviewers = %v, want [user_7]", pr.AssignedReviewers)
+			}
+		}
+	})
+
+	t.Run("hotfix prefers reviewers who are online", func(t *testing.T) {
+		setupLoad(t)
+		if _, err := userUseCase.Heartbeat(ctx, "user_5"); err != nil {
+			t.Fatalf("Heartbeat() error = %v", err)
+		}
+		// heartbeat user_6 давно устарел
+		testDB.Exec("UPDATE users SET last_seen_at = NOW() - INTERVAL '1 hour' WHERE id = 'user_6'")
+		uc := newPolicyPRUseCase(assignment.DefaultPolicy())
+
+		pr, err := uc.CreatePR(ctx, "pr_hotfix_online", "Hotfix", "user_1", WithReviewersCount(1), WithPriority(domain.PRPriorityHotfix))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		// user_5 загружен больше user_7, но только он онлайн
+		if !reflect.DeepEqual(pr.AssignedReviewers, []string{"user_5"}) {
+			t.Errorf("AssignedReviewers = %v, want [user_5]", pr.AssignedReviewers)
+		}
+
+		// онлайн-кандидатов не хватает - остальные добираются по загрузке
+		pr, err = uc.CreatePR(ctx, "pr_hotfix_mixed", "Hotfix", "user_1", WithReviewersCount(2), WithPriority(domain.PRPriorityHotfix))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		reviewers := append([]string(nil), pr.AssignedReviewers...)
+		sort.Strings(reviewers)
+		if !reflect.DeepEqual(reviewers, []string{"user_5", "user_7"}) {
+			t.Errorf("AssignedReviewers = %v, want user_5 and user_7", pr.AssignedReviewers)
+		}
+	})
+
+	t.Run("priority defaults to normal", func(t *testing.T) {
+		setupTestData(t)
+
+		pr, err := prUseCase.CreatePR(ctx, "pr_normal", "Normal", "user_1")
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		stored, err := prRepo.FindByID(ctx, pr.ID)
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if pr.Priority != domain.PRPriorityNormal || stored.Priority != domain.PRPriorityNormal {
+			t.Errorf("Priority = %q, stored %q, want normal", pr.Priority, stored.Priority)
+		}
+
+		if _, err := prUseCase.CreatePR(ctx, "pr_urgent", "Urgent", "user_1", WithPriority("urgent")); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("set priority", func(t *testing.T) {
+		setupLoad(t)
+
+		pr, err := prUseCase.SetPriority(ctx, "pr_load_1", domain.PRPriorityHigh)
+		if err != nil {
+			t.Fatalf("SetPriority() error = %v", err)
+		}
+		if pr.Priority != domain.PRPriorityHigh {
+			t.Errorf("Priority = %q, want high", pr.Priority)
+		}
+
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "pr_load_1", Action: domain.AuditActionPRPriorityChanged})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 1 || entries[0].OldValue["priority"] != string(domain.PRPriorityNormal) {
+			t.Errorf("Audit entries = %+v, want one change from normal", entries)
+		}
+
+		prs, err := prUseCase.GetPRsByReviewer(ctx, "user_5", domain.ReviewListFilter{ByPriority: true})
+		if err != nil {
+			t.Fatalf("GetPRsByReviewer() error = %v", err)
+		}
+		if len(prs) != 2 || prs[0].ID != "pr_load_1" {
+			t.Errorf("GetPRsByReviewer() = %+v, want pr_load_1 first", prs)
+		}
+		prs, err = prUseCase.GetPRsByReviewer(ctx, "user_5", domain.ReviewListFilter{Priorities: []domain.PRPriority{domain.PRPriorityNormal}})
+		if err != nil {
+			t.Fatalf("GetPRsByReviewer() error = %v", err)
+		}
+		if len(prs) != 1 || prs[0].ID != "pr_load_2" {
+			t.Errorf("GetPRsByReviewer(normal) = %+v, want only pr_load_2", prs)
+		}
+	})
+
+	t.Run("set priority errors", func(t *testing.T) {
+		setupLoad(t)
+		if _, err := prUseCase.MergePR(ctx, "pr_load_2"); err != nil {
+			t.Fatalf("MergePR() error = %v", err)
+		}
+
+		if _, err := prUseCase.SetPriority(ctx, "pr_load_2", domain.PRPriorityHotfix); !errors.Is(err, domain.ErrPRMerged) {
+			t.Errorf("Expected ErrPRMerged, got %v", err)
+		}
+		if _, err := prUseCase.SetPriority(ctx, "pr_load_1", "urgent"); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+		if _, err := prUseCase.SetPriority(ctx, "non_existent_pr", domain.PRPriorityHigh); !errors.Is(err, domain.ErrPRNotFound) {
+			t.Errorf("Expected ErrPRNotFound, got %v", err)
+		}
+	})
+}
+
+func TestPRUseCase_LabelsAndMetadata(t *testing.T) {
+	ctx := context.Background()
+	metadata := domain.PRMetadata{
+		Repository:   "org/search",
+		SourceBranch: "feature/search",
+		TargetBranch: "main",
+		URL:          "https://git.example.com/org/search/pull/1",
+		LinesChanged: 240,
+	}
+
+	t.Run("labels and metadata are set at creation", func(t *testing.T) {
+		setupTestData(t)
+
+		pr, err := prUseCase.CreatePR(ctx, "pr_meta", "Search", "user_1",
+			WithLabels("search ", "backend", "search"), WithMetadata(metadata))
+		if err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		stored, err := prRepo.FindByID(ctx, pr.ID)
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if want := []string{"backend", "search"}; !reflect.DeepEqual(stored.Labels, want) {
+			t.Errorf("Stored labels = %v, want %v", stored.Labels, want)
+		}
+		if stored.Metadata != metadata {
+			t.Errorf("Stored metadata = %+v, want %+v", stored.Metadata, metadata)
+		}
+
+		_, err = prUseCase.CreatePR(ctx, "pr_bad_meta", "Search", "user_1", WithMetadata(domain.PRMetadata{URL: "not a url"}))
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("update replaces only the given fields", func(t *testing.T) {
+		setupTestData(t)
+		if _, err := prUseCase.CreatePR(ctx, "pr_meta", "Search", "user_1", WithLabels("backend"), WithMetadata(metadata)); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+
+		labels := []string{"release", "backend"}
+		pr, err := prUseCase.UpdatePR(ctx, "pr_meta", domain.PRUpdate{Labels: &labels})
+		if err != nil {
+			t.Fatalf("UpdatePR() error = %v", err)
+		}
+		if want := []string{"backend", "release"}; !reflect.DeepEqual(pr.Labels, want) || pr.Metadata != metadata {
+			t.Errorf("UpdatePR() labels = %v, metadata = %+v, want %v and unchanged metadata", pr.Labels, pr.Metadata, want)
+		}
+
+		// повтор без изменений в журнал не пишется
+		if _, err := prUseCase.UpdatePR(ctx, "pr_meta", domain.PRUpdate{Labels: &labels}); err != nil {
+			t.Fatalf("UpdatePR() error = %v", err)
+		}
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "pr_meta", Action: domain.AuditActionPRUpdated})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 1 || entries[0].NewValue["metadata"] != nil {
+			t.Errorf("Audit entries = %+v, want one labels change", entries)
+		}
+
+		// метаданные смёрженного PR тоже можно поправить
+		if _, err := prUseCase.MergePR(ctx, "pr_meta"); err != nil {
+			t.Fatalf("MergePR() error = %v", err)
+		}
+		pr, err = prUseCase.UpdatePR(ctx, "pr_meta", domain.PRUpdate{Metadata: &domain.PRMetadata{}})
+		if err != nil {
+			t.Fatalf("UpdatePR() error = %v", err)
+		}
+		stored, err := prRepo.FindByID(ctx, "pr_meta")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if stored.Metadata != (domain.PRMetadata{}) || len(stored.Labels) != 2 {
+			t.Errorf("Stored PR = %+v, want cleared metadata and kept labels", stored)
+		}
+	})
+
+	t.Run("concurrent updates of different fields are both kept", func(t *testing.T) {
+		setupTestData(t)
+		if _, err := prUseCase.CreatePR(ctx, "pr_race", "Search", "user_1"); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+
+		labels := []string{"backend"}
+		updates := []domain.PRUpdate{{Labels: &labels}, {Metadata: &metadata}}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			for _, update := range updates {
+				wg.Add(1)
+				go func(update domain.PRUpdate) {
+					defer wg.Done()
+					if _, err := prUseCase.UpdatePR(ctx, "pr_race", update); err != nil {
+						t.Errorf("UpdatePR() error = %v", err)
+					}
+				}(update)
+			}
+		}
+		wg.Wait()
+
+		stored, err := prRepo.FindByID(ctx, "pr_race")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if !reflect.DeepEqual(stored.Labels, labels) || stored.Metadata != metadata {
+			t.Errorf("Stored labels = %v, metadata = %+v, want both updates", stored.Labels, stored.Metadata)
+		}
+	})
+
+	t.Run("listing is filtered by label and repository", func(t *testing.T) {
+		setupTestData(t)
+		// в backend-team единственный активный ревьювер для user_1 - user_5
+		if _, err := prUseCase.CreatePR(ctx, "pr_search", "Search", "user_1", WithLabels("backend"), WithMetadata(metadata)); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+		if _, err := prUseCase.CreatePR(ctx, "pr_cart", "Cart", "user_1", WithMetadata(domain.PRMetadata{Repository: "org/cart"})); err != nil {
+			t.Fatalf("CreatePR() error = %v", err)
+		}
+
+		prs, err := prUseCase.GetPRsByReviewer(ctx, "user_5", domain.ReviewListFilter{Labels: []string{" backend"}})
+		if err != nil {
+			t.Fatalf("GetPRsByReviewer() error = %v", err)
+		}
+		if len(prs) != 1 || prs[0].ID != "pr_search" {
+			t.Errorf("GetPRsByReviewer(label) = %+v, want only pr_search", prs)
+		}
+		prs, err = prUseCase.GetPRsByReviewer(ctx, "user_5", domain.ReviewListFilter{Repository: "org/cart"})
+		if err != nil {
+			t.Fatalf("GetPRsByReviewer() error = %v", err)
+		}
+		if len(prs) != 1 || prs[0].ID != "pr_cart" {
+			t.Errorf("GetPRsByReviewer(repository) = %+v, want only pr_cart", prs)
+		}
+	})
+
+	t.Run("update errors", func(t *testing.T) {
+		setupTestData(t)
+
+		labels := []string{"backend"}
+		if _, err := prUseCase.UpdatePR(ctx, "non_existent_pr", domain.PRUpdate{Labels: &labels}); !errors.Is(err, domain.ErrPRNotFound) {
+			t.Errorf("Expected ErrPRNotFound, got %v", err)
+		}
+		if _, err := prUseCase.UpdatePR(ctx, "non_existent_pr", domain.PRUpdate{}); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Clock отдаёт текущее время, в тестах подменяется на фиксированное
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock возвращает часы, основанные на time.Now
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// lockedRand делает *rand.Rand безопасным для конкурентных запросов
+type lockedRand struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func newLockedRand(src rand.Source) *lockedRand {
+	return &lockedRand{rnd: rand.New(src)}
+}
+
+func (r *lockedRand) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rnd.Intn(n)
+}
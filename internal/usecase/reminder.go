@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/notify"
+	pullrequest "avito-test-task/internal/repository/pull_request"
+	"avito-test-task/internal/repository/user"
+)
+
+// ReminderActor записывается в журнал для переназначений по истечении SLA
+const ReminderActor = "review-reminder"
+
+// ReminderSettings - пороги для команд, которые не задали свои
+type ReminderSettings struct {
+	DefaultSLA           time.Duration
+	DefaultReassignAfter time.Duration
+}
+
+// ReminderStats - итог одного прохода по зависшим ревью
+type ReminderStats struct {
+	Reminded   int
+	Reassigned int
+	Failed     int
+}
+
+// ReminderUseCase напоминает ревьюверам о зависших ревью и заменяет тех, кто не ответил до второго порога
+type ReminderUseCase struct {
+	prRepo   pullrequest.PRRepository
+	userRepo user.UserRepository
+	prUC     *PRUseCase
+	notifier notify.Notifier
+	settings ReminderSettings
+}
+
+// NewReminderUseCase принимает notifier, который возвращает ошибки доставки: недоставленное напоминание повторяется.
+// О переназначениях уведомляет prUC своим notifier
+func NewReminderUseCase(prRepo pullrequest.PRRepository, userRepo user.UserRepository, prUC *PRUseCase, notifier notify.Notifier, settings ReminderSettings) *ReminderUseCase {
+	return &ReminderUseCase{
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		prUC:     prUC,
+		notifier: notifier,
+		settings: settings,
+	}
+}
+
+// ProcessStaleReviews обрабатывает назначения, которые к моменту now ждут дольше порогов команды PR
+// (для PR без команды - основной команды автора).
+// Напоминание отправляется один раз на назначение; недоставленное повторяется на следующем проходе.
+// Если заменить ревьювера некем, вместо переназначения отправляется напоминание
+func (uc *ReminderUseCase) ProcessStaleReviews(ctx context.Context, now time.Time) (ReminderStats, error) {
+	var stats ReminderStats
+
+	reviews, err := uc.prRepo.FindStaleReviews(ctx, now, uc.settings.DefaultSLA, uc.settings.DefaultReassignAfter)
+	if err != nil {
+		return stats, err
+	}
+
+	users, err := uc.recipients(ctx, reviews)
+	if err != nil {
+		return stats, err
+	}
+
+	ctx = WithActor(ctx, ReminderActor)
+	for _, review := range reviews {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		sla, reassignAfter := uc.thresholds(review)
+		waited := now.Sub(review.WaitingSince)
+
+		if waited >= reassignAfter {
+			_, err := uc.prUC.ReassignStaleReviewer(ctx, review.PullRequestID, review.ReviewerID)
+			switch {
+			case err == nil:
+				stats.Reassigned++
+				continue
+			case errors.Is(err, domain.ErrNoCandidates):
+				// заменить некем, ревьюверу остаётся напоминание
+			case errors.Is(err, domain.ErrPRMerged), errors.Is(err, domain.ErrPRNotFound), errors.Is(err, domain.ErrReviewerNotAssigned):
+				// PR смёржили или ревьювера сняли после выборки
+				continue
+			default:
+				stats.Failed++
+				log.Printf("Failed to reassign stale reviewer %s on PR %s: %v", review.ReviewerID, review.PullRequestID, err)
+				continue
+			}
+		}
+
+		if waited < sla || review.RemindedAt != nil {
+			continue
+		}
+
+		if err := uc.notifier.Notify(ctx, reminderNotification(review, users)); err != nil {
+			stats.Failed++
+			log.Printf("Failed to remind %s about PR %s: %v", review.ReviewerID, review.PullRequestID, err)
+			continue
+		}
+		if err := uc.prRepo.MarkReminded(ctx, review.PullRequestID, review.ReviewerID, now); err != nil && !errors.Is(err, domain.ErrReviewerNotAssigned) {
+			stats.Failed++
+			log.Printf("Failed to mark reminder for %s on PR %s: %v", review.ReviewerID, review.PullRequestID, err)
+			continue
+		}
+		stats.Reminded++
+	}
+
+	return stats, nil
+}
+
+// thresholds возвращает пороги напоминания и переназначения с учётом настроек команды
+func (uc *ReminderUseCase) thresholds(review *domain.StaleReview) (sla, reassignAfter time.Duration) {
+	sla, reassignAfter = uc.settings.DefaultSLA, uc.settings.DefaultReassignAfter
+	if review.ReviewSLAHours != 0 {
+		sla = time.Duration(review.ReviewSLAHours) * time.Hour
+	}
+	if review.ReassignAfterHours != 0 {
+		reassignAfter = time.Duration(review.ReassignAfterHours) * time.Hour
+	}
+	return sla, reassignAfter
+}
+
+// recipients загружает ревьюверов и авторов зависших ревью одним запросом
+func (uc *ReminderUseCase) recipients(ctx context.Context, reviews []*domain.StaleReview) (map[string]*domain.User, error) {
+	if len(reviews) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, 2*len(reviews))
+	for _, review := range reviews {
+		ids = append(ids, review.ReviewerID, review.AuthorID)
+	}
+	users, err := uc.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*domain.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	return byID, nil
+}
+
+func reminderNotification(review *domain.StaleReview, users map[string]*domain.User) notify.Notification {
+	n := notify.Notification{
+		Kind:            notify.KindReviewReminder,
+		Recipient:       domain.User{ID: review.ReviewerID, Username: review.ReviewerName},
+		PullRequestID:   review.PullRequestID,
+		PullRequestName: review.Title,
+		AuthorID:        review.AuthorID,
+		AuthorName:      review.AuthorID,
+		TeamName:        review.TeamName,
+		ReviewerID:      review.ReviewerID,
+		WaitingSince:    review.WaitingSince,
+	}
+	if reviewer, ok := users[review.ReviewerID]; ok {
+		n.Recipient = *reviewer
+	}
+	if author, ok := users[review.AuthorID]; ok {
+		n.AuthorName = author.Username
+	}
+	return n
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/notify"
+)
+
+type recordingNotifier struct {
+	notifications []notify.Notification
+	err           error
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, notification notify.Notification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestReminderUseCase_ProcessStaleReviews(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	settings := ReminderSettings{DefaultSLA: 24 * time.Hour, DefaultReassignAfter: 72 * time.Hour}
+
+	insertReview := func(t *testing.T, prID, authorID, reviewerID string, assignedAgo time.Duration) {
+		t.Helper()
+		assignedAt := now.Add(-assignedAgo)
+		if _, err := testDB.Exec(`
+			INSERT INTO pull_requests (id, title, author_id, status, created_at, required_reviewers)
+			VALUES ($1, 'Stale PR', $2, 'OPEN', $3, 1)
+		`, prID, authorID, assignedAt); err != nil {
+			t.Fatalf("Failed to insert PR: %v", err)
+		}
+		if _, err := testDB.Exec(`
+			INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) VALUES ($1, $2, $3)
+		`, prID, reviewerID, assignedAt); err != nil {
+			t.Fatalf("Failed to insert reviewer: %v", err)
+		}
+	}
+
+	t.Run("reminder is sent once per assignment", func(t *testing.T) {
+		setupTestData(t)
+		insertReview(t, "pr_stale", "user_3", "user_4", 30*time.Hour)
+		insertReview(t, "pr_fresh", "user_4", "user_3", time.Hour)
+
+		notifier := &recordingNotifier{}
+		uc := NewReminderUseCase(*prRepo, *userRepo, &prUseCase, notifier, settings)
+
+		stats, err := uc.ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{Reminded: 1}) {
+			t.Errorf("Stats = %+v, want 1 reminder", stats)
+		}
+		if len(notifier.notifications) != 1 {
+			t.Fatalf("Expected 1 notification, got %d", len(notifier.notifications))
+		}
+		got := notifier.notifications[0]
+		if got.Kind != notify.KindReviewReminder || got.PullRequestID != "pr_stale" || got.Recipient.Username != "dave" ||
+			got.AuthorName != "charlie" || got.TeamName != "frontend-team" {
+			t.Errorf("Notification = %+v", got)
+		}
+
+		stats, err = uc.ProcessStaleReviews(ctx, now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("Second ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{}) || len(notifier.notifications) != 1 {
+			t.Errorf("Reminder should not repeat, stats = %+v, notifications = %d", stats, len(notifier.notifications))
+		}
+	})
+
+	t.Run("team SLA overrides default", func(t *testing.T) {
+		setupTestData(t)
+		insertReview(t, "pr_stale", "user_3", "user_4", 3*time.Hour)
+
+		if _, err := teamUseCase.SetReviewSLA(ctx, "frontend-team", 2, 0); err != nil {
+			t.Fatalf("SetReviewSLA() error = %v", err)
+		}
+
+		notifier := &recordingNotifier{}
+		stats, err := NewReminderUseCase(*prRepo, *userRepo, &prUseCase, notifier, settings).ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("ProcessStaleReviews() error = %v", err)
+		}
+		if stats.Reminded != 1 {
+			t.Errorf("Stats = %+v, want 1 reminder", stats)
+		}
+	})
+
+	t.Run("failed delivery is retried", func(t *testing.T) {
+		setupTestData(t)
+		insertReview(t, "pr_stale", "user_3", "user_4", 30*time.Hour)
+
+		notifier := &recordingNotifier{err: errors.New("smtp unavailable")}
+		uc := NewReminderUseCase(*prRepo, *userRepo, &prUseCase, notifier, settings)
+
+		stats, err := uc.ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{Failed: 1}) {
+			t.Errorf("Stats = %+v, want 1 failure", stats)
+		}
+
+		notifier.err = nil
+		stats, err = uc.ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("Second ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{Reminded: 1}) {
+			t.Errorf("Stats = %+v, want 1 reminder after retry", stats)
+		}
+	})
+
+	t.Run("expired review is reassigned", func(t *testing.T) {
+		setupTestData(t)
+		testDB.Exec(`
+			INSERT INTO users (id, username, team_id, is_active) VALUES ('user_6', 'eve', 1, true)
+		`)
+		insertReview(t, "pr_expired", "user_1", "user_5", 80*time.Hour)
+
+		notifier := &recordingNotifier{}
+		stats, err := NewReminderUseCase(*prRepo, *userRepo, &prUseCase, notifier, settings).ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{Reassigned: 1}) {
+			t.Errorf("Stats = %+v, want 1 reassignment", stats)
+		}
+
+		pr, err := prRepo.FindByID(ctx, "pr_expired")
+		if err != nil {
+			t.Fatalf("Failed to verify PR in DB: %v", err)
+		}
+		if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != "user_6" {
+			t.Errorf("Reviewers = %v, want [user_6]", pr.AssignedReviewers)
+		}
+		// о переназначении уведомляет PRUseCase, напоминание уже не нужно
+		if len(notifier.notifications) != 0 {
+			t.Errorf("Notifications = %+v, want none", notifier.notifications)
+		}
+
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "pr_expired", Action: domain.AuditActionReviewerReplaced})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 1 || entries[0].Reason != domain.AuditReasonSLAExpired || entries[0].Actor != ReminderActor {
+			t.Errorf("Audit entries = %+v, want one sla_expired entry by %s", entries, ReminderActor)
+		}
+	})
+
+	t.Run("expired review without candidates falls back to reminder", func(t *testing.T) {
+		setupTestData(t)
+		// в backend-team кроме автора и ревьювера только неактивный user_2
+		insertReview(t, "pr_expired", "user_1", "user_5", 80*time.Hour)
+
+		notifier := &recordingNotifier{}
+		stats, err := NewReminderUseCase(*prRepo, *userRepo, &prUseCase, notifier, settings).ProcessStaleReviews(ctx, now)
+		if err != nil {
+			t.Fatalf("ProcessStaleReviews() error = %v", err)
+		}
+		if stats != (ReminderStats{Reminded: 1}) {
+			t.Errorf("Stats = %+v, want 1 reminder", stats)
+		}
+		if len(notifier.notifications) != 1 || notifier.notifications[0].Kind != notify.KindReviewReminder {
+			t.Errorf("Notifications = %+v, want one reminder", notifier.notifications)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"avito-test-task/internal/domain"
 	"avito-test-task/internal/repository/team"
@@ -21,18 +22,84 @@ func NewTeamUseCase(teamRepo team.TeamRepository, userRepo user.UserRepository)
 }
 
 func (uc *TeamUseCase) CreateTeam(ctx context.Context, team *domain.Team) (*domain.Team, error) {
-	if err := uc.teamRepo.SaveTeam(ctx, team); err != nil {
+	if err := team.Validate(); err != nil {
+		return nil, err
+	}
+
+	if team.ReviewersCount != 0 {
+		if err := validateReviewersCount(team.ReviewersCount, len(team.Members)); err != nil {
+			return nil, err
+		}
+	} else {
+		team.ReviewersCount = domain.DefaultReviewersCount
+	}
+
+	if team.ParentName != "" {
+		parent, err := uc.teamRepo.FindByName(ctx, team.ParentName)
+		if err != nil {
+			return nil, err
+		}
+		team.ParentID = parent.ID
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   team.Name,
+		Action:     domain.AuditActionTeamCreated,
+		NewValue: map[string]any{
+			"team_name":       team.Name,
+			"parent_name":     team.ParentName,
+			"reviewers_count": team.ReviewersCount,
+			"review_sla":      reviewSLAAuditValue(team.ReviewSLAHours, team.ReassignAfterHours),
+			"review_rotation": team.ReviewRotation,
+			"members":         Map(team.Members, func(m domain.TeamMember) string { return m.UserID }),
+		},
+	})
+	if err := uc.teamRepo.SaveTeam(ctx, team, entry); err != nil {
 		return nil, err
 	}
 
 	for _, member := range team.Members {
 		user := uc.member2user(&member, team.ID, team.Name)
-		if err := uc.userRepo.SaveUser(ctx, &user); err != nil {
+
+		// старое состояние нужно журналу; существующий пользователь остаётся в своей основной команде
+		previous, err := uc.userRepo.FindByID(ctx, user.ID)
+		if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+			return nil, err
+		}
+
+		entry := domain.AuditEntry{
+			EntityType: domain.AuditEntityUser,
+			EntityID:   user.ID,
+			Action:     domain.AuditActionUserSaved,
+			Reason:     domain.AuditReasonTeamSync,
+			NewValue:   userAuditValue(&user),
+		}
+		if previous != nil {
+			// у существующего пользователя SaveUser меняет только имя
+			saved := *previous
+			saved.Username = user.Username
+			entry.OldValue = userAuditValue(previous)
+			entry.NewValue = userAuditValue(&saved)
+		}
+		if err := uc.userRepo.SaveUser(ctx, &user, auditEntry(ctx, entry)); err != nil {
+			return nil, err
+		}
+		membership := auditEntry(ctx, membershipAuditEntry(team.Name, user.ID, nil, &domain.TeamMembership{
+			Role:     roleOrDefault(member.Role),
+			IsActive: member.IsActive,
+		}))
+		if err := uc.userRepo.SaveMembership(ctx, user.ID, team.ID, member.Role, member.IsActive, membership); err != nil {
 			return nil, err
 		}
-		team.Members = append(team.Members, uc.user2member(&user))
 	}
 
+	members, err := uc.userRepo.FindMembers(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	team.Members = members
+
 	return team, nil
 }
 
@@ -42,21 +109,268 @@ func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*domain.Te
 		return nil, err
 	}
 
-	users, err := uc.userRepo.FindByTeamID(ctx, team.ID)
+	team.Members, err = uc.userRepo.FindMembers(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// SetMember добавляет существующего пользователя в команду или меняет его роль и активность в ней
+func (uc *TeamUseCase) SetMember(ctx context.Context, teamName, userID string, role domain.MemberRole, isActive bool) (*domain.Team, error) {
+	if err := domain.ValidateMember(userID, role); err != nil {
+		return nil, err
+	}
+
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	role = roleOrDefault(role)
+	var previous *domain.TeamMembership
+	if m, ok := user.Membership(team.ID); ok {
+		previous = &m
+	}
+	entry := auditEntry(ctx, membershipAuditEntry(team.Name, userID, previous, &domain.TeamMembership{Role: role, IsActive: isActive}))
+	if err := uc.userRepo.SaveMembership(ctx, userID, team.ID, role, isActive, entry); err != nil {
+		return nil, err
+	}
+
+	return uc.GetTeam(ctx, teamName)
+}
+
+// RemoveMember исключает пользователя из команды; из основной команды исключить нельзя
+func (uc *TeamUseCase) RemoveMember(ctx context.Context, teamName, userID string) (*domain.Team, error) {
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	previous, ok := user.Membership(team.ID)
+	if !ok {
+		return nil, domain.ErrNotTeamMember
+	}
+
+	entry := auditEntry(ctx, membershipAuditEntry(team.Name, userID, &previous, nil))
+	if err := uc.userRepo.RemoveMembership(ctx, userID, team.ID, entry); err != nil {
+		return nil, err
+	}
+
+	return uc.GetTeam(ctx, teamName)
+}
+
+// SetPrimaryTeam делает основной одну из команд пользователя: из неё назначаются ревьюверы его PR по умолчанию
+func (uc *TeamUseCase) SetPrimaryTeam(ctx context.Context, userID, teamName string) (*domain.User, error) {
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityUser,
+		EntityID:   userID,
+		Action:     domain.AuditActionUserSaved,
+		OldValue:   map[string]any{"team_name": user.TeamName},
+		NewValue:   map[string]any{"team_name": team.Name},
+	})
+	if err := uc.userRepo.SetPrimaryTeam(ctx, userID, team.ID, entry); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.FindByID(ctx, userID)
+}
+
+// SearchUsersByTag ищет пользователей с тегом экспертизы; непустое teamName оставляет только участников этой команды
+func (uc *TeamUseCase) SearchUsersByTag(ctx context.Context, tag, teamName string) ([]*domain.User, error) {
+	tags := domain.NormalizeTags([]string{tag})
+	if err := domain.ValidateTags(tags); err != nil {
+		return nil, err
+	}
+
+	teamID := 0
+	if teamName != "" {
+		team, err := uc.teamRepo.FindByName(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teamID = team.ID
+	}
+
+	return uc.userRepo.FindByTag(ctx, tags[0], teamID)
+}
+
+// ListTeams возвращает все команды без участников
+func (uc *TeamUseCase) ListTeams(ctx context.Context) ([]*domain.Team, error) {
+	return uc.teamRepo.FindAll(ctx)
+}
+
+// GetTeamsByIDs возвращает команды без участников; используется для пакетной загрузки
+func (uc *TeamUseCase) GetTeamsByIDs(ctx context.Context, ids []int) ([]*domain.Team, error) {
+	return uc.teamRepo.FindByIDs(ctx, ids)
+}
+
+// SetReviewersCount меняет число ревьюверов для новых PR команды, значение проверяется по размеру команды
+func (uc *TeamUseCase) SetReviewersCount(ctx context.Context, teamName string, count int) (*domain.Team, error) {
+	team, err := uc.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateReviewersCount(count, len(team.Members)); err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   team.Name,
+		Action:     domain.AuditActionTeamSettingsChanged,
+		OldValue:   map[string]any{"reviewers_count": team.ReviewersCount},
+		NewValue:   map[string]any{"reviewers_count": count},
+	})
+	if err := uc.teamRepo.UpdateReviewersCount(ctx, team.ID, count, entry); err != nil {
+		return nil, err
+	}
+
+	team.ReviewersCount = count
+	return team, nil
+}
+
+// SetReviewSLA меняет пороги напоминания и переназначения ревьюверов команды, 0 возвращает значение из конфигурации
+func (uc *TeamUseCase) SetReviewSLA(ctx context.Context, teamName string, slaHours, reassignAfterHours int) (*domain.Team, error) {
+	if err := domain.ValidateReviewSLA(slaHours, reassignAfterHours); err != nil {
+		return nil, err
+	}
+
+	team, err := uc.GetTeam(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
 
-	team.Members = Map(users, uc.user2member)
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   team.Name,
+		Action:     domain.AuditActionTeamSettingsChanged,
+		OldValue:   map[string]any{"review_sla": reviewSLAAuditValue(team.ReviewSLAHours, team.ReassignAfterHours)},
+		NewValue:   map[string]any{"review_sla": reviewSLAAuditValue(slaHours, reassignAfterHours)},
+	})
+	if err := uc.teamRepo.UpdateReviewSLA(ctx, team.ID, slaHours, reassignAfterHours, entry); err != nil {
+		return nil, err
+	}
+
+	team.ReviewSLAHours = slaHours
+	team.ReassignAfterHours = reassignAfterHours
 	return team, nil
 }
 
-func (uc *TeamUseCase) user2member(u *domain.User) domain.TeamMember {
-	return domain.TeamMember{
-		UserID:   u.ID,
-		Username: u.Username,
-		IsActive: u.IsActive,
+// SetReviewRotation включает или выключает выбор ревьюверов команды по давности последнего ревью автора
+func (uc *TeamUseCase) SetReviewRotation(ctx context.Context, teamName string, enabled bool) (*domain.Team, error) {
+	team, err := uc.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
 	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   team.Name,
+		Action:     domain.AuditActionTeamSettingsChanged,
+		OldValue:   map[string]any{"review_rotation": team.ReviewRotation},
+		NewValue:   map[string]any{"review_rotation": enabled},
+	})
+	if err := uc.teamRepo.UpdateReviewRotation(ctx, team.ID, enabled, entry); err != nil {
+		return nil, err
+	}
+
+	team.ReviewRotation = enabled
+	return team, nil
+}
+
+// MoveTeam переносит команду вместе с поддеревом под parentName, пустое имя - на верхний уровень
+func (uc *TeamUseCase) MoveTeam(ctx context.Context, teamName, parentName string) (*domain.Team, error) {
+	team, err := uc.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID int
+	if parentName != "" {
+		parent, err := uc.teamRepo.FindByName(ctx, parentName)
+		if err != nil {
+			return nil, err
+		}
+		parentID = parent.ID
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   team.Name,
+		Action:     domain.AuditActionTeamMoved,
+		OldValue:   map[string]any{"parent_name": team.ParentName},
+		NewValue:   map[string]any{"parent_name": parentName},
+	})
+	if err := uc.teamRepo.Move(ctx, team.ID, parentID, entry); err != nil {
+		return nil, err
+	}
+
+	team.ParentID = parentID
+	team.ParentName = parentName
+	return team, nil
+}
+
+// GetSubtree возвращает команду со всеми вложенными командами, без участников
+func (uc *TeamUseCase) GetSubtree(ctx context.Context, teamName string) (*domain.TeamTree, error) {
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := uc.teamRepo.FindSubtree(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// FindSubtree отдаёт родителя раньше детей, поэтому узел родителя уже создан
+	nodes := make(map[int]*domain.TeamTree, len(teams))
+	var root *domain.TeamTree
+	for _, t := range teams {
+		node := &domain.TeamTree{Team: t, Children: []*domain.TeamTree{}}
+		nodes[t.ID] = node
+		if t.ID == team.ID {
+			root = node
+		} else if parent, ok := nodes[t.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	if root == nil {
+		return nil, domain.ErrTeamNotFound
+	}
+
+	return root, nil
+}
+
+// GetSubtreeStats возвращает показатели поддерева команды и каждой вложенной команды, корень - первый
+func (uc *TeamUseCase) GetSubtreeStats(ctx context.Context, teamName string) ([]domain.TeamStats, error) {
+	team, err := uc.teamRepo.FindByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.teamRepo.FindSubtreeStats(ctx, team.ID)
 }
 
 func (uc *TeamUseCase) member2user(m *domain.TeamMember, teamID int, teamName string) domain.User {
@@ -70,6 +384,46 @@ func (uc *TeamUseCase) member2user(m *domain.TeamMember, teamID int, teamName st
 
 }
 
+func userAuditValue(u *domain.User) map[string]any {
+	return map[string]any{
+		"username":  u.Username,
+		"team_name": u.TeamName,
+		"is_active": u.IsActive,
+	}
+}
+
+// membershipAuditEntry записывает в журнал команды изменение членства; nil - членства не было или больше нет
+func membershipAuditEntry(teamName, userID string, previous, current *domain.TeamMembership) domain.AuditEntry {
+	value := func(m *domain.TeamMembership) map[string]any {
+		if m == nil {
+			return nil
+		}
+		return map[string]any{"user_id": userID, "role": m.Role, "is_active": m.IsActive}
+	}
+	return domain.AuditEntry{
+		EntityType: domain.AuditEntityTeam,
+		EntityID:   teamName,
+		Action:     domain.AuditActionMembershipChanged,
+		OldValue:   value(previous),
+		NewValue:   value(current),
+	}
+}
+
+func roleOrDefault(role domain.MemberRole) domain.MemberRole {
+	if role == "" {
+		return domain.MemberRoleMember
+	}
+	return role
+}
+
+// reviewSLAAuditValue записывает пороги SLA в журнал; 0 - значение из конфигурации сервера
+func reviewSLAAuditValue(slaHours, reassignAfterHours int) map[string]any {
+	return map[string]any{
+		"review_sla_hours":     slaHours,
+		"reassign_after_hours": reassignAfterHours,
+	}
+}
+
 func Map[T any, R any](items []T, f func(T) R) []R {
 	result := make([]R, len(items))
 	for i, v := range items {
@@ -3,6 +3,8 @@ package usecase
 import (
 	"avito-test-task/internal/domain"
 	"context"
+	"errors"
+	"reflect"
 	"testing"
 
 	_ "github.com/lib/pq"
@@ -339,3 +341,338 @@ func TestTeamUseCase_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamUseCase_ReviewersCount(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		operation     func() (*domain.Team, error)
+		expectedError error
+		wantCount     int
+	}{
+		{
+			name: "create team with custom reviewers count",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.CreateTeam(ctx, &domain.Team{
+					Name:           "critical-team",
+					ReviewersCount: 3,
+					Members: []domain.TeamMember{
+						{UserID: "crit_1", Username: "crit1", IsActive: true},
+						{UserID: "crit_2", Username: "crit2", IsActive: true},
+						{UserID: "crit_3", Username: "crit3", IsActive: true},
+						{UserID: "crit_4", Username: "crit4", IsActive: true},
+					},
+				})
+			},
+			wantCount: 3,
+		},
+		{
+			name: "create team without reviewers count uses default",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.CreateTeam(ctx, &domain.Team{Name: "default-team"})
+			},
+			wantCount: domain.DefaultReviewersCount,
+		},
+		{
+			name: "create team with reviewers count larger than team",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.CreateTeam(ctx, &domain.Team{
+					Name:           "docs-team",
+					ReviewersCount: 2,
+					Members: []domain.TeamMember{
+						{UserID: "docs_1", Username: "docs1", IsActive: true},
+						{UserID: "docs_2", Username: "docs2", IsActive: true},
+					},
+				})
+			},
+			expectedError: domain.ErrInvalidReviewersCount,
+		},
+		{
+			name: "set reviewers count for existing team",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.SetReviewersCount(ctx, "backend-team", 1)
+			},
+			wantCount: 1,
+		},
+		{
+			name: "set reviewers count larger than team",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.SetReviewersCount(ctx, "frontend-team", 2)
+			},
+			expectedError: domain.ErrInvalidReviewersCount,
+		},
+		{
+			name: "set reviewers count for non-existent team",
+			operation: func() (*domain.Team, error) {
+				return teamUseCase.SetReviewersCount(ctx, "non-existent-team", 1)
+			},
+			expectedError: domain.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestData(t)
+
+			team, err := tt.operation()
+			if err != tt.expectedError {
+				t.Fatalf("Expected error %v, got %v", tt.expectedError, err)
+			}
+			if tt.expectedError != nil {
+				return
+			}
+
+			if team.ReviewersCount != tt.wantCount {
+				t.Errorf("ReviewersCount = %d, want %d", team.ReviewersCount, tt.wantCount)
+			}
+
+			stored, err := teamRepo.FindByName(ctx, team.Name)
+			if err != nil {
+				t.Fatalf("Failed to verify team in DB: %v", err)
+			}
+			if stored.ReviewersCount != tt.wantCount {
+				t.Errorf("ReviewersCount in DB = %d, want %d", stored.ReviewersCount, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestTeamUseCase_CreateTeamValidation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("duplicate member IDs are rejected before saving", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := teamUseCase.CreateTeam(ctx, &domain.Team{
+			Name: "dup-team",
+			Members: []domain.TeamMember{
+				{UserID: "dup_1", Username: "first", IsActive: true},
+				{UserID: "dup_1", Username: "second", IsActive: true},
+			},
+		})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Fatalf("Expected error %v, got %v", domain.ErrValidation, err)
+		}
+
+		if _, err := teamUseCase.GetTeam(ctx, "dup-team"); err != domain.ErrTeamNotFound {
+			t.Errorf("Team should not be saved, got err = %v", err)
+		}
+	})
+}
+
+func TestTeamUseCase_Memberships(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("existing user joins a new team and keeps the primary one", func(t *testing.T) {
+		setupTestData(t)
+
+		team, err := teamUseCase.CreateTeam(ctx, &domain.Team{
+			Name: "platform-team",
+			Members: []domain.TeamMember{
+				{UserID: "user_3", Username: "charlie", IsActive: true, Role: domain.MemberRoleLead},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateTeam() error = %v", err)
+		}
+		if len(team.Members) != 1 || team.Members[0].Role != domain.MemberRoleLead {
+			t.Errorf("Members = %+v, want user_3 as lead", team.Members)
+		}
+
+		user, err := userRepo.FindByID(ctx, "user_3")
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if user.TeamName != "frontend-team" {
+			t.Errorf("Primary team = %s, want frontend-team", user.TeamName)
+		}
+		if len(user.Teams) != 2 || !user.Teams[0].IsPrimary || user.Teams[1].TeamName != "platform-team" {
+			t.Errorf("Teams = %+v", user.Teams)
+		}
+
+		frontend, err := teamUseCase.GetTeam(ctx, "frontend-team")
+		if err != nil {
+			t.Fatalf("GetTeam() error = %v", err)
+		}
+		if len(frontend.Members) != 2 {
+			t.Errorf("frontend-team has %d members, want 2", len(frontend.Members))
+		}
+	})
+
+	t.Run("membership activity is per team", func(t *testing.T) {
+		setupTestData(t)
+
+		team, err := teamUseCase.SetMember(ctx, "frontend-team", "user_1", domain.MemberRoleMember, false)
+		if err != nil {
+			t.Fatalf("SetMember() error = %v", err)
+		}
+		if len(team.Members) != 3 || team.Members[0].UserID != "user_1" || team.Members[0].IsActive {
+			t.Errorf("Members = %+v, want inactive user_1", team.Members)
+		}
+
+		backend, err := teamUseCase.GetTeam(ctx, "backend-team")
+		if err != nil {
+			t.Fatalf("GetTeam() error = %v", err)
+		}
+		if !backend.Members[0].IsActive {
+			t.Error("user_1 should stay active in backend-team")
+		}
+
+		if _, err := teamUseCase.SetMember(ctx, "frontend-team", "user_1", "owner", true); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected ErrValidation for unknown role, got %v", err)
+		}
+		if _, err := teamUseCase.SetMember(ctx, "frontend-team", "ghost", "", true); err != domain.ErrUserNotFound {
+			t.Errorf("Expected ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("primary team cannot be left until another one is primary", func(t *testing.T) {
+		setupTestData(t)
+		if _, err := teamUseCase.SetMember(ctx, "frontend-team", "user_1", "", true); err != nil {
+			t.Fatalf("SetMember() error = %v", err)
+		}
+
+		if _, err := teamUseCase.RemoveMember(ctx, "backend-team", "user_1"); err != domain.ErrPrimaryTeam {
+			t.Fatalf("Expected ErrPrimaryTeam, got %v", err)
+		}
+
+		user, err := teamUseCase.SetPrimaryTeam(ctx, "user_1", "frontend-team")
+		if err != nil {
+			t.Fatalf("SetPrimaryTeam() error = %v", err)
+		}
+		if user.TeamName != "frontend-team" {
+			t.Errorf("Primary team = %s, want frontend-team", user.TeamName)
+		}
+
+		team, err := teamUseCase.RemoveMember(ctx, "backend-team", "user_1")
+		if err != nil {
+			t.Fatalf("RemoveMember() error = %v", err)
+		}
+		for _, member := range team.Members {
+			if member.UserID == "user_1" {
+				t.Error("user_1 should be removed from backend-team")
+			}
+		}
+
+		if _, err := teamUseCase.RemoveMember(ctx, "backend-team", "user_1"); err != domain.ErrNotTeamMember {
+			t.Errorf("Expected ErrNotTeamMember, got %v", err)
+		}
+		if _, err := teamUseCase.SetPrimaryTeam(ctx, "user_1", "backend-team"); err != domain.ErrNotTeamMember {
+			t.Errorf("Expected ErrNotTeamMember, got %v", err)
+		}
+	})
+}
+
+func TestTeamUseCase_Hierarchy(t *testing.T) {
+	ctx := context.Background()
+	setupTestData(t)
+
+	if _, err := teamUseCase.CreateTeam(ctx, &domain.Team{Name: "engineering"}); err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if _, err := teamUseCase.CreateTeam(ctx, &domain.Team{Name: "orphan", ParentName: "missing"}); err != domain.ErrTeamNotFound {
+		t.Fatalf("Expected ErrTeamNotFound for unknown parent, got %v", err)
+	}
+	for _, name := range []string{"backend-team", "frontend-team"} {
+		team, err := teamUseCase.MoveTeam(ctx, name, "engineering")
+		if err != nil {
+			t.Fatalf("MoveTeam(%s) error = %v", name, err)
+		}
+		if team.ParentName != "engineering" {
+			t.Errorf("ParentName = %q, want engineering", team.ParentName)
+		}
+	}
+	if _, err := teamUseCase.CreateTeam(ctx, &domain.Team{Name: "platform", ParentName: "backend-team"}); err != nil {
+		t.Fatalf("CreateTeam() with parent error = %v", err)
+	}
+
+	t.Run("subtree is nested", func(t *testing.T) {
+		tree, err := teamUseCase.GetSubtree(ctx, "engineering")
+		if err != nil {
+			t.Fatalf("GetSubtree() error = %v", err)
+		}
+		if len(tree.Children) != 2 || tree.Children[0].Team.Name != "backend-team" || tree.Children[1].Team.Name != "frontend-team" {
+			t.Fatalf("Children = %+v", tree.Children)
+		}
+		if platform := tree.Children[0].Children; len(platform) != 1 || platform[0].Team.Name != "platform" {
+			t.Errorf("backend-team children = %+v, want platform", platform)
+		}
+	})
+
+	t.Run("team cannot be moved under its descendant", func(t *testing.T) {
+		if _, err := teamUseCase.MoveTeam(ctx, "engineering", "platform"); err != domain.ErrTeamCycle {
+			t.Errorf("Expected ErrTeamCycle, got %v", err)
+		}
+	})
+
+	t.Run("moving to top level", func(t *testing.T) {
+		if _, err := teamUseCase.MoveTeam(ctx, "platform", ""); err != nil {
+			t.Fatalf("MoveTeam() error = %v", err)
+		}
+		team, err := teamUseCase.GetTeam(ctx, "platform")
+		if err != nil {
+			t.Fatalf("GetTeam() error = %v", err)
+		}
+		if team.ParentName != "" {
+			t.Errorf("ParentName = %q, want top level", team.ParentName)
+		}
+
+		entries, err := auditUseCase.FindEntries(ctx, domain.AuditFilter{EntityID: "platform", Action: domain.AuditActionTeamMoved})
+		if err != nil {
+			t.Fatalf("FindEntries() error = %v", err)
+		}
+		if len(entries) != 1 || entries[0].OldValue["parent_name"] != "backend-team" {
+			t.Errorf("Audit entries = %+v", entries)
+		}
+	})
+
+	t.Run("stats cover the subtree", func(t *testing.T) {
+		stats, err := teamUseCase.GetSubtreeStats(ctx, "engineering")
+		if err != nil {
+			t.Fatalf("GetSubtreeStats() error = %v", err)
+		}
+		if len(stats) != 3 || stats[0].TeamName != "engineering" || stats[0].Members != 5 || stats[0].ActiveMembers != 4 {
+			t.Errorf("Stats = %+v", stats)
+		}
+	})
+}
+
+func TestTeamUseCase_SearchUsersByTag(t *testing.T) {
+	ctx := context.Background()
+	setupTestData(t)
+
+	for userID, tags := range map[string][]string{"user_1": {"go"}, "user_3": {"go", "react"}, "user_4": {"react"}} {
+		if _, err := userUseCase.SetTags(ctx, userID, tags); err != nil {
+			t.Fatalf("SetTags(%s) error = %v", userID, err)
+		}
+	}
+
+	ids := func(users []*domain.User) []string {
+		result := make([]string, 0, len(users))
+		for _, u := range users {
+			result = append(result, u.ID)
+		}
+		return result
+	}
+
+	users, err := teamUseCase.SearchUsersByTag(ctx, "Go", "")
+	if err != nil {
+		t.Fatalf("SearchUsersByTag() error = %v", err)
+	}
+	if got := ids(users); !reflect.DeepEqual(got, []string{"user_1", "user_3"}) {
+		t.Errorf("users with go = %v, want [user_1 user_3]", got)
+	}
+
+	users, err = teamUseCase.SearchUsersByTag(ctx, "go", "frontend-team")
+	if err != nil {
+		t.Fatalf("SearchUsersByTag() error = %v", err)
+	}
+	if got := ids(users); !reflect.DeepEqual(got, []string{"user_3"}) {
+		t.Errorf("frontend users with go = %v, want [user_3]", got)
+	}
+
+	if _, err := teamUseCase.SearchUsersByTag(ctx, "go", "unknown-team"); !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Errorf("Expected ErrTeamNotFound, got %v", err)
+	}
+}
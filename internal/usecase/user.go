@@ -9,10 +9,28 @@ import (
 
 type UserUseCase struct {
 	userRepo user.UserRepository
+	clock    Clock
 }
 
-func NewUserUseCase(userRepo user.UserRepository) *UserUseCase {
-	return &UserUseCase{userRepo: userRepo}
+type UserOption func(*UserUseCase)
+
+// WithUserClock задаёт часы для отметок heartbeat; должны совпадать с часами PRUseCase,
+// который по этим отметкам проверяет, онлайн ли ревьювер
+func WithUserClock(clock Clock) UserOption {
+	return func(uc *UserUseCase) {
+		uc.clock = clock
+	}
+}
+
+func NewUserUseCase(userRepo user.UserRepository, opts ...UserOption) *UserUseCase {
+	uc := &UserUseCase{
+		userRepo: userRepo,
+		clock:    SystemClock(),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 func (uc *UserUseCase) SetUserActivity(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
@@ -21,10 +39,123 @@ func (uc *UserUseCase) SetUserActivity(ctx context.Context, userID string, isAct
 		return nil, err
 	}
 
-	if err := uc.userRepo.UpdateActivity(ctx, userID, isActive); err != nil {
+	reason := domain.AuditReasonActivation
+	if !isActive {
+		reason = domain.AuditReasonDeactivation
+	}
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityUser,
+		EntityID:   userID,
+		Action:     domain.AuditActionUserActivityChanged,
+		Reason:     reason,
+		OldValue:   map[string]any{"is_active": user.IsActive},
+		NewValue:   map[string]any{"is_active": isActive},
+	})
+	if err := uc.userRepo.UpdateActivity(ctx, userID, isActive, entry); err != nil {
 		return nil, err
 	}
 
 	user.IsActive = isActive
 	return user, nil
 }
+
+// SetCapacity меняет долю ревью пользователя в процентах; она действует во всех его командах
+func (uc *UserUseCase) SetCapacity(ctx context.Context, userID string, capacity int) (*domain.User, error) {
+	if err := domain.ValidateCapacity(capacity); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityUser,
+		EntityID:   userID,
+		Action:     domain.AuditActionUserCapacityChanged,
+		OldValue:   map[string]any{"capacity": user.Capacity},
+		NewValue:   map[string]any{"capacity": capacity},
+	})
+	if err := uc.userRepo.UpdateCapacity(ctx, userID, capacity, entry); err != nil {
+		return nil, err
+	}
+
+	user.Capacity = capacity
+	return user, nil
+}
+
+// Heartbeat отмечает, что пользователь сейчас онлайн. В журнал не пишется: клиенты шлют heartbeat каждые несколько минут
+func (uc *UserUseCase) Heartbeat(ctx context.Context, userID string) (*domain.User, error) {
+	if err := uc.userRepo.UpdateLastSeen(ctx, userID, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.FindByID(ctx, userID)
+}
+
+// SetTags заменяет теги экспертизы пользователя целиком; теги приводятся к нижнему регистру, повторы убираются
+func (uc *UserUseCase) SetTags(ctx context.Context, userID string, tags []string) (*domain.User, error) {
+	tags = domain.NormalizeTags(tags)
+	if err := domain.ValidateTags(tags); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityUser,
+		EntityID:   userID,
+		Action:     domain.AuditActionUserTagsChanged,
+		OldValue:   map[string]any{"tags": user.Tags},
+		NewValue:   map[string]any{"tags": tags},
+	})
+	if err := uc.userRepo.SetTags(ctx, userID, tags, entry); err != nil {
+		return nil, err
+	}
+
+	user.Tags = tags
+	return user, nil
+}
+
+func (uc *UserUseCase) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	return uc.userRepo.FindByID(ctx, userID)
+}
+
+// GetUsersByIDs возвращает найденных пользователей; используется для пакетной загрузки
+func (uc *UserUseCase) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	return uc.userRepo.FindByIDs(ctx, userIDs)
+}
+
+// GetUsersByTeamIDs возвращает участников нескольких команд одним запросом, сгруппированных по команде
+func (uc *UserUseCase) GetUsersByTeamIDs(ctx context.Context, teamIDs []int) (map[int][]*domain.User, error) {
+	return uc.userRepo.FindByTeamIDs(ctx, teamIDs)
+}
+
+// SetNotifications заменяет контакты и настройки уведомлений пользователя целиком
+func (uc *UserUseCase) SetNotifications(ctx context.Context, userID string, settings domain.NotificationSettings) (*domain.User, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry(ctx, domain.AuditEntry{
+		EntityType: domain.AuditEntityUser,
+		EntityID:   userID,
+		Action:     domain.AuditActionUserNotifications,
+		OldValue:   map[string]any{"notifications": user.Notifications},
+		NewValue:   map[string]any{"notifications": settings},
+	})
+	if err := uc.userRepo.UpdateNotifications(ctx, userID, settings, entry); err != nil {
+		return nil, err
+	}
+
+	user.Notifications = settings
+	return user, nil
+}
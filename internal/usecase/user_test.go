@@ -3,7 +3,10 @@ package usecase
 import (
 	"avito-test-task/internal/domain"
 	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	_ "github.com/lib/pq"
 )
@@ -309,3 +312,204 @@ func TestUserUseCase_SetUserActivity_ConcurrentUpdates(t *testing.T) {
 		}
 	})
 }
+
+func TestUserUseCase_SetNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("settings are saved and audited", func(t *testing.T) {
+		setupTestData(t)
+
+		settings := domain.NotificationSettings{Email: "alice@example.com", ChatHandle: "@alice", Locale: domain.LocaleEN, MuteChat: true}
+		user, err := userUseCase.SetNotifications(ctx, "user_1", settings)
+		if err != nil {
+			t.Fatalf("SetNotifications() error = %v", err)
+		}
+		if user.Notifications != settings {
+			t.Errorf("Notifications = %+v, want %+v", user.Notifications, settings)
+		}
+
+		stored, err := userRepo.FindByID(ctx, "user_1")
+		if err != nil {
+			t.Fatalf("Failed to verify user in DB: %v", err)
+		}
+		if stored.Notifications != settings {
+			t.Errorf("Stored notifications = %+v, want %+v", stored.Notifications, settings)
+		}
+
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "user_1", Action: domain.AuditActionUserNotifications})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("Expected 1 audit entry, got %d", len(entries))
+		}
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := userUseCase.SetNotifications(ctx, "user_1", domain.NotificationSettings{Email: "Alice <alice@example.com>"})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := userUseCase.SetNotifications(ctx, "non_existent_user", domain.NotificationSettings{})
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Errorf("Expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
+
+func TestUserUseCase_SetCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("capacity is saved and audited", func(t *testing.T) {
+		setupTestData(t)
+
+		user, err := userUseCase.SetCapacity(ctx, "user_1", 40)
+		if err != nil {
+			t.Fatalf("SetCapacity() error = %v", err)
+		}
+		if user.Capacity != 40 {
+			t.Errorf("Capacity = %d, want 40", user.Capacity)
+		}
+
+		stored, err := userRepo.FindByID(ctx, "user_1")
+		if err != nil {
+			t.Fatalf("Failed to verify user in DB: %v", err)
+		}
+		if stored.Capacity != 40 {
+			t.Errorf("Stored capacity = %d, want 40", stored.Capacity)
+		}
+
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "user_1", Action: domain.AuditActionUserCapacityChanged})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 1 || entries[0].OldValue["capacity"] != float64(domain.MaxCapacity) {
+			t.Errorf("Audit entries = %+v, want one change from %d", entries, domain.MaxCapacity)
+		}
+	})
+
+	t.Run("capacity out of range", func(t *testing.T) {
+		setupTestData(t)
+
+		for _, capacity := range []int{-1, domain.MaxCapacity + 1} {
+			if _, err := userUseCase.SetCapacity(ctx, "user_1", capacity); !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("SetCapacity(%d) error = %v, want validation error", capacity, err)
+			}
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := userUseCase.SetCapacity(ctx, "non_existent_user", 50)
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Errorf("Expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
+
+func TestUserUseCase_Heartbeat(t *testing.T) {
+	ctx := context.Background()
+	setupTestData(t)
+
+	user, err := userUseCase.Heartbeat(ctx, "user_1")
+	if err != nil {
+		t.Fatalf("Heartbeat() error = %v", err)
+	}
+	if !user.Online(time.Now()) {
+		t.Errorf("User should be online right after heartbeat, LastSeenAt = %v", user.LastSeenAt)
+	}
+	if user.Online(time.Now().Add(domain.OnlineWindow)) {
+		t.Error("User should go offline after OnlineWindow without heartbeat")
+	}
+
+	if _, err := userUseCase.Heartbeat(ctx, "non_existent"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("Heartbeat() error = %v, want ErrUserNotFound", err)
+	}
+
+	// отметка берётся из внедрённых часов, а не из time.Now
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc := NewUserUseCase(*userRepo, WithUserClock(fixedClock{now: now}))
+	user, err = uc.Heartbeat(ctx, "user_2")
+	if err != nil {
+		t.Fatalf("Heartbeat() error = %v", err)
+	}
+	if user.LastSeenAt == nil || !user.LastSeenAt.Equal(now) {
+		t.Errorf("LastSeenAt = %v, want %v", user.LastSeenAt, now)
+	}
+}
+
+func TestUserUseCase_SetTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tags are normalized, replaced and audited", func(t *testing.T) {
+		setupTestData(t)
+
+		if _, err := userUseCase.SetTags(ctx, "user_1", []string{"rust"}); err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+		user, err := userUseCase.SetTags(ctx, "user_1", []string{" Postgres", "go", "GO"})
+		if err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+		want := []string{"go", "postgres"}
+		if !reflect.DeepEqual(user.Tags, want) {
+			t.Errorf("Tags = %v, want %v", user.Tags, want)
+		}
+
+		stored, err := userRepo.FindByID(ctx, "user_1")
+		if err != nil {
+			t.Fatalf("Failed to verify user in DB: %v", err)
+		}
+		if !reflect.DeepEqual(stored.Tags, want) {
+			t.Errorf("Stored tags = %v, want %v", stored.Tags, want)
+		}
+
+		entries, err := auditRepo.Find(ctx, domain.AuditFilter{EntityID: "user_1", Action: domain.AuditActionUserTagsChanged})
+		if err != nil {
+			t.Fatalf("Failed to read audit log: %v", err)
+		}
+		if len(entries) != 2 {
+			t.Errorf("Audit entries = %+v, want 2", entries)
+		}
+	})
+
+	t.Run("empty list clears tags", func(t *testing.T) {
+		setupTestData(t)
+
+		if _, err := userUseCase.SetTags(ctx, "user_1", []string{"go"}); err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+		user, err := userUseCase.SetTags(ctx, "user_1", nil)
+		if err != nil {
+			t.Fatalf("SetTags() error = %v", err)
+		}
+		if len(user.Tags) != 0 {
+			t.Errorf("Tags = %v, want none", user.Tags)
+		}
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		setupTestData(t)
+
+		if _, err := userUseCase.SetTags(ctx, "user_1", []string{"two words"}); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		setupTestData(t)
+
+		_, err := userUseCase.SetTags(ctx, "non_existent_user", []string{"go"})
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			t.Errorf("Expected ErrUserNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN reviewers_count INTEGER NOT NULL DEFAULT 2 CHECK (reviewers_count BETWEEN 1 AND 10);
+
+ALTER TABLE pull_requests ADD COLUMN required_reviewers INTEGER NOT NULL DEFAULT 2 CHECK (required_reviewers BETWEEN 1 AND 10);
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION notify_review_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('review_events', NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_log_notify_review_event
+    AFTER INSERT ON audit_log
+    FOR EACH ROW
+    WHEN (NEW.entity_type = 'pull_request')
+    EXECUTE FUNCTION notify_review_event();
@@ -0,0 +1,9 @@
+-- +goose Up
+-- NULL - порог из конфигурации сервера
+ALTER TABLE teams ADD COLUMN review_sla_hours INTEGER NULL CHECK (review_sla_hours > 0);
+ALTER TABLE teams ADD COLUMN reassign_after_hours INTEGER NULL CHECK (reassign_after_hours > review_sla_hours);
+
+-- у существующих назначений assigned_at остаётся NULL, для них ожидание считается от pull_requests.created_at
+ALTER TABLE pr_reviewers ADD COLUMN assigned_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE pr_reviewers ALTER COLUMN assigned_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE pr_reviewers ADD COLUMN reminded_at TIMESTAMP WITH TIME ZONE NULL;
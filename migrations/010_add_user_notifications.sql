@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN email VARCHAR(255) NULL;
+ALTER TABLE users ADD COLUMN chat_handle VARCHAR(255) NULL;
+ALTER TABLE users ADD COLUMN locale VARCHAR(8) NULL CHECK (locale IN ('ru', 'en'));
+ALTER TABLE users ADD COLUMN mute_email BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN mute_chat BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE NULL
+);
+
+-- relay читает только недоставленные события, по порядку и по агрегату
+CREATE INDEX idx_outbox_pending ON outbox(id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_outbox_pending_aggregate ON outbox(aggregate_type, aggregate_id, id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_outbox_delivered_at ON outbox(delivered_at) WHERE delivered_at IS NOT NULL;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- пользователь может состоять в нескольких командах; users.team_id остаётся основной командой
+CREATE TABLE team_memberships (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    role VARCHAR(32) NOT NULL DEFAULT 'member' CHECK (role IN ('member', 'lead')),
+    -- is_active выключает участие только в этой команде, users.is_active - во всех
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, team_id)
+);
+
+CREATE INDEX idx_team_memberships_team_id ON team_memberships(team_id);
+
+INSERT INTO team_memberships (user_id, team_id, is_active)
+SELECT id, team_id, TRUE FROM users;
+
+-- членство в основной команде существует всегда: его добавляет триггер при создании пользователя и смене основной команды
+-- +goose StatementBegin
+CREATE FUNCTION ensure_primary_membership() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO team_memberships (user_id, team_id) VALUES (NEW.id, NEW.team_id)
+    ON CONFLICT DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER users_ensure_primary_membership
+    AFTER INSERT OR UPDATE OF team_id ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION ensure_primary_membership();
+
+-- команда, из которой назначаются ревьюверы PR: основная команда автора или указанная при создании
+ALTER TABLE pull_requests ADD COLUMN team_id INTEGER NULL REFERENCES teams(id);
+
+UPDATE pull_requests pr SET team_id = u.team_id FROM users u WHERE u.id = pr.author_id;
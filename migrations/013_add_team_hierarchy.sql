@@ -0,0 +1,5 @@
+-- +goose Up
+-- NULL - команда верхнего уровня (например, департамент); циклы не допускает TeamRepository.Move
+ALTER TABLE teams ADD COLUMN parent_id INTEGER NULL REFERENCES teams(id) CHECK (parent_id <> id);
+
+CREATE INDEX idx_teams_parent_id ON teams(parent_id);
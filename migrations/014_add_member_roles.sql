@@ -0,0 +1,5 @@
+-- +goose Up
+-- senior и trainee нужны правилам назначения ревьюверов из политики (assignment.RoleRules)
+ALTER TABLE team_memberships DROP CONSTRAINT team_memberships_role_check;
+ALTER TABLE team_memberships ADD CONSTRAINT team_memberships_role_check
+    CHECK (role IN ('lead', 'senior', 'member', 'trainee'));
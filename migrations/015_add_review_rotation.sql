@@ -0,0 +1,3 @@
+-- +goose Up
+-- review_rotation - ревьюверы выбираются по давности последнего ревью автора вместо способа из политики
+ALTER TABLE teams ADD COLUMN review_rotation BOOLEAN NOT NULL DEFAULT FALSE;
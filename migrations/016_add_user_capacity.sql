@@ -0,0 +1,3 @@
+-- +goose Up
+-- capacity - доля ревью пользователя в процентах: part-time и новички получают меньше назначений, 0 - не назначаются
+ALTER TABLE users ADD COLUMN capacity INTEGER NOT NULL DEFAULT 100 CHECK (capacity BETWEEN 0 AND 100);
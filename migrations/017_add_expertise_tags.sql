@@ -0,0 +1,11 @@
+-- +goose Up
+-- теги экспертизы пользователя (go, postgres, frontend); по ним подбираются ревьюверы для required_tags PR
+CREATE TABLE user_tags (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL CHECK (tag <> ''),
+    PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX idx_user_tags_tag ON user_tags(tag);
+
+ALTER TABLE pull_requests ADD COLUMN required_tags TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+-- +goose Up
+-- priority - срочность PR: hotfix получает самых свободных ревьюверов, списки ревью сортируются по ней
+ALTER TABLE pull_requests ADD COLUMN priority VARCHAR(16) NOT NULL DEFAULT 'normal'
+    CHECK (priority IN ('low', 'normal', 'high', 'hotfix'));
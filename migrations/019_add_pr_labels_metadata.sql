@@ -0,0 +1,12 @@
+-- +goose Up
+-- labels - произвольные метки PR, остальные колонки - ссылка на код в хостинге репозиториев; пустая строка - не задано
+ALTER TABLE pull_requests
+    ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN repository VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN source_branch VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN target_branch VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN url VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN lines_changed INTEGER NOT NULL DEFAULT 0 CHECK (lines_changed >= 0);
+
+CREATE INDEX idx_pr_repository ON pull_requests(repository);
+CREATE INDEX idx_pr_labels ON pull_requests USING GIN (labels);
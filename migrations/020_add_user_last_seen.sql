@@ -0,0 +1,3 @@
+-- +goose Up
+-- last_seen_at - последний heartbeat клиента пользователя; по нему hotfix-PR назначаются тем, кто сейчас онлайн
+ALTER TABLE users ADD COLUMN last_seen_at TIMESTAMP WITH TIME ZONE NULL;
@@ -131,6 +131,26 @@ test_endpoint "Deactivate user u3" 200 "$BASE_URL/users/setIsActive" '{
     "is_active": false
 }'
 
+echo "1.11 Getting PR history..."
+test_endpoint "Get history of PR pr-business-test" 200 "$BASE_URL/pullRequest/history?pull_request_id=pr-business-test" "" "GET"
+
+
+echo "1.12 Searching audit log..."
+test_endpoint "Get deactivations of user u3" 200 "$BASE_URL/audit?entity_type=user&entity_id=u3&action=USER_ACTIVITY_CHANGED" "" "GET"
+
+
+echo "1.13 Topping up PR reviewers..."
+test_endpoint "Top up PR pr-business-test" 200 "$BASE_URL/pullRequest/topUp" '{
+    "pull_request_id": "pr-business-test"
+}'
+
+
+echo "1.14 Setting team reviewers count..."
+test_endpoint "Set reviewers count for 'backend'" 200 "$BASE_URL/team/setReviewersCount" '{
+    "team_name": "backend",
+    "reviewers_count": 1
+}'
+
 echo "=== 2. Error Test Cases ==="
 
 
@@ -192,6 +212,31 @@ test_endpoint "Deactivate non-existent user" 404 "$BASE_URL/users/setIsActive" '
     "is_active": false
 }'
 
+
+echo "2.10 Requesting more reviewers than team size..."
+test_endpoint "Set reviewers count larger than team" 422 "$BASE_URL/team/setReviewersCount" '{
+    "team_name": "developers",
+    "reviewers_count": 3
+}'
+
+
+echo "2.11 Getting history of non-existent PR..."
+test_endpoint "Get history of non-existent PR" 404 "$BASE_URL/pullRequest/history?pull_request_id=pr-nonexistent" "" "GET"
+
+
+echo "2.12 Adding reviewer to non-existent PR..."
+test_endpoint "Add reviewer to non-existent PR" 404 "$BASE_URL/pullRequest/addReviewer" '{
+    "pull_request_id": "pr-nonexistent",
+    "user_id": "u1"
+}'
+
+
+echo "2.13 Removing reviewer from non-existent PR..."
+test_endpoint "Remove reviewer from non-existent PR" 404 "$BASE_URL/pullRequest/removeReviewer" '{
+    "pull_request_id": "pr-nonexistent",
+    "user_id": "u1"
+}'
+
 echo "=== 3. Edge Cases ==="
 
 